@@ -148,6 +148,10 @@ const (
 
 	PathSideInputsMount = "/var/numaflow/side-inputs"
 
+	// Mount paths of the volumes of the file sinks
+	PathFileSinkMount         = "/var/numaflow/file-sink"
+	PathFallbackFileSinkMount = "/var/numaflow/fallback-file-sink"
+
 	// ISB
 	DefaultBufferLength     = 30000
 	DefaultBufferUsageLimit = 0.8
@@ -196,6 +200,30 @@ const (
 	// DefaultKafkaHandlerChannelSize is the default channel size for kafka handler
 	DefaultKafkaHandlerChannelSize = 100
 
+	// DefaultHTTPSourceSyncTimeout is the default timeout of the synchronous requests of the http source
+	DefaultHTTPSourceSyncTimeout = 30 * time.Second
+
+	// DefaultHTTPSinkTimeout is the default timeout of the requests of the http sink
+	DefaultHTTPSinkTimeout = 30 * time.Second
+
+	// DefaultJetStreamSinkAckTimeout is the default timeout of waiting for the acks of the jetstream sink
+	DefaultJetStreamSinkAckTimeout = 30 * time.Second
+
+	// DefaultSQLSinkBatchSize is the default maximum number of the rows written in one statement of the sql sink
+	DefaultSQLSinkBatchSize = 100
+
+	// DefaultFileSinkMaxSize is the default size to rotate the files of the file sink at
+	DefaultFileSinkMaxSize = 128 * 1024 * 1024
+	// DefaultFileSinkInterval is the default interval to rotate the files of the file sink
+	DefaultFileSinkInterval = 10 * time.Minute
+
+	// DefaultSinkRetryMaxAttempts is the default maximum number of the attempts to write a message to the sink
+	DefaultSinkRetryMaxAttempts = 3
+	// DefaultSinkRetryInterval is the default interval before the first retry of writing to the sink
+	DefaultSinkRetryInterval = 100 * time.Millisecond
+	// DefaultSinkRetryMaxInterval is the default maximum interval between the retries of writing to the sink
+	DefaultSinkRetryMaxInterval = 30 * time.Second
+
 	// DefaultKeyForNonKeyedData Default key for non keyed stream
 	DefaultKeyForNonKeyedData = "NON_KEYED_STREAM"
 
@@ -222,4 +250,7 @@ const (
 var (
 	MessageTagDrop = fmt.Sprintf("%U__DROP__", '\\') // U+005C__DROP__
 	MessageTagAll  = fmt.Sprintf("%U__ALL__", '\\')  // U+005C__ALL__
+	// MessageTagConversionFailed is added by the builtin convert transformer to the messages failed to be converted,
+	// so that they can be counted.
+	MessageTagConversionFailed = fmt.Sprintf("%U__CONVERSION_FAILED__", '\\') // U+005C__CONVERSION_FAILED__
 )
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type FileSink struct {
+	// Volume is the name of a volume in the volumes of the vertex to write the files to, e.g. a PersistentVolumeClaim.
+	Volume string `json:"volume" protobuf:"bytes,1,opt,name=volume"`
+	// Path is the directory relative to the root of the volume to write the files to, defaults to the root of the volume.
+	// +optional
+	Path string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
+	// Prefix of the file names, defaults to the vertex name, or "{vertex}-fallback" for a fallback sink.
+	// The files are named "{prefix}-{replica}-{createdTime}-{sequence}.{extension}", and "{prefix}-{replica}-w{windowStart}-{createdTime}-{sequence}.{extension}"
+	// if they are rotated by event time windows, the times are in Unix milliseconds.
+	// +optional
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,3,opt,name=prefix"`
+	// Format of the files, one of jsonl, csv and parquet, defaults to jsonl.
+	// +optional
+	Format FileSinkFormat `json:"format,omitempty" protobuf:"bytes,4,opt,name=format,casttype=FileSinkFormat"`
+	// Columns are the fields of the JSON payloads written as the columns of the csv and parquet files, required by these formats.
+	// +optional
+	Columns []string `json:"columns,omitempty" protobuf:"bytes,5,rep,name=columns"`
+	// Compression of the files, one of none, gzip and zstd, defaults to none.
+	// The pages are compressed for the parquet files, otherwise the files are compressed as a whole.
+	// +optional
+	Compression FileSinkCompression `json:"compression,omitempty" protobuf:"bytes,6,opt,name=compression,casttype=FileSinkCompression"`
+	// Rotation defines when a file is closed and a new one is started.
+	// +optional
+	Rotation *FileSinkRotation `json:"rotation,omitempty" protobuf:"bytes,7,opt,name=rotation"`
+}
+
+// +kubebuilder:validation:Enum="";jsonl;csv;parquet
+type FileSinkFormat string
+
+const (
+	FileSinkFormatJSONL   FileSinkFormat = "jsonl"
+	FileSinkFormatCSV     FileSinkFormat = "csv"
+	FileSinkFormatParquet FileSinkFormat = "parquet"
+)
+
+// +kubebuilder:validation:Enum="";none;gzip;zstd
+type FileSinkCompression string
+
+const (
+	FileSinkCompressionNone FileSinkCompression = "none"
+	FileSinkCompressionGzip FileSinkCompression = "gzip"
+	FileSinkCompressionZstd FileSinkCompression = "zstd"
+)
+
+// FileSinkRotation defines the rotation of the files, a file is closed as soon as any of the conditions is met.
+type FileSinkRotation struct {
+	// MaxSize is the size of a file to rotate at, defaults to 128Mi.
+	// +optional
+	MaxSize *apiresource.Quantity `json:"maxSize,omitempty" protobuf:"bytes,1,opt,name=maxSize"`
+	// Interval is how long a file is kept open since it's created, defaults to 10m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty" protobuf:"bytes,2,opt,name=interval"`
+	// Window writes the messages to the files of the fixed event time windows of the length, a file of a window is
+	// closed once a message of a later window is written, the late messages are written to new files of their windows.
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty" protobuf:"bytes,3,opt,name=window"`
+}
+
+func (fs FileSink) GetFormat() FileSinkFormat {
+	if fs.Format == "" {
+		return FileSinkFormatJSONL
+	}
+	return fs.Format
+}
+
+func (fs FileSink) GetCompression() FileSinkCompression {
+	if fs.Compression == "" {
+		return FileSinkCompressionNone
+	}
+	return fs.Compression
+}
+
+func (fs FileSink) GetMaxSize() int64 {
+	if fs.Rotation != nil && fs.Rotation.MaxSize != nil && fs.Rotation.MaxSize.Value() > 0 {
+		return fs.Rotation.MaxSize.Value()
+	}
+	return DefaultFileSinkMaxSize
+}
+
+func (fs FileSink) GetInterval() time.Duration {
+	if fs.Rotation != nil && fs.Rotation.Interval != nil && fs.Rotation.Interval.Duration > 0 {
+		return fs.Rotation.Interval.Duration
+	}
+	return DefaultFileSinkInterval
+}
+
+// GetWindow returns the length of the event time windows, 0 means the files are not rotated by windows.
+func (fs FileSink) GetWindow() time.Duration {
+	if fs.Rotation != nil && fs.Rotation.Window != nil && fs.Rotation.Window.Duration > 0 {
+		return fs.Rotation.Window.Duration
+	}
+	return 0
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFileSink_Defaults(t *testing.T) {
+	fs := FileSink{}
+	assert.Equal(t, FileSinkFormatJSONL, fs.GetFormat())
+	assert.Equal(t, FileSinkCompressionNone, fs.GetCompression())
+	assert.Equal(t, int64(DefaultFileSinkMaxSize), fs.GetMaxSize())
+	assert.Equal(t, DefaultFileSinkInterval, fs.GetInterval())
+	assert.Equal(t, time.Duration(0), fs.GetWindow())
+}
+
+func TestFileSink_Getters(t *testing.T) {
+	size := apiresource.MustParse("1Mi")
+	fs := FileSink{
+		Format:      FileSinkFormatParquet,
+		Compression: FileSinkCompressionZstd,
+		Rotation: &FileSinkRotation{
+			MaxSize:  &size,
+			Interval: &metav1.Duration{Duration: time.Minute},
+			Window:   &metav1.Duration{Duration: time.Hour},
+		},
+	}
+	assert.Equal(t, FileSinkFormatParquet, fs.GetFormat())
+	assert.Equal(t, FileSinkCompressionZstd, fs.GetCompression())
+	assert.Equal(t, int64(1024*1024), fs.GetMaxSize())
+	assert.Equal(t, time.Minute, fs.GetInterval())
+	assert.Equal(t, time.Hour, fs.GetWindow())
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FileSource reads the newline delimited records of the files on the mounted volumes, each line is a message.
+type FileSource struct {
+	// Path is a glob pattern of the files to read, e.g. "/var/log/app/*.log".
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	// VolumeMounts mounts the volumes of the vertex which contain the files to the main container.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty" protobuf:"bytes,2,rep,name=volumeMounts"`
+	// Follow keeps reading the lines appended to the files and the new files matching the path, defaults to true.
+	// If it's false, the files matching the path at the startup are read once.
+	// +optional
+	Follow *bool `json:"follow,omitempty" protobuf:"varint,3,opt,name=follow"`
+	// PollInterval is how often to look for the new files and the appended lines, defaults to 1s.
+	// +optional
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty" protobuf:"bytes,4,opt,name=pollInterval"`
+}
+
+func (fs FileSource) GetFollow() bool {
+	return fs.Follow == nil || *fs.Follow
+}
+
+func (fs FileSource) GetPollInterval() time.Duration {
+	if fs.PollInterval != nil {
+		return fs.PollInterval.Duration
+	}
+	return time.Second
+}
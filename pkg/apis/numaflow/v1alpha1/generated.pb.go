@@ -384,10 +384,94 @@ func (m *Edge) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Edge proto.InternalMessageInfo
 
+func (m *FileSink) Reset()      { *m = FileSink{} }
+func (*FileSink) ProtoMessage() {}
+func (*FileSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{12}
+}
+func (m *FileSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FileSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileSink.Merge(m, src)
+}
+func (m *FileSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileSink proto.InternalMessageInfo
+
+func (m *FileSinkRotation) Reset()      { *m = FileSinkRotation{} }
+func (*FileSinkRotation) ProtoMessage() {}
+func (*FileSinkRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{13}
+}
+func (m *FileSinkRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileSinkRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FileSinkRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileSinkRotation.Merge(m, src)
+}
+func (m *FileSinkRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileSinkRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileSinkRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileSinkRotation proto.InternalMessageInfo
+
+func (m *FileSource) Reset()      { *m = FileSource{} }
+func (*FileSource) ProtoMessage() {}
+func (*FileSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{14}
+}
+func (m *FileSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FileSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileSource.Merge(m, src)
+}
+func (m *FileSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileSource proto.InternalMessageInfo
+
 func (m *FixedWindow) Reset()      { *m = FixedWindow{} }
 func (*FixedWindow) ProtoMessage() {}
 func (*FixedWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{12}
+	return fileDescriptor_9d0d1b17d3865563, []int{15}
 }
 func (m *FixedWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +499,7 @@ var xxx_messageInfo_FixedWindow proto.InternalMessageInfo
 func (m *ForwardConditions) Reset()      { *m = ForwardConditions{} }
 func (*ForwardConditions) ProtoMessage() {}
 func (*ForwardConditions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{13}
+	return fileDescriptor_9d0d1b17d3865563, []int{16}
 }
 func (m *ForwardConditions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +527,7 @@ var xxx_messageInfo_ForwardConditions proto.InternalMessageInfo
 func (m *Function) Reset()      { *m = Function{} }
 func (*Function) ProtoMessage() {}
 func (*Function) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{14}
+	return fileDescriptor_9d0d1b17d3865563, []int{17}
 }
 func (m *Function) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,7 +555,7 @@ var xxx_messageInfo_Function proto.InternalMessageInfo
 func (m *GSSAPI) Reset()      { *m = GSSAPI{} }
 func (*GSSAPI) ProtoMessage() {}
 func (*GSSAPI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{15}
+	return fileDescriptor_9d0d1b17d3865563, []int{18}
 }
 func (m *GSSAPI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,10 +580,66 @@ func (m *GSSAPI) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GSSAPI proto.InternalMessageInfo
 
+func (m *GeneratorKeyDistribution) Reset()      { *m = GeneratorKeyDistribution{} }
+func (*GeneratorKeyDistribution) ProtoMessage() {}
+func (*GeneratorKeyDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{19}
+}
+func (m *GeneratorKeyDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneratorKeyDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GeneratorKeyDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratorKeyDistribution.Merge(m, src)
+}
+func (m *GeneratorKeyDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *GeneratorKeyDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratorKeyDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratorKeyDistribution proto.InternalMessageInfo
+
+func (m *GeneratorLateData) Reset()      { *m = GeneratorLateData{} }
+func (*GeneratorLateData) ProtoMessage() {}
+func (*GeneratorLateData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{20}
+}
+func (m *GeneratorLateData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneratorLateData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GeneratorLateData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratorLateData.Merge(m, src)
+}
+func (m *GeneratorLateData) XXX_Size() int {
+	return m.Size()
+}
+func (m *GeneratorLateData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratorLateData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratorLateData proto.InternalMessageInfo
+
 func (m *GeneratorSource) Reset()      { *m = GeneratorSource{} }
 func (*GeneratorSource) ProtoMessage() {}
 func (*GeneratorSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{16}
+	return fileDescriptor_9d0d1b17d3865563, []int{21}
 }
 func (m *GeneratorSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +667,7 @@ var xxx_messageInfo_GeneratorSource proto.InternalMessageInfo
 func (m *GetDaemonDeploymentReq) Reset()      { *m = GetDaemonDeploymentReq{} }
 func (*GetDaemonDeploymentReq) ProtoMessage() {}
 func (*GetDaemonDeploymentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{17}
+	return fileDescriptor_9d0d1b17d3865563, []int{22}
 }
 func (m *GetDaemonDeploymentReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +695,7 @@ var xxx_messageInfo_GetDaemonDeploymentReq proto.InternalMessageInfo
 func (m *GetJetStreamServiceSpecReq) Reset()      { *m = GetJetStreamServiceSpecReq{} }
 func (*GetJetStreamServiceSpecReq) ProtoMessage() {}
 func (*GetJetStreamServiceSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{18}
+	return fileDescriptor_9d0d1b17d3865563, []int{23}
 }
 func (m *GetJetStreamServiceSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +723,7 @@ var xxx_messageInfo_GetJetStreamServiceSpecReq proto.InternalMessageInfo
 func (m *GetJetStreamStatefulSetSpecReq) Reset()      { *m = GetJetStreamStatefulSetSpecReq{} }
 func (*GetJetStreamStatefulSetSpecReq) ProtoMessage() {}
 func (*GetJetStreamStatefulSetSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{19}
+	return fileDescriptor_9d0d1b17d3865563, []int{24}
 }
 func (m *GetJetStreamStatefulSetSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +751,7 @@ var xxx_messageInfo_GetJetStreamStatefulSetSpecReq proto.InternalMessageInfo
 func (m *GetRedisServiceSpecReq) Reset()      { *m = GetRedisServiceSpecReq{} }
 func (*GetRedisServiceSpecReq) ProtoMessage() {}
 func (*GetRedisServiceSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{20}
+	return fileDescriptor_9d0d1b17d3865563, []int{25}
 }
 func (m *GetRedisServiceSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +779,7 @@ var xxx_messageInfo_GetRedisServiceSpecReq proto.InternalMessageInfo
 func (m *GetRedisStatefulSetSpecReq) Reset()      { *m = GetRedisStatefulSetSpecReq{} }
 func (*GetRedisStatefulSetSpecReq) ProtoMessage() {}
 func (*GetRedisStatefulSetSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{21}
+	return fileDescriptor_9d0d1b17d3865563, []int{26}
 }
 func (m *GetRedisStatefulSetSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +807,7 @@ var xxx_messageInfo_GetRedisStatefulSetSpecReq proto.InternalMessageInfo
 func (m *GetSideInputDeploymentReq) Reset()      { *m = GetSideInputDeploymentReq{} }
 func (*GetSideInputDeploymentReq) ProtoMessage() {}
 func (*GetSideInputDeploymentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{22}
+	return fileDescriptor_9d0d1b17d3865563, []int{27}
 }
 func (m *GetSideInputDeploymentReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +835,7 @@ var xxx_messageInfo_GetSideInputDeploymentReq proto.InternalMessageInfo
 func (m *GetVertexPodSpecReq) Reset()      { *m = GetVertexPodSpecReq{} }
 func (*GetVertexPodSpecReq) ProtoMessage() {}
 func (*GetVertexPodSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{23}
+	return fileDescriptor_9d0d1b17d3865563, []int{28}
 }
 func (m *GetVertexPodSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,7 +863,7 @@ var xxx_messageInfo_GetVertexPodSpecReq proto.InternalMessageInfo
 func (m *GroupBy) Reset()      { *m = GroupBy{} }
 func (*GroupBy) ProtoMessage() {}
 func (*GroupBy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{24}
+	return fileDescriptor_9d0d1b17d3865563, []int{29}
 }
 func (m *GroupBy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -748,10 +888,94 @@ func (m *GroupBy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GroupBy proto.InternalMessageInfo
 
+func (m *HTTPSink) Reset()      { *m = HTTPSink{} }
+func (*HTTPSink) ProtoMessage() {}
+func (*HTTPSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{30}
+}
+func (m *HTTPSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPSink.Merge(m, src)
+}
+func (m *HTTPSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPSink proto.InternalMessageInfo
+
+func (m *HTTPSinkAuth) Reset()      { *m = HTTPSinkAuth{} }
+func (*HTTPSinkAuth) ProtoMessage() {}
+func (*HTTPSinkAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{31}
+}
+func (m *HTTPSinkAuth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPSinkAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPSinkAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPSinkAuth.Merge(m, src)
+}
+func (m *HTTPSinkAuth) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPSinkAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPSinkAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPSinkAuth proto.InternalMessageInfo
+
+func (m *HTTPSinkBatch) Reset()      { *m = HTTPSinkBatch{} }
+func (*HTTPSinkBatch) ProtoMessage() {}
+func (*HTTPSinkBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{32}
+}
+func (m *HTTPSinkBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPSinkBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPSinkBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPSinkBatch.Merge(m, src)
+}
+func (m *HTTPSinkBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPSinkBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPSinkBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPSinkBatch proto.InternalMessageInfo
+
 func (m *HTTPSource) Reset()      { *m = HTTPSource{} }
 func (*HTTPSource) ProtoMessage() {}
 func (*HTTPSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{25}
+	return fileDescriptor_9d0d1b17d3865563, []int{33}
 }
 func (m *HTTPSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,7 +1003,7 @@ var xxx_messageInfo_HTTPSource proto.InternalMessageInfo
 func (m *IdleSource) Reset()      { *m = IdleSource{} }
 func (*IdleSource) ProtoMessage() {}
 func (*IdleSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{26}
+	return fileDescriptor_9d0d1b17d3865563, []int{34}
 }
 func (m *IdleSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +1031,7 @@ var xxx_messageInfo_IdleSource proto.InternalMessageInfo
 func (m *InterStepBufferService) Reset()      { *m = InterStepBufferService{} }
 func (*InterStepBufferService) ProtoMessage() {}
 func (*InterStepBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{27}
+	return fileDescriptor_9d0d1b17d3865563, []int{35}
 }
 func (m *InterStepBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +1059,7 @@ var xxx_messageInfo_InterStepBufferService proto.InternalMessageInfo
 func (m *InterStepBufferServiceList) Reset()      { *m = InterStepBufferServiceList{} }
 func (*InterStepBufferServiceList) ProtoMessage() {}
 func (*InterStepBufferServiceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{28}
+	return fileDescriptor_9d0d1b17d3865563, []int{36}
 }
 func (m *InterStepBufferServiceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +1087,7 @@ var xxx_messageInfo_InterStepBufferServiceList proto.InternalMessageInfo
 func (m *InterStepBufferServiceSpec) Reset()      { *m = InterStepBufferServiceSpec{} }
 func (*InterStepBufferServiceSpec) ProtoMessage() {}
 func (*InterStepBufferServiceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{29}
+	return fileDescriptor_9d0d1b17d3865563, []int{37}
 }
 func (m *InterStepBufferServiceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +1115,7 @@ var xxx_messageInfo_InterStepBufferServiceSpec proto.InternalMessageInfo
 func (m *InterStepBufferServiceStatus) Reset()      { *m = InterStepBufferServiceStatus{} }
 func (*InterStepBufferServiceStatus) ProtoMessage() {}
 func (*InterStepBufferServiceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{30}
+	return fileDescriptor_9d0d1b17d3865563, []int{38}
 }
 func (m *InterStepBufferServiceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +1143,7 @@ var xxx_messageInfo_InterStepBufferServiceStatus proto.InternalMessageInfo
 func (m *JetStreamBufferService) Reset()      { *m = JetStreamBufferService{} }
 func (*JetStreamBufferService) ProtoMessage() {}
 func (*JetStreamBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{31}
+	return fileDescriptor_9d0d1b17d3865563, []int{39}
 }
 func (m *JetStreamBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,7 +1171,7 @@ var xxx_messageInfo_JetStreamBufferService proto.InternalMessageInfo
 func (m *JetStreamConfig) Reset()      { *m = JetStreamConfig{} }
 func (*JetStreamConfig) ProtoMessage() {}
 func (*JetStreamConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{32}
+	return fileDescriptor_9d0d1b17d3865563, []int{40}
 }
 func (m *JetStreamConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -972,10 +1196,38 @@ func (m *JetStreamConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JetStreamConfig proto.InternalMessageInfo
 
+func (m *JetStreamSink) Reset()      { *m = JetStreamSink{} }
+func (*JetStreamSink) ProtoMessage() {}
+func (*JetStreamSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{41}
+}
+func (m *JetStreamSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JetStreamSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JetStreamSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JetStreamSink.Merge(m, src)
+}
+func (m *JetStreamSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *JetStreamSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_JetStreamSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JetStreamSink proto.InternalMessageInfo
+
 func (m *JetStreamSource) Reset()      { *m = JetStreamSource{} }
 func (*JetStreamSource) ProtoMessage() {}
 func (*JetStreamSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{33}
+	return fileDescriptor_9d0d1b17d3865563, []int{42}
 }
 func (m *JetStreamSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1255,7 @@ var xxx_messageInfo_JetStreamSource proto.InternalMessageInfo
 func (m *JobTemplate) Reset()      { *m = JobTemplate{} }
 func (*JobTemplate) ProtoMessage() {}
 func (*JobTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{34}
+	return fileDescriptor_9d0d1b17d3865563, []int{43}
 }
 func (m *JobTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,10 +1280,38 @@ func (m *JobTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JobTemplate proto.InternalMessageInfo
 
+func (m *KafkaRewind) Reset()      { *m = KafkaRewind{} }
+func (*KafkaRewind) ProtoMessage() {}
+func (*KafkaRewind) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{44}
+}
+func (m *KafkaRewind) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaRewind) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaRewind) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaRewind.Merge(m, src)
+}
+func (m *KafkaRewind) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaRewind) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaRewind.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaRewind proto.InternalMessageInfo
+
 func (m *KafkaSink) Reset()      { *m = KafkaSink{} }
 func (*KafkaSink) ProtoMessage() {}
 func (*KafkaSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{35}
+	return fileDescriptor_9d0d1b17d3865563, []int{45}
 }
 func (m *KafkaSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1056,15 +1336,15 @@ func (m *KafkaSink) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KafkaSink proto.InternalMessageInfo
 
-func (m *KafkaSource) Reset()      { *m = KafkaSource{} }
-func (*KafkaSource) ProtoMessage() {}
-func (*KafkaSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{36}
+func (m *KafkaSinkKey) Reset()      { *m = KafkaSinkKey{} }
+func (*KafkaSinkKey) ProtoMessage() {}
+func (*KafkaSinkKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{46}
 }
-func (m *KafkaSource) XXX_Unmarshal(b []byte) error {
+func (m *KafkaSinkKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *KafkaSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KafkaSinkKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1072,27 +1352,27 @@ func (m *KafkaSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *KafkaSource) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_KafkaSource.Merge(m, src)
+func (m *KafkaSinkKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaSinkKey.Merge(m, src)
 }
-func (m *KafkaSource) XXX_Size() int {
+func (m *KafkaSinkKey) XXX_Size() int {
 	return m.Size()
 }
-func (m *KafkaSource) XXX_DiscardUnknown() {
-	xxx_messageInfo_KafkaSource.DiscardUnknown(m)
+func (m *KafkaSinkKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaSinkKey.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_KafkaSource proto.InternalMessageInfo
+var xxx_messageInfo_KafkaSinkKey proto.InternalMessageInfo
 
-func (m *Lifecycle) Reset()      { *m = Lifecycle{} }
-func (*Lifecycle) ProtoMessage() {}
-func (*Lifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{37}
+func (m *KafkaSinkTransaction) Reset()      { *m = KafkaSinkTransaction{} }
+func (*KafkaSinkTransaction) ProtoMessage() {}
+func (*KafkaSinkTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{47}
 }
-func (m *Lifecycle) XXX_Unmarshal(b []byte) error {
+func (m *KafkaSinkTransaction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Lifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KafkaSinkTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1100,27 +1380,111 @@ func (m *Lifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Lifecycle) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Lifecycle.Merge(m, src)
+func (m *KafkaSinkTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaSinkTransaction.Merge(m, src)
 }
-func (m *Lifecycle) XXX_Size() int {
+func (m *KafkaSinkTransaction) XXX_Size() int {
 	return m.Size()
 }
-func (m *Lifecycle) XXX_DiscardUnknown() {
-	xxx_messageInfo_Lifecycle.DiscardUnknown(m)
+func (m *KafkaSinkTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaSinkTransaction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Lifecycle proto.InternalMessageInfo
+var xxx_messageInfo_KafkaSinkTransaction proto.InternalMessageInfo
 
-func (m *Log) Reset()      { *m = Log{} }
-func (*Log) ProtoMessage() {}
-func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{38}
+func (m *KafkaSource) Reset()      { *m = KafkaSource{} }
+func (*KafkaSource) ProtoMessage() {}
+func (*KafkaSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{48}
 }
-func (m *Log) XXX_Unmarshal(b []byte) error {
+func (m *KafkaSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Log) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KafkaSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaSource.Merge(m, src)
+}
+func (m *KafkaSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaSource proto.InternalMessageInfo
+
+func (m *KafkaStartPosition) Reset()      { *m = KafkaStartPosition{} }
+func (*KafkaStartPosition) ProtoMessage() {}
+func (*KafkaStartPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{49}
+}
+func (m *KafkaStartPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaStartPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaStartPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaStartPosition.Merge(m, src)
+}
+func (m *KafkaStartPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaStartPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaStartPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaStartPosition proto.InternalMessageInfo
+
+func (m *Lifecycle) Reset()      { *m = Lifecycle{} }
+func (*Lifecycle) ProtoMessage() {}
+func (*Lifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{50}
+}
+func (m *Lifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Lifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lifecycle.Merge(m, src)
+}
+func (m *Lifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lifecycle proto.InternalMessageInfo
+
+func (m *Log) Reset()      { *m = Log{} }
+func (*Log) ProtoMessage() {}
+func (*Log) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{51}
+}
+func (m *Log) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Log) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1143,7 +1507,7 @@ var xxx_messageInfo_Log proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{39}
+	return fileDescriptor_9d0d1b17d3865563, []int{52}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1535,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *NativeRedis) Reset()      { *m = NativeRedis{} }
 func (*NativeRedis) ProtoMessage() {}
 func (*NativeRedis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{40}
+	return fileDescriptor_9d0d1b17d3865563, []int{53}
 }
 func (m *NativeRedis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,7 +1563,7 @@ var xxx_messageInfo_NativeRedis proto.InternalMessageInfo
 func (m *NatsAuth) Reset()      { *m = NatsAuth{} }
 func (*NatsAuth) ProtoMessage() {}
 func (*NatsAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{41}
+	return fileDescriptor_9d0d1b17d3865563, []int{54}
 }
 func (m *NatsAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1224,10 +1588,38 @@ func (m *NatsAuth) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NatsAuth proto.InternalMessageInfo
 
+func (m *NatsSink) Reset()      { *m = NatsSink{} }
+func (*NatsSink) ProtoMessage() {}
+func (*NatsSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{55}
+}
+func (m *NatsSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NatsSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NatsSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NatsSink.Merge(m, src)
+}
+func (m *NatsSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *NatsSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_NatsSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NatsSink proto.InternalMessageInfo
+
 func (m *NatsSource) Reset()      { *m = NatsSource{} }
 func (*NatsSource) ProtoMessage() {}
 func (*NatsSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{42}
+	return fileDescriptor_9d0d1b17d3865563, []int{56}
 }
 func (m *NatsSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1255,7 +1647,7 @@ var xxx_messageInfo_NatsSource proto.InternalMessageInfo
 func (m *NoStore) Reset()      { *m = NoStore{} }
 func (*NoStore) ProtoMessage() {}
 func (*NoStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{43}
+	return fileDescriptor_9d0d1b17d3865563, []int{57}
 }
 func (m *NoStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1283,7 +1675,7 @@ var xxx_messageInfo_NoStore proto.InternalMessageInfo
 func (m *PBQStorage) Reset()      { *m = PBQStorage{} }
 func (*PBQStorage) ProtoMessage() {}
 func (*PBQStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{44}
+	return fileDescriptor_9d0d1b17d3865563, []int{58}
 }
 func (m *PBQStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1311,7 +1703,7 @@ var xxx_messageInfo_PBQStorage proto.InternalMessageInfo
 func (m *PersistenceStrategy) Reset()      { *m = PersistenceStrategy{} }
 func (*PersistenceStrategy) ProtoMessage() {}
 func (*PersistenceStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{45}
+	return fileDescriptor_9d0d1b17d3865563, []int{59}
 }
 func (m *PersistenceStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1339,7 +1731,7 @@ var xxx_messageInfo_PersistenceStrategy proto.InternalMessageInfo
 func (m *Pipeline) Reset()      { *m = Pipeline{} }
 func (*Pipeline) ProtoMessage() {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{46}
+	return fileDescriptor_9d0d1b17d3865563, []int{60}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1367,7 +1759,7 @@ var xxx_messageInfo_Pipeline proto.InternalMessageInfo
 func (m *PipelineLimits) Reset()      { *m = PipelineLimits{} }
 func (*PipelineLimits) ProtoMessage() {}
 func (*PipelineLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{47}
+	return fileDescriptor_9d0d1b17d3865563, []int{61}
 }
 func (m *PipelineLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1395,7 +1787,7 @@ var xxx_messageInfo_PipelineLimits proto.InternalMessageInfo
 func (m *PipelineList) Reset()      { *m = PipelineList{} }
 func (*PipelineList) ProtoMessage() {}
 func (*PipelineList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{48}
+	return fileDescriptor_9d0d1b17d3865563, []int{62}
 }
 func (m *PipelineList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,7 +1815,7 @@ var xxx_messageInfo_PipelineList proto.InternalMessageInfo
 func (m *PipelineSpec) Reset()      { *m = PipelineSpec{} }
 func (*PipelineSpec) ProtoMessage() {}
 func (*PipelineSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{49}
+	return fileDescriptor_9d0d1b17d3865563, []int{63}
 }
 func (m *PipelineSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1451,7 +1843,7 @@ var xxx_messageInfo_PipelineSpec proto.InternalMessageInfo
 func (m *PipelineStatus) Reset()      { *m = PipelineStatus{} }
 func (*PipelineStatus) ProtoMessage() {}
 func (*PipelineStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{50}
+	return fileDescriptor_9d0d1b17d3865563, []int{64}
 }
 func (m *PipelineStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1476,10 +1868,38 @@ func (m *PipelineStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PipelineStatus proto.InternalMessageInfo
 
+func (m *RateLimit) Reset()      { *m = RateLimit{} }
+func (*RateLimit) ProtoMessage() {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{65}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
 func (m *RedisBufferService) Reset()      { *m = RedisBufferService{} }
 func (*RedisBufferService) ProtoMessage() {}
 func (*RedisBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{51}
+	return fileDescriptor_9d0d1b17d3865563, []int{66}
 }
 func (m *RedisBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1507,7 +1927,7 @@ var xxx_messageInfo_RedisBufferService proto.InternalMessageInfo
 func (m *RedisConfig) Reset()      { *m = RedisConfig{} }
 func (*RedisConfig) ProtoMessage() {}
 func (*RedisConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{52}
+	return fileDescriptor_9d0d1b17d3865563, []int{67}
 }
 func (m *RedisConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1535,7 +1955,7 @@ var xxx_messageInfo_RedisConfig proto.InternalMessageInfo
 func (m *RedisSettings) Reset()      { *m = RedisSettings{} }
 func (*RedisSettings) ProtoMessage() {}
 func (*RedisSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{53}
+	return fileDescriptor_9d0d1b17d3865563, []int{68}
 }
 func (m *RedisSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1560,10 +1980,38 @@ func (m *RedisSettings) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RedisSettings proto.InternalMessageInfo
 
+func (m *RedisStreamsSource) Reset()      { *m = RedisStreamsSource{} }
+func (*RedisStreamsSource) ProtoMessage() {}
+func (*RedisStreamsSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{69}
+}
+func (m *RedisStreamsSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedisStreamsSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RedisStreamsSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedisStreamsSource.Merge(m, src)
+}
+func (m *RedisStreamsSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedisStreamsSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedisStreamsSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedisStreamsSource proto.InternalMessageInfo
+
 func (m *SASL) Reset()      { *m = SASL{} }
 func (*SASL) ProtoMessage() {}
 func (*SASL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{54}
+	return fileDescriptor_9d0d1b17d3865563, []int{70}
 }
 func (m *SASL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1591,7 +2039,7 @@ var xxx_messageInfo_SASL proto.InternalMessageInfo
 func (m *SASLPlain) Reset()      { *m = SASLPlain{} }
 func (*SASLPlain) ProtoMessage() {}
 func (*SASLPlain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{55}
+	return fileDescriptor_9d0d1b17d3865563, []int{71}
 }
 func (m *SASLPlain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1616,10 +2064,66 @@ func (m *SASLPlain) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SASLPlain proto.InternalMessageInfo
 
+func (m *SQLSink) Reset()      { *m = SQLSink{} }
+func (*SQLSink) ProtoMessage() {}
+func (*SQLSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{72}
+}
+func (m *SQLSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SQLSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLSink.Merge(m, src)
+}
+func (m *SQLSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLSink proto.InternalMessageInfo
+
+func (m *SQLSinkColumn) Reset()      { *m = SQLSinkColumn{} }
+func (*SQLSinkColumn) ProtoMessage() {}
+func (*SQLSinkColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{73}
+}
+func (m *SQLSinkColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLSinkColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SQLSinkColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLSinkColumn.Merge(m, src)
+}
+func (m *SQLSinkColumn) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLSinkColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLSinkColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLSinkColumn proto.InternalMessageInfo
+
 func (m *Scale) Reset()      { *m = Scale{} }
 func (*Scale) ProtoMessage() {}
 func (*Scale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{56}
+	return fileDescriptor_9d0d1b17d3865563, []int{74}
 }
 func (m *Scale) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1647,7 +2151,7 @@ var xxx_messageInfo_Scale proto.InternalMessageInfo
 func (m *SessionWindow) Reset()      { *m = SessionWindow{} }
 func (*SessionWindow) ProtoMessage() {}
 func (*SessionWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{57}
+	return fileDescriptor_9d0d1b17d3865563, []int{75}
 }
 func (m *SessionWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1675,7 +2179,7 @@ var xxx_messageInfo_SessionWindow proto.InternalMessageInfo
 func (m *SideInput) Reset()      { *m = SideInput{} }
 func (*SideInput) ProtoMessage() {}
 func (*SideInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{58}
+	return fileDescriptor_9d0d1b17d3865563, []int{76}
 }
 func (m *SideInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1703,7 +2207,7 @@ var xxx_messageInfo_SideInput proto.InternalMessageInfo
 func (m *SideInputTrigger) Reset()      { *m = SideInputTrigger{} }
 func (*SideInputTrigger) ProtoMessage() {}
 func (*SideInputTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{59}
+	return fileDescriptor_9d0d1b17d3865563, []int{77}
 }
 func (m *SideInputTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1731,7 +2235,7 @@ var xxx_messageInfo_SideInputTrigger proto.InternalMessageInfo
 func (m *SideInputsManagerTemplate) Reset()      { *m = SideInputsManagerTemplate{} }
 func (*SideInputsManagerTemplate) ProtoMessage() {}
 func (*SideInputsManagerTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{60}
+	return fileDescriptor_9d0d1b17d3865563, []int{78}
 }
 func (m *SideInputsManagerTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1759,7 +2263,7 @@ var xxx_messageInfo_SideInputsManagerTemplate proto.InternalMessageInfo
 func (m *Sink) Reset()      { *m = Sink{} }
 func (*Sink) ProtoMessage() {}
 func (*Sink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{61}
+	return fileDescriptor_9d0d1b17d3865563, []int{79}
 }
 func (m *Sink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1784,10 +2288,66 @@ func (m *Sink) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Sink proto.InternalMessageInfo
 
+func (m *SinkRetryBackoff) Reset()      { *m = SinkRetryBackoff{} }
+func (*SinkRetryBackoff) ProtoMessage() {}
+func (*SinkRetryBackoff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{80}
+}
+func (m *SinkRetryBackoff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SinkRetryBackoff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SinkRetryBackoff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SinkRetryBackoff.Merge(m, src)
+}
+func (m *SinkRetryBackoff) XXX_Size() int {
+	return m.Size()
+}
+func (m *SinkRetryBackoff) XXX_DiscardUnknown() {
+	xxx_messageInfo_SinkRetryBackoff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SinkRetryBackoff proto.InternalMessageInfo
+
+func (m *SinkRetryStrategy) Reset()      { *m = SinkRetryStrategy{} }
+func (*SinkRetryStrategy) ProtoMessage() {}
+func (*SinkRetryStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{81}
+}
+func (m *SinkRetryStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SinkRetryStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SinkRetryStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SinkRetryStrategy.Merge(m, src)
+}
+func (m *SinkRetryStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SinkRetryStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SinkRetryStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SinkRetryStrategy proto.InternalMessageInfo
+
 func (m *SlidingWindow) Reset()      { *m = SlidingWindow{} }
 func (*SlidingWindow) ProtoMessage() {}
 func (*SlidingWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{62}
+	return fileDescriptor_9d0d1b17d3865563, []int{82}
 }
 func (m *SlidingWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1815,7 +2375,7 @@ var xxx_messageInfo_SlidingWindow proto.InternalMessageInfo
 func (m *Source) Reset()      { *m = Source{} }
 func (*Source) ProtoMessage() {}
 func (*Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{63}
+	return fileDescriptor_9d0d1b17d3865563, []int{83}
 }
 func (m *Source) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1843,7 +2403,7 @@ var xxx_messageInfo_Source proto.InternalMessageInfo
 func (m *Status) Reset()      { *m = Status{} }
 func (*Status) ProtoMessage() {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{64}
+	return fileDescriptor_9d0d1b17d3865563, []int{84}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1871,7 +2431,7 @@ var xxx_messageInfo_Status proto.InternalMessageInfo
 func (m *TLS) Reset()      { *m = TLS{} }
 func (*TLS) ProtoMessage() {}
 func (*TLS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{65}
+	return fileDescriptor_9d0d1b17d3865563, []int{85}
 }
 func (m *TLS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1899,7 +2459,7 @@ var xxx_messageInfo_TLS proto.InternalMessageInfo
 func (m *TagConditions) Reset()      { *m = TagConditions{} }
 func (*TagConditions) ProtoMessage() {}
 func (*TagConditions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{66}
+	return fileDescriptor_9d0d1b17d3865563, []int{86}
 }
 func (m *TagConditions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1927,7 +2487,7 @@ var xxx_messageInfo_TagConditions proto.InternalMessageInfo
 func (m *Templates) Reset()      { *m = Templates{} }
 func (*Templates) ProtoMessage() {}
 func (*Templates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{67}
+	return fileDescriptor_9d0d1b17d3865563, []int{87}
 }
 func (m *Templates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1955,7 +2515,7 @@ var xxx_messageInfo_Templates proto.InternalMessageInfo
 func (m *Transformer) Reset()      { *m = Transformer{} }
 func (*Transformer) ProtoMessage() {}
 func (*Transformer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{68}
+	return fileDescriptor_9d0d1b17d3865563, []int{88}
 }
 func (m *Transformer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1983,7 +2543,7 @@ var xxx_messageInfo_Transformer proto.InternalMessageInfo
 func (m *UDF) Reset()      { *m = UDF{} }
 func (*UDF) ProtoMessage() {}
 func (*UDF) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{69}
+	return fileDescriptor_9d0d1b17d3865563, []int{89}
 }
 func (m *UDF) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2011,7 +2571,7 @@ var xxx_messageInfo_UDF proto.InternalMessageInfo
 func (m *UDSink) Reset()      { *m = UDSink{} }
 func (*UDSink) ProtoMessage() {}
 func (*UDSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{70}
+	return fileDescriptor_9d0d1b17d3865563, []int{90}
 }
 func (m *UDSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2039,7 +2599,7 @@ var xxx_messageInfo_UDSink proto.InternalMessageInfo
 func (m *UDSource) Reset()      { *m = UDSource{} }
 func (*UDSource) ProtoMessage() {}
 func (*UDSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{71}
+	return fileDescriptor_9d0d1b17d3865563, []int{91}
 }
 func (m *UDSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2067,7 +2627,7 @@ var xxx_messageInfo_UDSource proto.InternalMessageInfo
 func (m *UDTransformer) Reset()      { *m = UDTransformer{} }
 func (*UDTransformer) ProtoMessage() {}
 func (*UDTransformer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{72}
+	return fileDescriptor_9d0d1b17d3865563, []int{92}
 }
 func (m *UDTransformer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2095,7 +2655,7 @@ var xxx_messageInfo_UDTransformer proto.InternalMessageInfo
 func (m *Vertex) Reset()      { *m = Vertex{} }
 func (*Vertex) ProtoMessage() {}
 func (*Vertex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{73}
+	return fileDescriptor_9d0d1b17d3865563, []int{93}
 }
 func (m *Vertex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2123,7 +2683,7 @@ var xxx_messageInfo_Vertex proto.InternalMessageInfo
 func (m *VertexInstance) Reset()      { *m = VertexInstance{} }
 func (*VertexInstance) ProtoMessage() {}
 func (*VertexInstance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{74}
+	return fileDescriptor_9d0d1b17d3865563, []int{94}
 }
 func (m *VertexInstance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2151,7 +2711,7 @@ var xxx_messageInfo_VertexInstance proto.InternalMessageInfo
 func (m *VertexLimits) Reset()      { *m = VertexLimits{} }
 func (*VertexLimits) ProtoMessage() {}
 func (*VertexLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{75}
+	return fileDescriptor_9d0d1b17d3865563, []int{95}
 }
 func (m *VertexLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2179,7 +2739,7 @@ var xxx_messageInfo_VertexLimits proto.InternalMessageInfo
 func (m *VertexList) Reset()      { *m = VertexList{} }
 func (*VertexList) ProtoMessage() {}
 func (*VertexList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{76}
+	return fileDescriptor_9d0d1b17d3865563, []int{96}
 }
 func (m *VertexList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2207,7 +2767,7 @@ var xxx_messageInfo_VertexList proto.InternalMessageInfo
 func (m *VertexSpec) Reset()      { *m = VertexSpec{} }
 func (*VertexSpec) ProtoMessage() {}
 func (*VertexSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{77}
+	return fileDescriptor_9d0d1b17d3865563, []int{97}
 }
 func (m *VertexSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2235,7 +2795,7 @@ var xxx_messageInfo_VertexSpec proto.InternalMessageInfo
 func (m *VertexStatus) Reset()      { *m = VertexStatus{} }
 func (*VertexStatus) ProtoMessage() {}
 func (*VertexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{78}
+	return fileDescriptor_9d0d1b17d3865563, []int{98}
 }
 func (m *VertexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2263,7 +2823,7 @@ var xxx_messageInfo_VertexStatus proto.InternalMessageInfo
 func (m *VertexTemplate) Reset()      { *m = VertexTemplate{} }
 func (*VertexTemplate) ProtoMessage() {}
 func (*VertexTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{79}
+	return fileDescriptor_9d0d1b17d3865563, []int{99}
 }
 func (m *VertexTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2291,7 +2851,7 @@ var xxx_messageInfo_VertexTemplate proto.InternalMessageInfo
 func (m *Watermark) Reset()      { *m = Watermark{} }
 func (*Watermark) ProtoMessage() {}
 func (*Watermark) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{80}
+	return fileDescriptor_9d0d1b17d3865563, []int{100}
 }
 func (m *Watermark) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2319,7 +2879,7 @@ var xxx_messageInfo_Watermark proto.InternalMessageInfo
 func (m *Window) Reset()      { *m = Window{} }
 func (*Window) ProtoMessage() {}
 func (*Window) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{81}
+	return fileDescriptor_9d0d1b17d3865563, []int{101}
 }
 func (m *Window) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2358,11 +2918,16 @@ func init() {
 	proto.RegisterType((*ContainerTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ContainerTemplate")
 	proto.RegisterType((*DaemonTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.DaemonTemplate")
 	proto.RegisterType((*Edge)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Edge")
+	proto.RegisterType((*FileSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.FileSink")
+	proto.RegisterType((*FileSinkRotation)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.FileSinkRotation")
+	proto.RegisterType((*FileSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.FileSource")
 	proto.RegisterType((*FixedWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.FixedWindow")
 	proto.RegisterType((*ForwardConditions)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ForwardConditions")
 	proto.RegisterType((*Function)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Function")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Function.KwargsEntry")
 	proto.RegisterType((*GSSAPI)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GSSAPI")
+	proto.RegisterType((*GeneratorKeyDistribution)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GeneratorKeyDistribution")
+	proto.RegisterType((*GeneratorLateData)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GeneratorLateData")
 	proto.RegisterType((*GeneratorSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GeneratorSource")
 	proto.RegisterType((*GetDaemonDeploymentReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetDaemonDeploymentReq")
 	proto.RegisterType((*GetJetStreamServiceSpecReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetJetStreamServiceSpecReq")
@@ -2376,6 +2941,10 @@ func init() {
 	proto.RegisterType((*GetSideInputDeploymentReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetSideInputDeploymentReq")
 	proto.RegisterType((*GetVertexPodSpecReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetVertexPodSpecReq")
 	proto.RegisterType((*GroupBy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GroupBy")
+	proto.RegisterType((*HTTPSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSink")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSink.HeadersEntry")
+	proto.RegisterType((*HTTPSinkAuth)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSinkAuth")
+	proto.RegisterType((*HTTPSinkBatch)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSinkBatch")
 	proto.RegisterType((*HTTPSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSource")
 	proto.RegisterType((*IdleSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.IdleSource")
 	proto.RegisterType((*InterStepBufferService)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.InterStepBufferService")
@@ -2384,10 +2953,16 @@ func init() {
 	proto.RegisterType((*InterStepBufferServiceStatus)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.InterStepBufferServiceStatus")
 	proto.RegisterType((*JetStreamBufferService)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.JetStreamBufferService")
 	proto.RegisterType((*JetStreamConfig)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.JetStreamConfig")
+	proto.RegisterType((*JetStreamSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.JetStreamSink")
 	proto.RegisterType((*JetStreamSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.JetStreamSource")
 	proto.RegisterType((*JobTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.JobTemplate")
+	proto.RegisterType((*KafkaRewind)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaRewind")
 	proto.RegisterType((*KafkaSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaSink")
+	proto.RegisterType((*KafkaSinkKey)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaSinkKey")
+	proto.RegisterType((*KafkaSinkTransaction)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaSinkTransaction")
 	proto.RegisterType((*KafkaSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaSource")
+	proto.RegisterType((*KafkaStartPosition)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaStartPosition")
+	proto.RegisterMapType((map[string]int64)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaStartPosition.OffsetsEntry")
 	proto.RegisterType((*Lifecycle)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Lifecycle")
 	proto.RegisterType((*Log)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Log")
 	proto.RegisterType((*Metadata)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Metadata")
@@ -2395,6 +2970,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Metadata.LabelsEntry")
 	proto.RegisterType((*NativeRedis)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.NativeRedis")
 	proto.RegisterType((*NatsAuth)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.NatsAuth")
+	proto.RegisterType((*NatsSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.NatsSink")
 	proto.RegisterType((*NatsSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.NatsSource")
 	proto.RegisterType((*NoStore)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.NoStore")
 	proto.RegisterType((*PBQStorage)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PBQStorage")
@@ -2404,17 +2980,23 @@ func init() {
 	proto.RegisterType((*PipelineList)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PipelineList")
 	proto.RegisterType((*PipelineSpec)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PipelineSpec")
 	proto.RegisterType((*PipelineStatus)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PipelineStatus")
+	proto.RegisterType((*RateLimit)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RateLimit")
 	proto.RegisterType((*RedisBufferService)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RedisBufferService")
 	proto.RegisterType((*RedisConfig)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RedisConfig")
 	proto.RegisterType((*RedisSettings)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RedisSettings")
+	proto.RegisterType((*RedisStreamsSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RedisStreamsSource")
 	proto.RegisterType((*SASL)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SASL")
 	proto.RegisterType((*SASLPlain)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SASLPlain")
+	proto.RegisterType((*SQLSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SQLSink")
+	proto.RegisterType((*SQLSinkColumn)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SQLSinkColumn")
 	proto.RegisterType((*Scale)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Scale")
 	proto.RegisterType((*SessionWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SessionWindow")
 	proto.RegisterType((*SideInput)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SideInput")
 	proto.RegisterType((*SideInputTrigger)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SideInputTrigger")
 	proto.RegisterType((*SideInputsManagerTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SideInputsManagerTemplate")
 	proto.RegisterType((*Sink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Sink")
+	proto.RegisterType((*SinkRetryBackoff)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SinkRetryBackoff")
+	proto.RegisterType((*SinkRetryStrategy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SinkRetryStrategy")
 	proto.RegisterType((*SlidingWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SlidingWindow")
 	proto.RegisterType((*Source)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Source")
 	proto.RegisterType((*Status)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Status")
@@ -2443,444 +3025,562 @@ func init() {
 }
 
 var fileDescriptor_9d0d1b17d3865563 = []byte{
-	// 6986 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x24, 0xc7,
-	0x75, 0xa8, 0xe6, 0xc9, 0x99, 0x33, 0xe4, 0x2e, 0xb7, 0x56, 0x5a, 0x71, 0xa9, 0xd5, 0x72, 0xdd,
-	0xbe, 0xd2, 0x5d, 0x5f, 0xdb, 0xe4, 0x15, 0xaf, 0x64, 0xc9, 0x37, 0xb6, 0x25, 0x0e, 0xb9, 0xa4,
-	0xa8, 0x25, 0x77, 0xe9, 0x33, 0xe4, 0x4a, 0xb6, 0x62, 0x2b, 0xcd, 0xee, 0xe2, 0xb0, 0x35, 0x3d,
-	0xdd, 0xe3, 0xee, 0x1e, 0xee, 0x52, 0x8e, 0xe1, 0xd7, 0x87, 0x14, 0x24, 0x46, 0x02, 0x7f, 0x19,
-	0x08, 0x9c, 0x20, 0x41, 0x80, 0x7c, 0x18, 0xfe, 0x09, 0xe0, 0x7c, 0x04, 0x08, 0x92, 0xfc, 0x04,
-	0xce, 0xdb, 0x1f, 0x01, 0xec, 0x20, 0x00, 0x11, 0x33, 0xc8, 0x47, 0x12, 0xc4, 0x30, 0x62, 0x20,
-	0x71, 0x16, 0x01, 0x1c, 0xd4, 0xab, 0x5f, 0xd3, 0xb3, 0x4b, 0x4e, 0x93, 0xeb, 0x75, 0xe2, 0xbf,
-	0xe9, 0xaa, 0x53, 0xe7, 0x54, 0x9f, 0xae, 0xaa, 0xf3, 0xac, 0x33, 0xb0, 0xd2, 0xb6, 0x82, 0xdd,
-	0xfe, 0xf6, 0xac, 0xe1, 0x76, 0xe7, 0x9c, 0x7e, 0x57, 0xef, 0x79, 0xee, 0x9b, 0xfc, 0xc7, 0x8e,
-	0xed, 0xde, 0x9e, 0xeb, 0x75, 0xda, 0x73, 0x7a, 0xcf, 0xf2, 0xa3, 0x96, 0xbd, 0x67, 0x74, 0xbb,
-	0xb7, 0xab, 0x3f, 0x33, 0xd7, 0xa6, 0x0e, 0xf5, 0xf4, 0x80, 0x9a, 0xb3, 0x3d, 0xcf, 0x0d, 0x5c,
-	0xf2, 0x7c, 0x84, 0x68, 0x56, 0x21, 0x9a, 0x55, 0xc3, 0x66, 0x7b, 0x9d, 0xf6, 0x2c, 0x43, 0x14,
-	0xb5, 0x28, 0x44, 0xd3, 0xef, 0x8f, 0xcd, 0xa0, 0xed, 0xb6, 0xdd, 0x39, 0x8e, 0x6f, 0xbb, 0xbf,
-	0xc3, 0x9f, 0xf8, 0x03, 0xff, 0x25, 0xe8, 0x4c, 0x6b, 0x9d, 0x17, 0xfc, 0x59, 0xcb, 0x65, 0xd3,
-	0x9a, 0x33, 0x5c, 0x8f, 0xce, 0xed, 0x0d, 0xcc, 0x65, 0xfa, 0xd9, 0x08, 0xa6, 0xab, 0x1b, 0xbb,
-	0x96, 0x43, 0xbd, 0x7d, 0xf5, 0x2e, 0x73, 0x1e, 0xf5, 0xdd, 0xbe, 0x67, 0xd0, 0x63, 0x8d, 0xf2,
-	0xe7, 0xba, 0x34, 0xd0, 0xb3, 0x68, 0xcd, 0x0d, 0x1b, 0xe5, 0xf5, 0x9d, 0xc0, 0xea, 0x0e, 0x92,
-	0xf9, 0xc0, 0xfd, 0x06, 0xf8, 0xc6, 0x2e, 0xed, 0xea, 0xe9, 0x71, 0xda, 0xdf, 0xd6, 0xe1, 0xfc,
-	0xc2, 0xb6, 0x1f, 0x78, 0xba, 0x11, 0x6c, 0xb8, 0xe6, 0x26, 0xed, 0xf6, 0x6c, 0x3d, 0xa0, 0xa4,
-	0x03, 0x35, 0x36, 0x37, 0x53, 0x0f, 0xf4, 0xa9, 0xc2, 0x95, 0xc2, 0xd5, 0xc6, 0xfc, 0xc2, 0xec,
-	0x88, 0xdf, 0x62, 0x76, 0x5d, 0x22, 0x6a, 0x8e, 0x1f, 0x1e, 0xcc, 0xd4, 0xd4, 0x13, 0x86, 0x04,
-	0xc8, 0x57, 0x0a, 0x30, 0xee, 0xb8, 0x26, 0x6d, 0x51, 0x9b, 0x1a, 0x81, 0xeb, 0x4d, 0x15, 0xaf,
-	0x94, 0xae, 0x36, 0xe6, 0x3f, 0x39, 0x32, 0xc5, 0x8c, 0x37, 0x9a, 0xbd, 0x11, 0x23, 0x70, 0xcd,
-	0x09, 0xbc, 0xfd, 0xe6, 0xa3, 0xdf, 0x3c, 0x98, 0x79, 0xe4, 0xf0, 0x60, 0x66, 0x3c, 0xde, 0x85,
-	0x89, 0x99, 0x90, 0x2d, 0x68, 0x04, 0xae, 0xcd, 0x58, 0x66, 0xb9, 0x8e, 0x3f, 0x55, 0xe2, 0x13,
-	0xbb, 0x3c, 0x2b, 0xb8, 0xcd, 0xc8, 0xcf, 0xb2, 0xe5, 0x32, 0xbb, 0xf7, 0xcc, 0xec, 0x66, 0x08,
-	0xd6, 0x3c, 0x2f, 0x11, 0x37, 0xa2, 0x36, 0x1f, 0xe3, 0x78, 0x08, 0x85, 0xb3, 0x3e, 0x35, 0xfa,
-	0x9e, 0x15, 0xec, 0x2f, 0xba, 0x4e, 0x40, 0xef, 0x04, 0x53, 0x65, 0xce, 0xe5, 0xa7, 0xb3, 0x50,
-	0x6f, 0xb8, 0x66, 0x2b, 0x09, 0xdd, 0x3c, 0x7f, 0x78, 0x30, 0x73, 0x36, 0xd5, 0x88, 0x69, 0x9c,
-	0xc4, 0x81, 0x49, 0xab, 0xab, 0xb7, 0xe9, 0x46, 0xdf, 0xb6, 0x5b, 0xd4, 0xf0, 0x68, 0xe0, 0x4f,
-	0x55, 0xf8, 0x2b, 0x5c, 0xcd, 0xa2, 0xb3, 0xe6, 0x1a, 0xba, 0x7d, 0x73, 0xfb, 0x4d, 0x6a, 0x04,
-	0x48, 0x77, 0xa8, 0x47, 0x1d, 0x83, 0x36, 0xa7, 0xe4, 0xcb, 0x4c, 0xae, 0xa6, 0x30, 0xe1, 0x00,
-	0x6e, 0xb2, 0x02, 0xe7, 0x7a, 0x9e, 0xe5, 0xf2, 0x29, 0xd8, 0xba, 0xef, 0xdf, 0xd0, 0xbb, 0x74,
-	0xaa, 0x7a, 0xa5, 0x70, 0xb5, 0xde, 0xbc, 0x28, 0xd1, 0x9c, 0xdb, 0x48, 0x03, 0xe0, 0xe0, 0x18,
-	0x72, 0x15, 0x6a, 0xaa, 0x71, 0x6a, 0xec, 0x4a, 0xe1, 0x6a, 0x45, 0xac, 0x1d, 0x35, 0x16, 0xc3,
-	0x5e, 0xb2, 0x0c, 0x35, 0x7d, 0x67, 0xc7, 0x72, 0x18, 0x64, 0x8d, 0xb3, 0xf0, 0x52, 0xd6, 0xab,
-	0x2d, 0x48, 0x18, 0x81, 0x47, 0x3d, 0x61, 0x38, 0x96, 0xbc, 0x02, 0xc4, 0xa7, 0xde, 0x9e, 0x65,
-	0xd0, 0x05, 0xc3, 0x70, 0xfb, 0x4e, 0xc0, 0xe7, 0x5e, 0xe7, 0x73, 0x9f, 0x96, 0x73, 0x27, 0xad,
-	0x01, 0x08, 0xcc, 0x18, 0x45, 0x5e, 0x82, 0x49, 0xb9, 0xed, 0x22, 0x2e, 0x00, 0xc7, 0xf4, 0x28,
-	0x63, 0x24, 0xa6, 0xfa, 0x70, 0x00, 0x9a, 0x98, 0x70, 0x49, 0xef, 0x07, 0x6e, 0x97, 0xa1, 0x4c,
-	0x12, 0xdd, 0x74, 0x3b, 0xd4, 0x99, 0x6a, 0x5c, 0x29, 0x5c, 0xad, 0x35, 0xaf, 0x1c, 0x1e, 0xcc,
-	0x5c, 0x5a, 0xb8, 0x07, 0x1c, 0xde, 0x13, 0x0b, 0xb9, 0x09, 0x75, 0xd3, 0xf1, 0x37, 0x5c, 0xdb,
-	0x32, 0xf6, 0xa7, 0xc6, 0xf9, 0x04, 0x9f, 0x91, 0xaf, 0x5a, 0x5f, 0xba, 0xd1, 0x12, 0x1d, 0x77,
-	0x0f, 0x66, 0x2e, 0x0d, 0x9e, 0x8e, 0xb3, 0x61, 0x3f, 0x46, 0x38, 0xc8, 0x3a, 0x47, 0xb8, 0xe8,
-	0x3a, 0x3b, 0x56, 0x7b, 0x6a, 0x82, 0x7f, 0x8d, 0x2b, 0x43, 0x16, 0xf4, 0xd2, 0x8d, 0x96, 0x80,
-	0x6b, 0x4e, 0x48, 0x72, 0xe2, 0x11, 0x23, 0x0c, 0xd3, 0x2f, 0xc2, 0xb9, 0x81, 0x5d, 0x4b, 0x26,
-	0xa1, 0xd4, 0xa1, 0xfb, 0xfc, 0x50, 0xaa, 0x23, 0xfb, 0x49, 0x1e, 0x85, 0xca, 0x9e, 0x6e, 0xf7,
-	0xe9, 0x54, 0x91, 0xb7, 0x89, 0x87, 0xff, 0x5f, 0x7c, 0xa1, 0xa0, 0xfd, 0x66, 0x09, 0xc6, 0xd5,
-	0x59, 0xd0, 0xb2, 0x9c, 0x0e, 0x79, 0x15, 0x4a, 0xb6, 0xdb, 0x96, 0x27, 0xda, 0x87, 0x46, 0x3e,
-	0x5f, 0xd6, 0xdc, 0x76, 0x73, 0xec, 0xf0, 0x60, 0xa6, 0xb4, 0xe6, 0xb6, 0x91, 0x61, 0x24, 0x06,
-	0x54, 0x3a, 0xfa, 0x4e, 0x47, 0xe7, 0x73, 0x68, 0xcc, 0x37, 0x47, 0x46, 0x7d, 0x9d, 0x61, 0x61,
-	0x73, 0x6d, 0xd6, 0x0f, 0x0f, 0x66, 0x2a, 0xfc, 0x11, 0x05, 0x6e, 0xe2, 0x42, 0x7d, 0xdb, 0xd6,
-	0x8d, 0xce, 0xae, 0x6b, 0xd3, 0xa9, 0x52, 0x4e, 0x42, 0x4d, 0x85, 0x49, 0x7c, 0x80, 0xf0, 0x11,
-	0x23, 0x1a, 0xc4, 0x80, 0x6a, 0xdf, 0xf4, 0x2d, 0xa7, 0x23, 0x4f, 0xa7, 0x17, 0x47, 0xa6, 0xb6,
-	0xb5, 0xc4, 0xdf, 0x09, 0x0e, 0x0f, 0x66, 0xaa, 0xe2, 0x37, 0x4a, 0xd4, 0xda, 0xf7, 0x1a, 0x70,
-	0x46, 0x7d, 0xa4, 0x5b, 0xd4, 0x0b, 0xe8, 0x1d, 0x72, 0x05, 0xca, 0x0e, 0xdb, 0x34, 0xfc, 0x23,
-	0x37, 0xc7, 0xe5, 0x9a, 0x2c, 0xf3, 0xcd, 0xc2, 0x7b, 0xd8, 0xcc, 0x84, 0xc0, 0x95, 0x0c, 0x1f,
-	0x7d, 0x66, 0x2d, 0x8e, 0x46, 0xcc, 0x4c, 0xfc, 0x46, 0x89, 0x9a, 0xbc, 0x0e, 0x65, 0xfe, 0xf2,
-	0x82, 0xd5, 0x1f, 0x1e, 0x9d, 0x04, 0x7b, 0xf5, 0x1a, 0x7b, 0x03, 0xfe, 0xe2, 0x1c, 0x29, 0x5b,
-	0x8a, 0x7d, 0x73, 0x47, 0x32, 0xf6, 0x43, 0x39, 0x18, 0xbb, 0x2c, 0x96, 0xe2, 0xd6, 0xd2, 0x32,
-	0x32, 0x8c, 0xe4, 0x97, 0x0b, 0x70, 0xce, 0x70, 0x9d, 0x40, 0x67, 0x4a, 0x80, 0x12, 0x7f, 0x53,
-	0x15, 0x4e, 0xe7, 0x95, 0x91, 0xe9, 0x2c, 0xa6, 0x31, 0x36, 0x1f, 0x63, 0xa7, 0xf9, 0x40, 0x33,
-	0x0e, 0xd2, 0x26, 0xbf, 0x5a, 0x80, 0xc7, 0xd8, 0x29, 0x3b, 0x00, 0xcc, 0x65, 0xc3, 0xc9, 0xce,
-	0xea, 0xe2, 0xe1, 0xc1, 0xcc, 0x63, 0xab, 0x59, 0xc4, 0x30, 0x7b, 0x0e, 0x6c, 0x76, 0xe7, 0xf5,
-	0x41, 0x85, 0x81, 0xcb, 0x9d, 0xc6, 0xfc, 0xda, 0x49, 0x2a, 0x21, 0xcd, 0x27, 0xe4, 0x52, 0xce,
-	0xd2, 0xb9, 0x30, 0x6b, 0x16, 0xe4, 0x1a, 0x8c, 0xed, 0xb9, 0x76, 0xbf, 0x4b, 0xfd, 0xa9, 0x1a,
-	0x97, 0xdc, 0xd3, 0x59, 0x07, 0xea, 0x2d, 0x0e, 0xd2, 0x3c, 0x2b, 0xd1, 0x8f, 0x89, 0x67, 0x1f,
-	0xd5, 0x58, 0x62, 0x41, 0xd5, 0xb6, 0xba, 0x56, 0xe0, 0x73, 0x91, 0xd6, 0x98, 0xbf, 0x36, 0xf2,
-	0x6b, 0x89, 0x2d, 0xba, 0xc6, 0x91, 0x89, 0x5d, 0x23, 0x7e, 0xa3, 0x24, 0xc0, 0x8e, 0x42, 0xdf,
-	0xd0, 0x6d, 0x21, 0xf2, 0x1a, 0xf3, 0x1f, 0x19, 0x7d, 0xdb, 0x30, 0x2c, 0xcd, 0x09, 0xf9, 0x4e,
-	0x15, 0xfe, 0x88, 0x02, 0x37, 0xf9, 0x04, 0x9c, 0x49, 0x7c, 0x4d, 0x7f, 0xaa, 0xc1, 0xb9, 0xf3,
-	0x64, 0x16, 0x77, 0x42, 0xa8, 0xe6, 0x05, 0x89, 0xec, 0x4c, 0x62, 0x85, 0xf8, 0x98, 0x42, 0x46,
-	0xae, 0x43, 0xcd, 0xb7, 0x4c, 0x6a, 0xe8, 0x9e, 0x3f, 0x35, 0x7e, 0x14, 0xc4, 0x93, 0x12, 0x71,
-	0xad, 0x25, 0x87, 0x61, 0x88, 0x80, 0xcc, 0x02, 0xf4, 0x74, 0x2f, 0xb0, 0x84, 0x0a, 0x39, 0xc1,
-	0xd5, 0x99, 0x33, 0x87, 0x07, 0x33, 0xb0, 0x11, 0xb6, 0x62, 0x0c, 0x82, 0xc1, 0xb3, 0xb1, 0xab,
-	0x4e, 0xaf, 0x1f, 0xf8, 0x53, 0x67, 0xae, 0x94, 0xae, 0xd6, 0x05, 0x7c, 0x2b, 0x6c, 0xc5, 0x18,
-	0x04, 0xf9, 0x7a, 0x01, 0x9e, 0x88, 0x1e, 0x07, 0x37, 0xd9, 0xd9, 0x13, 0xdf, 0x64, 0x33, 0x87,
-	0x07, 0x33, 0x4f, 0xb4, 0x86, 0x93, 0xc4, 0x7b, 0xcd, 0x47, 0x7b, 0x15, 0x26, 0x16, 0xfa, 0xc1,
-	0xae, 0xeb, 0x59, 0x6f, 0x71, 0x75, 0x98, 0x2c, 0x43, 0x25, 0xe0, 0x6a, 0x8d, 0x90, 0xcb, 0x4f,
-	0x65, 0xb1, 0x5a, 0xa8, 0x98, 0xd7, 0xe9, 0xbe, 0xd2, 0x06, 0x84, 0x7c, 0x14, 0x6a, 0x8e, 0x18,
-	0xae, 0xfd, 0x46, 0x01, 0xea, 0x4d, 0xdd, 0xb7, 0x0c, 0x86, 0x9e, 0x2c, 0x42, 0xb9, 0xef, 0x53,
-	0xef, 0x78, 0x48, 0xf9, 0x29, 0xbd, 0xe5, 0x53, 0x0f, 0xf9, 0x60, 0x72, 0x13, 0x6a, 0x3d, 0xdd,
-	0xf7, 0x6f, 0xbb, 0x9e, 0x29, 0x25, 0xcd, 0x11, 0x11, 0x09, 0x7d, 0x55, 0x0e, 0xc5, 0x10, 0x89,
-	0xd6, 0x80, 0x48, 0xd4, 0x6a, 0x3f, 0x28, 0xc0, 0xf9, 0x66, 0x7f, 0x67, 0x87, 0x7a, 0x52, 0x3d,
-	0x13, 0x8a, 0x0f, 0xa1, 0x50, 0xf1, 0xa8, 0x69, 0xf9, 0x72, 0xee, 0x4b, 0x23, 0x7f, 0x3a, 0x64,
-	0x58, 0xa4, 0x9e, 0xc5, 0xf9, 0xc5, 0x1b, 0x50, 0x60, 0x27, 0x7d, 0xa8, 0xbf, 0x49, 0x03, 0x3f,
-	0xf0, 0xa8, 0xde, 0x95, 0x6f, 0xf7, 0xf2, 0xc8, 0xa4, 0x5e, 0xa1, 0x41, 0x8b, 0x63, 0x8a, 0xab,
-	0x75, 0x61, 0x23, 0x46, 0x94, 0xb4, 0x3f, 0xaa, 0xc0, 0xf8, 0xa2, 0xdb, 0xdd, 0xb6, 0x1c, 0x6a,
-	0x5e, 0x33, 0xdb, 0x94, 0xbc, 0x01, 0x65, 0x6a, 0xb6, 0xa9, 0x7c, 0xdb, 0xd1, 0xe5, 0x2c, 0x43,
-	0x16, 0x69, 0x0b, 0xec, 0x09, 0x39, 0x62, 0xb2, 0x06, 0x67, 0x76, 0x3c, 0xb7, 0x2b, 0x8e, 0xae,
-	0xcd, 0xfd, 0x9e, 0x54, 0x15, 0x9b, 0xff, 0x4b, 0x1d, 0x07, 0xcb, 0x89, 0xde, 0xbb, 0x07, 0x33,
-	0x10, 0x3d, 0x61, 0x6a, 0x2c, 0x79, 0x0d, 0xa6, 0xa2, 0x96, 0x70, 0x0f, 0x2f, 0x32, 0xbd, 0x9a,
-	0xab, 0x0a, 0x95, 0xe6, 0xa5, 0xc3, 0x83, 0x99, 0xa9, 0xe5, 0x21, 0x30, 0x38, 0x74, 0x34, 0x79,
-	0xbb, 0x00, 0x93, 0x51, 0xa7, 0x38, 0x57, 0xa5, 0x86, 0x70, 0x42, 0x07, 0x36, 0x37, 0x40, 0x96,
-	0x53, 0x24, 0x70, 0x80, 0x28, 0x59, 0x86, 0xf1, 0xc0, 0x8d, 0xf1, 0xab, 0xc2, 0xf9, 0xa5, 0x29,
-	0x8b, 0x79, 0xd3, 0x1d, 0xca, 0xad, 0xc4, 0x38, 0x82, 0x70, 0x41, 0x3d, 0xa7, 0x38, 0x55, 0xe5,
-	0x9c, 0x9a, 0x3e, 0x3c, 0x98, 0xb9, 0xb0, 0x99, 0x09, 0x81, 0x43, 0x46, 0x92, 0xcf, 0x17, 0xe0,
-	0x8c, 0xea, 0x92, 0x3c, 0x1a, 0x3b, 0x49, 0x1e, 0x11, 0xb6, 0x22, 0x36, 0x13, 0x04, 0x30, 0x45,
-	0x50, 0xfb, 0x61, 0x19, 0xea, 0xe1, 0xc9, 0x46, 0xde, 0x0d, 0x15, 0x6e, 0x0b, 0x4b, 0x85, 0x35,
-	0x14, 0x59, 0xdc, 0x64, 0x46, 0xd1, 0x47, 0x9e, 0x82, 0x31, 0xc3, 0xed, 0x76, 0x75, 0xc7, 0xe4,
-	0xfe, 0x8d, 0x7a, 0xb3, 0xc1, 0x24, 0xf5, 0xa2, 0x68, 0x42, 0xd5, 0x47, 0x2e, 0x41, 0x59, 0xf7,
-	0xda, 0xc2, 0xd5, 0x50, 0x17, 0xe7, 0xd1, 0x82, 0xd7, 0xf6, 0x91, 0xb7, 0x92, 0x0f, 0x42, 0x89,
-	0x3a, 0x7b, 0x53, 0xe5, 0xe1, 0xaa, 0xc0, 0x35, 0x67, 0xef, 0x96, 0xee, 0x35, 0x1b, 0x72, 0x0e,
-	0xa5, 0x6b, 0xce, 0x1e, 0xb2, 0x31, 0x64, 0x0d, 0xc6, 0xa8, 0xb3, 0xc7, 0xbe, 0xbd, 0xf4, 0x01,
-	0xbc, 0x6b, 0xc8, 0x70, 0x06, 0x22, 0xb5, 0xe2, 0x50, 0xa1, 0x90, 0xcd, 0xa8, 0x50, 0x90, 0x8f,
-	0xc1, 0xb8, 0xd0, 0x2d, 0xd6, 0xd9, 0x37, 0xf1, 0xa7, 0xaa, 0x1c, 0xe5, 0xcc, 0x70, 0xe5, 0x84,
-	0xc3, 0x45, 0x3e, 0x97, 0x58, 0xa3, 0x8f, 0x09, 0x54, 0xe4, 0x63, 0x50, 0x57, 0xee, 0x34, 0xf5,
-	0x65, 0x33, 0xdd, 0x15, 0x28, 0x81, 0x90, 0x7e, 0xaa, 0x6f, 0x79, 0xb4, 0x4b, 0x9d, 0xc0, 0x6f,
-	0x9e, 0x53, 0x06, 0xac, 0xea, 0xf5, 0x31, 0xc2, 0x46, 0xb6, 0x07, 0xfd, 0x2e, 0xc2, 0x69, 0xf0,
-	0xee, 0x21, 0xa7, 0xfa, 0x08, 0x4e, 0x97, 0x4f, 0xc2, 0xd9, 0xd0, 0x31, 0x22, 0x6d, 0x6b, 0xe1,
-	0x46, 0x78, 0x96, 0x0d, 0x5f, 0x4d, 0x76, 0xdd, 0x3d, 0x98, 0x79, 0x32, 0xc3, 0xba, 0x8e, 0x00,
-	0x30, 0x8d, 0x4c, 0xfb, 0x83, 0x12, 0x0c, 0xaa, 0xdd, 0x49, 0xa6, 0x15, 0x4e, 0x9a, 0x69, 0xe9,
-	0x17, 0x12, 0xc7, 0xe7, 0x0b, 0x72, 0x58, 0xfe, 0x97, 0xca, 0xfa, 0x30, 0xa5, 0x93, 0xfe, 0x30,
-	0x0f, 0xcb, 0xde, 0xd1, 0xde, 0x29, 0xc3, 0x99, 0x25, 0x9d, 0x76, 0x5d, 0xe7, 0xbe, 0x46, 0x48,
-	0xe1, 0xa1, 0x30, 0x42, 0xae, 0x42, 0xcd, 0xa3, 0x3d, 0xdb, 0x32, 0x74, 0x9f, 0x7f, 0x7a, 0xe9,
-	0x8e, 0x43, 0xd9, 0x86, 0x61, 0xef, 0x10, 0xe3, 0xb3, 0xf4, 0x50, 0x1a, 0x9f, 0xe5, 0x1f, 0xbf,
-	0xf1, 0xa9, 0x7d, 0xbe, 0x08, 0x5c, 0x51, 0x21, 0x57, 0xa0, 0xcc, 0x84, 0x70, 0xda, 0xe5, 0xc1,
-	0x17, 0x0e, 0xef, 0x21, 0xd3, 0x50, 0x0c, 0x5c, 0xb9, 0xf3, 0x40, 0xf6, 0x17, 0x37, 0x5d, 0x2c,
-	0x06, 0x2e, 0x79, 0x0b, 0xc0, 0x70, 0x1d, 0xd3, 0x52, 0x5e, 0xea, 0x7c, 0x2f, 0xb6, 0xec, 0x7a,
-	0xb7, 0x75, 0xcf, 0x5c, 0x0c, 0x31, 0x0a, 0xf3, 0x23, 0x7a, 0xc6, 0x18, 0x35, 0xf2, 0x22, 0x54,
-	0x5d, 0x67, 0xb9, 0x6f, 0xdb, 0x9c, 0xa1, 0xf5, 0xe6, 0xff, 0x66, 0x36, 0xe1, 0x4d, 0xde, 0x72,
-	0xf7, 0x60, 0xe6, 0xa2, 0xd0, 0x6f, 0xd9, 0xd3, 0xab, 0x9e, 0x15, 0x58, 0x4e, 0xbb, 0x15, 0x78,
-	0x7a, 0x40, 0xdb, 0xfb, 0x28, 0x87, 0x69, 0x5f, 0x2e, 0x40, 0x63, 0xd9, 0xba, 0x43, 0xcd, 0x57,
-	0x2d, 0xc7, 0x74, 0x6f, 0x13, 0x84, 0xaa, 0x4d, 0x9d, 0x76, 0xb0, 0x2b, 0x57, 0xff, 0x6c, 0x6c,
-	0xaf, 0x85, 0xc1, 0x8d, 0x68, 0xfe, 0x5d, 0x1a, 0xe8, 0x6c, 0xf7, 0x2d, 0xf5, 0xa5, 0xfb, 0x5d,
-	0x18, 0xa5, 0x1c, 0x03, 0x4a, 0x4c, 0x64, 0x0e, 0xea, 0x42, 0xfb, 0xb4, 0x9c, 0x36, 0xe7, 0x61,
-	0x2d, 0x3a, 0xf4, 0x5a, 0xaa, 0x03, 0x23, 0x18, 0x6d, 0x1f, 0xce, 0x0d, 0xb0, 0x81, 0x98, 0x50,
-	0x0e, 0xf4, 0xb6, 0x3a, 0x5f, 0x97, 0x47, 0x66, 0xf0, 0xa6, 0xde, 0x8e, 0x31, 0x97, 0xcb, 0xf8,
-	0x4d, 0x9d, 0xc9, 0x78, 0x86, 0x5d, 0xfb, 0xcf, 0x02, 0xd4, 0x96, 0xfb, 0x8e, 0xc1, 0x6d, 0xa3,
-	0xfb, 0xbb, 0xc2, 0x94, 0xc2, 0x50, 0xcc, 0x54, 0x18, 0xfa, 0x50, 0xed, 0xdc, 0x0e, 0x15, 0x8a,
-	0xc6, 0xfc, 0xfa, 0xe8, 0xab, 0x42, 0x4e, 0x69, 0xf6, 0x3a, 0xc7, 0x27, 0x62, 0x28, 0x67, 0xe4,
-	0x84, 0xaa, 0xd7, 0x5f, 0xe5, 0x44, 0x25, 0xb1, 0xe9, 0x0f, 0x42, 0x23, 0x06, 0x76, 0x2c, 0xa7,
-	0xed, 0xef, 0x96, 0xa1, 0xba, 0xd2, 0x6a, 0x2d, 0x6c, 0xac, 0x92, 0xe7, 0xa0, 0x21, 0xdd, 0xeb,
-	0x37, 0x22, 0x1e, 0x84, 0xd1, 0x95, 0x56, 0xd4, 0x85, 0x71, 0x38, 0xa6, 0x8e, 0x79, 0x54, 0xb7,
-	0xbb, 0x72, 0xb3, 0x84, 0xea, 0x18, 0xb2, 0x46, 0x14, 0x7d, 0x44, 0x87, 0x33, 0xcc, 0xc2, 0x63,
-	0x2c, 0x14, 0xd6, 0x9b, 0xdc, 0x36, 0x47, 0xb4, 0xef, 0xb8, 0x92, 0xb8, 0x95, 0x40, 0x80, 0x29,
-	0x84, 0xe4, 0x05, 0xa8, 0xe9, 0xfd, 0x60, 0x97, 0x2b, 0xd0, 0x62, 0x6f, 0x5c, 0xe2, 0xd1, 0x07,
-	0xd9, 0x76, 0xf7, 0x60, 0x66, 0xfc, 0x3a, 0x36, 0x9f, 0x53, 0xcf, 0x18, 0x42, 0xb3, 0xc9, 0x29,
-	0x8b, 0x51, 0x4e, 0xae, 0x72, 0xec, 0xc9, 0x6d, 0x24, 0x10, 0x60, 0x0a, 0x21, 0x79, 0x1d, 0xc6,
-	0x3b, 0x74, 0x3f, 0xd0, 0xb7, 0x25, 0x81, 0xea, 0x71, 0x08, 0x4c, 0x32, 0x15, 0xee, 0x7a, 0x6c,
-	0x38, 0x26, 0x90, 0x11, 0x1f, 0x1e, 0xed, 0x50, 0x6f, 0x9b, 0x7a, 0xae, 0xb4, 0x3e, 0x25, 0x91,
-	0xb1, 0xe3, 0x10, 0x99, 0x3a, 0x3c, 0x98, 0x79, 0xf4, 0x7a, 0x06, 0x1a, 0xcc, 0x44, 0xae, 0xfd,
-	0x47, 0x11, 0xce, 0xae, 0x88, 0xf8, 0xa6, 0xeb, 0x09, 0x21, 0x4c, 0x2e, 0x42, 0xc9, 0xeb, 0xf5,
-	0xf9, 0xca, 0x29, 0x09, 0x3f, 0x29, 0x6e, 0x6c, 0x21, 0x6b, 0x23, 0xaf, 0x41, 0xcd, 0x94, 0x47,
-	0x86, 0x34, 0x7e, 0x8f, 0x7b, 0xd0, 0x70, 0x21, 0xa8, 0x9e, 0x30, 0xc4, 0xc6, 0x34, 0xfd, 0xae,
-	0xdf, 0x6e, 0x59, 0x6f, 0x51, 0x69, 0x0f, 0x72, 0x4d, 0x7f, 0x5d, 0x34, 0xa1, 0xea, 0x63, 0x52,
-	0xb5, 0x43, 0xf7, 0x85, 0x35, 0x54, 0x8e, 0xa4, 0xea, 0x75, 0xd9, 0x86, 0x61, 0x2f, 0x99, 0x51,
-	0x9b, 0x85, 0xad, 0x82, 0xb2, 0xb0, 0xe4, 0x6f, 0xb1, 0x06, 0xb9, 0x6f, 0xd8, 0x91, 0xf9, 0xa6,
-	0x15, 0x04, 0xd4, 0x93, 0x9f, 0x71, 0xa4, 0x23, 0xf3, 0x15, 0x8e, 0x01, 0x25, 0x26, 0xf2, 0x5e,
-	0xa8, 0x73, 0xe4, 0x4d, 0xdb, 0xdd, 0xe6, 0x1f, 0xae, 0x2e, 0x6c, 0xfa, 0x5b, 0xaa, 0x11, 0xa3,
-	0x7e, 0xed, 0x47, 0x45, 0xb8, 0xb0, 0x42, 0x03, 0xa1, 0xd5, 0x2c, 0xd1, 0x9e, 0xed, 0xee, 0x33,
-	0xd5, 0x12, 0xe9, 0xa7, 0xc8, 0x4b, 0x00, 0x96, 0xbf, 0xdd, 0xda, 0x33, 0xf8, 0x3e, 0x10, 0x7b,
-	0xf8, 0x8a, 0xdc, 0x92, 0xb0, 0xda, 0x6a, 0xca, 0x9e, 0xbb, 0x89, 0x27, 0x8c, 0x8d, 0x89, 0xcc,
-	0xab, 0xe2, 0x3d, 0xcc, 0xab, 0x16, 0x40, 0x2f, 0x52, 0x50, 0x4b, 0x1c, 0xf2, 0xff, 0x29, 0x32,
-	0xc7, 0xd1, 0x4d, 0x63, 0x68, 0xf2, 0xa8, 0x8c, 0x0e, 0x4c, 0x9a, 0x74, 0x47, 0xef, 0xdb, 0x41,
-	0xa8, 0x54, 0xcb, 0x4d, 0x7c, 0x74, 0xbd, 0x3c, 0x8c, 0xbd, 0x2e, 0xa5, 0x30, 0xe1, 0x00, 0x6e,
-	0xed, 0xf7, 0x4a, 0x30, 0xbd, 0x42, 0x83, 0xd0, 0xe3, 0x22, 0x4f, 0xc7, 0x56, 0x8f, 0x1a, 0xec,
-	0x2b, 0xbc, 0x5d, 0x80, 0xaa, 0xad, 0x6f, 0x53, 0x9b, 0x49, 0x2f, 0xf6, 0x36, 0x6f, 0x8c, 0x2c,
-	0x08, 0x86, 0x53, 0x99, 0x5d, 0xe3, 0x14, 0x52, 0xa2, 0x41, 0x34, 0xa2, 0x24, 0xcf, 0x0e, 0x75,
-	0xc3, 0xee, 0xfb, 0x01, 0xf5, 0x36, 0x5c, 0x2f, 0x90, 0xfa, 0x64, 0x78, 0xa8, 0x2f, 0x46, 0x5d,
-	0x18, 0x87, 0x23, 0xf3, 0x00, 0x86, 0x6d, 0x51, 0x27, 0xe0, 0xa3, 0xc4, 0xbe, 0x22, 0xea, 0xfb,
-	0x2e, 0x86, 0x3d, 0x18, 0x83, 0x62, 0xa4, 0xba, 0xae, 0x63, 0x05, 0xae, 0x20, 0x55, 0x4e, 0x92,
-	0x5a, 0x8f, 0xba, 0x30, 0x0e, 0xc7, 0x87, 0xd1, 0xc0, 0xb3, 0x0c, 0x9f, 0x0f, 0xab, 0xa4, 0x86,
-	0x45, 0x5d, 0x18, 0x87, 0x63, 0x32, 0x2f, 0xf6, 0xfe, 0xc7, 0x92, 0x79, 0x5f, 0xab, 0xc3, 0xe5,
-	0x04, 0x5b, 0x03, 0x3d, 0xa0, 0x3b, 0x7d, 0xbb, 0x45, 0x03, 0xf5, 0x01, 0x47, 0x94, 0x85, 0xbf,
-	0x18, 0x7d, 0x77, 0x91, 0x55, 0x61, 0x9c, 0xcc, 0x77, 0x1f, 0x98, 0xe0, 0x91, 0xbe, 0xfd, 0x1c,
-	0xd4, 0x1d, 0x3d, 0xf0, 0xf9, 0xc6, 0x95, 0x7b, 0x34, 0x54, 0xc3, 0x6e, 0xa8, 0x0e, 0x8c, 0x60,
-	0xc8, 0x06, 0x3c, 0x2a, 0x59, 0x7c, 0xed, 0x4e, 0xcf, 0xf5, 0x02, 0xea, 0x89, 0xb1, 0x52, 0x9c,
-	0xca, 0xb1, 0x8f, 0xae, 0x67, 0xc0, 0x60, 0xe6, 0x48, 0xb2, 0x0e, 0xe7, 0x0d, 0x11, 0x69, 0xa6,
-	0xb6, 0xab, 0x9b, 0x0a, 0xa1, 0x70, 0x70, 0x85, 0xa6, 0xd1, 0xe2, 0x20, 0x08, 0x66, 0x8d, 0x4b,
-	0xaf, 0xe6, 0xea, 0x48, 0xab, 0x79, 0x6c, 0x94, 0xd5, 0x5c, 0x1b, 0x6d, 0x35, 0xd7, 0x8f, 0xb6,
-	0x9a, 0x19, 0xe7, 0xd9, 0x3a, 0xa2, 0x1e, 0x53, 0x4f, 0x84, 0x84, 0x8d, 0x25, 0x32, 0x84, 0x9c,
-	0x6f, 0x65, 0xc0, 0x60, 0xe6, 0x48, 0xb2, 0x0d, 0xd3, 0xa2, 0xfd, 0x9a, 0x63, 0x78, 0xfb, 0x3d,
-	0x26, 0x78, 0x62, 0x78, 0x1b, 0x09, 0x0f, 0xe3, 0x74, 0x6b, 0x28, 0x24, 0xde, 0x03, 0x0b, 0xf9,
-	0x19, 0x98, 0x10, 0x5f, 0x69, 0x5d, 0xef, 0x71, 0xb4, 0x22, 0xad, 0xe1, 0x31, 0x89, 0x76, 0x62,
-	0x31, 0xde, 0x89, 0x49, 0x58, 0xb2, 0x00, 0x67, 0x7b, 0x7b, 0x06, 0xfb, 0xb9, 0xba, 0x73, 0x83,
-	0x52, 0x93, 0x9a, 0x3c, 0x5a, 0x53, 0x6f, 0x3e, 0xae, 0x1c, 0x1d, 0x1b, 0xc9, 0x6e, 0x4c, 0xc3,
-	0x93, 0x17, 0x60, 0xdc, 0x0f, 0x74, 0x2f, 0x90, 0x6e, 0xbd, 0xa9, 0x33, 0x22, 0xed, 0x43, 0x79,
-	0xbd, 0x5a, 0xb1, 0x3e, 0x4c, 0x40, 0x66, 0xca, 0x8b, 0xb3, 0xa7, 0x27, 0x2f, 0xf2, 0x9c, 0x56,
-	0x77, 0x85, 0xb0, 0xe7, 0xb1, 0x84, 0x94, 0x98, 0xf9, 0x62, 0x5a, 0xcc, 0xbc, 0x9e, 0xe7, 0xb8,
-	0xc9, 0xa0, 0x70, 0xa4, 0x63, 0xe6, 0x15, 0x20, 0x9e, 0x8c, 0x7c, 0x08, 0x7b, 0x3b, 0x26, 0x69,
-	0xc2, 0x64, 0x1e, 0x1c, 0x80, 0xc0, 0x8c, 0x51, 0xa4, 0x05, 0x8f, 0xf9, 0xd4, 0x09, 0x2c, 0x87,
-	0xda, 0x49, 0x74, 0x42, 0x04, 0x3d, 0x29, 0xd1, 0x3d, 0xd6, 0xca, 0x02, 0xc2, 0xec, 0xb1, 0x79,
-	0x98, 0xff, 0xe7, 0xc0, 0xe5, 0xbc, 0x60, 0xcd, 0x89, 0x89, 0x89, 0xb7, 0xd3, 0x62, 0xe2, 0x8d,
-	0xfc, 0xdf, 0x6d, 0x34, 0x11, 0x31, 0x0f, 0xc0, 0xbf, 0x42, 0x5c, 0x46, 0x84, 0x27, 0x23, 0x86,
-	0x3d, 0x18, 0x83, 0x62, 0xbb, 0x5e, 0xf1, 0x39, 0x2e, 0x1e, 0xc2, 0x5d, 0xdf, 0x8a, 0x77, 0x62,
-	0x12, 0x76, 0xa8, 0x88, 0xa9, 0x8c, 0x2c, 0x62, 0x5e, 0x01, 0x92, 0xf0, 0xf6, 0x08, 0x7c, 0xd5,
-	0x64, 0x2e, 0xd9, 0xea, 0x00, 0x04, 0x66, 0x8c, 0x1a, 0xb2, 0x94, 0xc7, 0x4e, 0x76, 0x29, 0xd7,
-	0x46, 0x5f, 0xca, 0xe4, 0x0d, 0xb8, 0xc8, 0x49, 0x49, 0xfe, 0x24, 0x11, 0x0b, 0x61, 0xf3, 0x2e,
-	0x89, 0xf8, 0x22, 0x0e, 0x03, 0xc4, 0xe1, 0x38, 0xd8, 0xf7, 0x31, 0x3c, 0x6a, 0x32, 0xe2, 0xba,
-	0x3d, 0x5c, 0x10, 0x2d, 0x66, 0xc0, 0x60, 0xe6, 0x48, 0xb6, 0xc4, 0x02, 0xb6, 0x0c, 0xf5, 0x6d,
-	0x9b, 0x9a, 0x32, 0x97, 0x2e, 0x5c, 0x62, 0x9b, 0x6b, 0x2d, 0xd9, 0x83, 0x31, 0xa8, 0x2c, 0xd9,
-	0x30, 0x7e, 0x4c, 0xd9, 0xb0, 0xc2, 0x5d, 0xa3, 0x3b, 0x09, 0x11, 0x24, 0x05, 0x4c, 0x98, 0x1d,
-	0xb9, 0x98, 0x06, 0xc0, 0xc1, 0x31, 0x5c, 0x34, 0x1b, 0x9e, 0xd5, 0x0b, 0xfc, 0x24, 0xae, 0x33,
-	0x29, 0xd1, 0x9c, 0x01, 0x83, 0x99, 0x23, 0x99, 0x52, 0xb4, 0x4b, 0x75, 0x3b, 0xd8, 0x4d, 0x22,
-	0x3c, 0x9b, 0x54, 0x8a, 0x5e, 0x1e, 0x04, 0xc1, 0xac, 0x71, 0x99, 0xb2, 0x6c, 0xf2, 0xe1, 0x94,
-	0x65, 0x5f, 0x28, 0xc1, 0xc5, 0x15, 0x1a, 0x84, 0xc9, 0x0c, 0x3f, 0xb5, 0x5d, 0x7f, 0x0c, 0xb6,
-	0xeb, 0x9f, 0x95, 0xe0, 0xfc, 0x0a, 0x95, 0xd9, 0x7f, 0x1b, 0xae, 0xa9, 0x84, 0xd9, 0xff, 0x50,
-	0xf6, 0xaf, 0xc3, 0xf9, 0x28, 0x7f, 0xa6, 0x15, 0xb8, 0x9e, 0x90, 0xe5, 0x29, 0x13, 0xa5, 0x35,
-	0x08, 0x82, 0x59, 0xe3, 0x32, 0xbf, 0x66, 0xf5, 0x14, 0xbf, 0xe6, 0xbf, 0x16, 0x61, 0x6c, 0xc5,
-	0x73, 0xfb, 0xbd, 0xe6, 0x3e, 0x69, 0x43, 0xf5, 0x36, 0xf7, 0xea, 0x4b, 0x9f, 0xf9, 0xe8, 0x79,
-	0x9a, 0x22, 0x38, 0x10, 0xa9, 0x0d, 0xe2, 0x19, 0x25, 0x7a, 0xf6, 0xa1, 0x3b, 0x74, 0x9f, 0x9a,
-	0xd2, 0xb9, 0x1f, 0x7e, 0xe8, 0xeb, 0xac, 0x11, 0x45, 0x1f, 0xe9, 0xc2, 0x59, 0xdd, 0xb6, 0xdd,
-	0xdb, 0xd4, 0x5c, 0xd3, 0x03, 0xea, 0x50, 0x5f, 0xc5, 0x4a, 0x8e, 0xeb, 0x2f, 0xe3, 0x01, 0xc7,
-	0x85, 0x24, 0x2a, 0x4c, 0xe3, 0x26, 0x6f, 0xc2, 0x98, 0x1f, 0xb8, 0x9e, 0x52, 0x48, 0x1a, 0xf3,
-	0x8b, 0x23, 0xbf, 0xfd, 0x46, 0xf3, 0xa3, 0x2d, 0x81, 0x4a, 0x38, 0x13, 0xe5, 0x03, 0x2a, 0x02,
-	0xda, 0x57, 0x0b, 0x00, 0x2f, 0x6f, 0x6e, 0x6e, 0x48, 0xbf, 0xa7, 0x09, 0x65, 0xbd, 0x1f, 0x46,
-	0x50, 0x46, 0x8f, 0x54, 0x24, 0x12, 0xb5, 0x64, 0x70, 0xa1, 0x1f, 0xec, 0x22, 0xc7, 0x4e, 0xde,
-	0x03, 0x63, 0x52, 0x89, 0x94, 0x6c, 0x0f, 0x63, 0x9e, 0x52, 0xd1, 0x44, 0xd5, 0xaf, 0xfd, 0x4e,
-	0x11, 0x60, 0xd5, 0xb4, 0x69, 0x4b, 0xa5, 0xd6, 0xd6, 0x83, 0x5d, 0x8f, 0xfa, 0xbb, 0xae, 0x6d,
-	0x8e, 0x18, 0xe6, 0xe1, 0xce, 0xc8, 0x4d, 0x85, 0x04, 0x23, 0x7c, 0xc4, 0x64, 0x46, 0x18, 0xed,
-	0xad, 0x3a, 0x01, 0xf5, 0xf6, 0x74, 0x7b, 0x44, 0xef, 0xee, 0xa4, 0x30, 0xd8, 0x22, 0x3c, 0x98,
-	0xc0, 0x4a, 0x74, 0x68, 0x58, 0x8e, 0x21, 0x36, 0x48, 0x73, 0x7f, 0xc4, 0x85, 0x74, 0x96, 0x69,
-	0xe5, 0xab, 0x11, 0x1a, 0x8c, 0xe3, 0xd4, 0xbe, 0x5f, 0x84, 0x0b, 0x9c, 0x1e, 0x9b, 0x46, 0x22,
-	0x51, 0x8c, 0xfc, 0xdc, 0xc0, 0x05, 0x9d, 0xff, 0x7b, 0x34, 0xd2, 0xe2, 0x7e, 0xc7, 0x3a, 0x0d,
-	0xf4, 0x48, 0xe7, 0x89, 0xda, 0x62, 0xb7, 0x72, 0xfa, 0x50, 0xf6, 0x7b, 0xd4, 0x90, 0xdc, 0x6b,
-	0x8d, 0xbc, 0x84, 0xb2, 0x5f, 0x80, 0x1d, 0xf1, 0x51, 0x38, 0x8b, 0x1f, 0xf8, 0x9c, 0x1c, 0xf9,
-	0x0c, 0x54, 0xfd, 0x40, 0x0f, 0xfa, 0x6a, 0x6b, 0x6e, 0x9d, 0x34, 0x61, 0x8e, 0x3c, 0x3a, 0x47,
-	0xc4, 0x33, 0x4a, 0xa2, 0xda, 0xf7, 0x0b, 0x30, 0x9d, 0x3d, 0x70, 0xcd, 0xf2, 0x03, 0xf2, 0xb3,
-	0x03, 0x6c, 0x3f, 0xe2, 0x17, 0x67, 0xa3, 0x39, 0xd3, 0xc3, 0x4c, 0x51, 0xd5, 0x12, 0x63, 0x79,
-	0x00, 0x15, 0x2b, 0xa0, 0x5d, 0x65, 0x83, 0xdd, 0x3c, 0xe1, 0x57, 0x8f, 0x89, 0x3f, 0x46, 0x05,
-	0x05, 0x31, 0xed, 0x9d, 0xe2, 0xb0, 0x57, 0x66, 0x9f, 0x85, 0xd8, 0xc9, 0x64, 0xc4, 0xeb, 0xf9,
-	0x92, 0x11, 0x93, 0x13, 0x1a, 0xcc, 0x49, 0xfc, 0xf9, 0xc1, 0x9c, 0xc4, 0x9b, 0xf9, 0x73, 0x12,
-	0x53, 0x6c, 0x18, 0x9a, 0x9a, 0xf8, 0x4b, 0x25, 0xb8, 0x74, 0xaf, 0x65, 0xc3, 0xe4, 0x99, 0x5c,
-	0x9d, 0x79, 0xe5, 0xd9, 0xbd, 0xd7, 0x21, 0x99, 0x87, 0x4a, 0x6f, 0x57, 0xf7, 0x95, 0xe2, 0xa2,
-	0x94, 0xfa, 0xca, 0x06, 0x6b, 0xbc, 0xcb, 0x0e, 0x0d, 0xae, 0xf0, 0xf0, 0x47, 0x14, 0xa0, 0xec,
-	0x38, 0xee, 0x52, 0xdf, 0x8f, 0xec, 0xe6, 0xf0, 0x38, 0x5e, 0x17, 0xcd, 0xa8, 0xfa, 0x49, 0x00,
-	0x55, 0xe1, 0xfb, 0x92, 0x92, 0x69, 0xf4, 0x0c, 0x93, 0x8c, 0xfc, 0xd5, 0xe8, 0xa5, 0xa4, 0x1b,
-	0x55, 0xd2, 0x22, 0xb3, 0x50, 0x0e, 0xa2, 0x6c, 0x42, 0x65, 0xbe, 0x96, 0x33, 0x74, 0x38, 0x0e,
-	0xa7, 0xfd, 0x55, 0x0d, 0x2e, 0x64, 0x7f, 0x43, 0xf6, 0xae, 0x7b, 0xd4, 0xf3, 0x2d, 0xd7, 0x91,
-	0x7a, 0x61, 0x94, 0xfb, 0x2e, 0x9a, 0x51, 0xf5, 0xff, 0x44, 0x67, 0xaf, 0xfc, 0x76, 0x81, 0x99,
-	0xd7, 0xc2, 0xe1, 0xfc, 0x20, 0x32, 0x58, 0x9e, 0x14, 0x66, 0xfa, 0x10, 0x82, 0x38, 0x7c, 0x2e,
-	0xe4, 0xb7, 0x0a, 0x30, 0xd5, 0x4d, 0xd9, 0xef, 0xa7, 0x78, 0xfb, 0x84, 0xa7, 0xd8, 0xae, 0x0f,
-	0xa1, 0x87, 0x43, 0x67, 0x42, 0x3e, 0x0b, 0x8d, 0x1e, 0x5b, 0x17, 0x7e, 0x40, 0x1d, 0x43, 0x5d,
-	0x40, 0x19, 0x7d, 0xf5, 0x6f, 0x44, 0xb8, 0x54, 0x5e, 0x8b, 0x90, 0xe9, 0xb1, 0x0e, 0x8c, 0x53,
-	0x7c, 0xc8, 0xaf, 0x9b, 0x5c, 0x85, 0x9a, 0x4f, 0x83, 0xc0, 0x72, 0xda, 0x3e, 0xf7, 0x0a, 0xd5,
-	0xc5, 0x5e, 0x69, 0xc9, 0x36, 0x0c, 0x7b, 0xc9, 0x7b, 0xa1, 0xce, 0xfd, 0xd7, 0x0b, 0x5e, 0xdb,
-	0x9f, 0xaa, 0xf3, 0xdc, 0x93, 0x09, 0x91, 0x4d, 0x23, 0x1b, 0x31, 0xea, 0x27, 0xcf, 0xc2, 0xf8,
-	0x36, 0xdf, 0xbe, 0xf2, 0x6e, 0xa0, 0xf0, 0xdd, 0x70, 0x0d, 0xab, 0x19, 0x6b, 0xc7, 0x04, 0x14,
-	0x99, 0x07, 0xa0, 0xa1, 0x93, 0x3f, 0xed, 0xa7, 0x89, 0xdc, 0xff, 0x18, 0x83, 0x22, 0x4f, 0x42,
-	0x29, 0xb0, 0x7d, 0xee, 0x9b, 0xa9, 0x45, 0xa6, 0xd5, 0xe6, 0x5a, 0x0b, 0x59, 0xbb, 0xf6, 0xa3,
-	0x02, 0x9c, 0x4d, 0x65, 0xaa, 0xb3, 0x21, 0x7d, 0xcf, 0x96, 0xc7, 0x48, 0x38, 0x64, 0x0b, 0xd7,
-	0x90, 0xb5, 0x93, 0x37, 0xa4, 0x2a, 0x5d, 0xcc, 0x79, 0x0d, 0xfa, 0x86, 0x1e, 0xf8, 0x4c, 0x77,
-	0x1e, 0xd0, 0xa2, 0x79, 0xcc, 0x20, 0x9a, 0x8f, 0x3c, 0xbb, 0x63, 0x31, 0x83, 0xa8, 0x0f, 0x13,
-	0x90, 0x29, 0x47, 0x56, 0xf9, 0x28, 0x8e, 0x2c, 0xed, 0xcb, 0xc5, 0x18, 0x07, 0xa4, 0x36, 0x7e,
-	0x1f, 0x0e, 0x3c, 0xcd, 0x84, 0x5e, 0x28, 0x90, 0xeb, 0x71, 0x99, 0xc5, 0x05, 0xa8, 0xec, 0x25,
-	0xaf, 0x0a, 0xde, 0x97, 0x72, 0x5e, 0x69, 0xdb, 0x5c, 0x6b, 0x89, 0x54, 0x0d, 0xf5, 0xd5, 0xc2,
-	0x4f, 0x50, 0x3e, 0xa5, 0x4f, 0xa0, 0xfd, 0x69, 0x09, 0x1a, 0xaf, 0xb8, 0xdb, 0x3f, 0x21, 0xe9,
-	0x98, 0xd9, 0x62, 0xaa, 0xf8, 0x63, 0x14, 0x53, 0x5b, 0xf0, 0x78, 0x10, 0xd8, 0x2d, 0x6a, 0xb8,
-	0x8e, 0xe9, 0x2f, 0xec, 0x04, 0xd4, 0x5b, 0xb6, 0x1c, 0xcb, 0xdf, 0xa5, 0xa6, 0x0c, 0x93, 0x3c,
-	0x71, 0x78, 0x30, 0xf3, 0xf8, 0xe6, 0xe6, 0x5a, 0x16, 0x08, 0x0e, 0x1b, 0xcb, 0x8f, 0x0d, 0xdd,
-	0xe8, 0xb8, 0x3b, 0x3b, 0x3c, 0xed, 0x5e, 0x06, 0xf0, 0xc5, 0xb1, 0x11, 0x6b, 0xc7, 0x04, 0x94,
-	0xf6, 0x8d, 0x22, 0xd4, 0xc3, 0x6b, 0xb4, 0xe4, 0x29, 0x18, 0xdb, 0xf6, 0xdc, 0x0e, 0xf5, 0x44,
-	0x44, 0x4a, 0xa6, 0xdd, 0x37, 0x45, 0x13, 0xaa, 0x3e, 0xf2, 0x6e, 0xa8, 0x04, 0x6e, 0xcf, 0x32,
-	0xd2, 0x8e, 0xa2, 0x4d, 0xd6, 0x88, 0xa2, 0xef, 0xf4, 0x16, 0xf8, 0xd3, 0x09, 0x75, 0xac, 0x3e,
-	0x54, 0x81, 0x7a, 0x1d, 0xca, 0xbe, 0xee, 0xdb, 0x52, 0x9e, 0xe6, 0xb8, 0x91, 0xba, 0xd0, 0x5a,
-	0x93, 0x37, 0x52, 0x17, 0x5a, 0x6b, 0xc8, 0x91, 0x6a, 0x3f, 0x2c, 0x42, 0x43, 0xf0, 0x4d, 0x9c,
-	0x0a, 0x27, 0xc9, 0xb9, 0x17, 0x79, 0x5c, 0xd6, 0xef, 0x77, 0xa9, 0xc7, 0x5d, 0x43, 0xf2, 0x90,
-	0x8b, 0xfb, 0xbd, 0xa3, 0xce, 0x30, 0x36, 0x1b, 0x35, 0x29, 0xd6, 0x97, 0x4f, 0x91, 0xf5, 0x95,
-	0x23, 0xb1, 0xbe, 0x7a, 0x1a, 0xac, 0x7f, 0xbb, 0x08, 0xf5, 0x35, 0x6b, 0x87, 0x1a, 0xfb, 0x86,
-	0xcd, 0x2f, 0x18, 0x99, 0xd4, 0xa6, 0x01, 0x5d, 0xf1, 0x74, 0x83, 0x6e, 0x50, 0xcf, 0xe2, 0x05,
-	0x20, 0xd8, 0xfe, 0xe0, 0x27, 0x90, 0xbc, 0x60, 0xb4, 0x34, 0x04, 0x06, 0x87, 0x8e, 0x26, 0xab,
-	0x30, 0x6e, 0x52, 0xdf, 0xf2, 0xa8, 0xb9, 0x11, 0x33, 0x2e, 0x9e, 0x52, 0xa2, 0x66, 0x29, 0xd6,
-	0x77, 0xf7, 0x60, 0x66, 0x62, 0xc3, 0xea, 0x51, 0xdb, 0x72, 0xa8, 0xb0, 0x32, 0x12, 0x43, 0xd9,
-	0x96, 0xef, 0xe9, 0x7d, 0x3f, 0x6b, 0x8e, 0xb1, 0x2d, 0xbf, 0x91, 0x0d, 0x82, 0xc3, 0xc6, 0x6a,
-	0x15, 0x28, 0xad, 0xb9, 0x6d, 0xed, 0x9d, 0x12, 0x84, 0x95, 0x42, 0xc8, 0x2f, 0x14, 0xa0, 0xa1,
-	0x3b, 0x8e, 0x1b, 0xc8, 0x2a, 0x1c, 0x22, 0xb2, 0x8c, 0xb9, 0x0b, 0x92, 0xcc, 0x2e, 0x44, 0x48,
-	0x45, 0x50, 0x32, 0x0c, 0x94, 0xc6, 0x7a, 0x30, 0x4e, 0x9b, 0xf4, 0x53, 0x71, 0xd2, 0xf5, 0xfc,
-	0xb3, 0x38, 0x42, 0x54, 0x74, 0xfa, 0x23, 0x30, 0x99, 0x9e, 0xec, 0x71, 0xc2, 0x1c, 0x79, 0x22,
-	0x24, 0x5f, 0xac, 0x43, 0xe3, 0x86, 0x1e, 0x58, 0x7b, 0x94, 0x1b, 0xea, 0xa7, 0x63, 0x79, 0xfd,
-	0x5a, 0x01, 0x2e, 0x24, 0x23, 0x96, 0xa7, 0x68, 0x7e, 0xf1, 0x4b, 0x67, 0x98, 0x49, 0x0d, 0x87,
-	0xcc, 0x82, 0x1b, 0x62, 0x03, 0x01, 0xd0, 0xd3, 0x36, 0xc4, 0x5a, 0xc3, 0x08, 0xe2, 0xf0, 0xb9,
-	0xfc, 0xa4, 0x18, 0x62, 0x0f, 0x77, 0x51, 0x80, 0x94, 0x99, 0x38, 0xf6, 0xd0, 0x98, 0x89, 0xb5,
-	0x87, 0x42, 0x03, 0xed, 0xc5, 0xcc, 0xc4, 0x7a, 0xce, 0x10, 0x83, 0x4c, 0xf2, 0x11, 0xd8, 0x86,
-	0x99, 0x9b, 0xfc, 0x52, 0x84, 0x52, 0xdf, 0x89, 0x01, 0x95, 0x6d, 0xdd, 0xb7, 0x0c, 0xa9, 0x8f,
-	0xe7, 0x28, 0x82, 0xa2, 0x6e, 0x8b, 0x0b, 0x4f, 0x24, 0x7f, 0x44, 0x81, 0x3b, 0xba, 0x95, 0x5e,
-	0xcc, 0x75, 0x2b, 0x9d, 0x2c, 0x42, 0xd9, 0x61, 0x87, 0x6d, 0xe9, 0xd8, 0xf7, 0xd0, 0x6f, 0x5c,
-	0xa7, 0xfb, 0xc8, 0x07, 0x33, 0x9d, 0x16, 0xd8, 0xeb, 0x1f, 0xcd, 0x60, 0x7b, 0x0f, 0x8c, 0xf9,
-	0x7d, 0xee, 0xd3, 0x97, 0x12, 0x3e, 0x8a, 0xcb, 0x88, 0x66, 0x54, 0xfd, 0x4c, 0x7b, 0xfb, 0x54,
-	0x9f, 0xf6, 0x95, 0xc7, 0x30, 0xd4, 0xde, 0x3e, 0xca, 0x1a, 0x51, 0xf4, 0x9d, 0x9e, 0xf2, 0xa5,
-	0x0c, 0xbb, 0xca, 0x69, 0x19, 0x76, 0x75, 0x18, 0xbb, 0xe1, 0xf2, 0x50, 0xa8, 0xf6, 0x4f, 0x45,
-	0x80, 0x28, 0x8c, 0x46, 0xbe, 0x5a, 0x80, 0xc7, 0xc2, 0x0d, 0x17, 0x88, 0xeb, 0xa8, 0x8b, 0xb6,
-	0x6e, 0x75, 0x73, 0x1b, 0x79, 0x59, 0x9b, 0x9d, 0x9f, 0x40, 0x1b, 0x59, 0xe4, 0x30, 0x7b, 0x16,
-	0x04, 0xa1, 0x46, 0xbb, 0xbd, 0x60, 0x7f, 0xc9, 0xf2, 0xe4, 0x0a, 0xcc, 0x8c, 0xd6, 0x5e, 0x93,
-	0x30, 0x62, 0xa8, 0xbc, 0x7a, 0xc8, 0x37, 0x91, 0xea, 0xc1, 0x10, 0x0f, 0xd9, 0x85, 0x9a, 0xe3,
-	0xbe, 0xe1, 0x33, 0x76, 0xc8, 0xe5, 0xf8, 0xd2, 0xe8, 0x2c, 0x17, 0x6c, 0x15, 0x46, 0x81, 0x7c,
-	0xc0, 0x31, 0x47, 0x32, 0xfb, 0x2b, 0x45, 0x38, 0x9f, 0xc1, 0x07, 0xf2, 0x12, 0x4c, 0xca, 0x88,
-	0x65, 0x54, 0x1a, 0xab, 0x10, 0x95, 0xc6, 0x6a, 0xa5, 0xfa, 0x70, 0x00, 0x9a, 0xbc, 0x01, 0xa0,
-	0x1b, 0x06, 0xf5, 0xfd, 0x75, 0xd7, 0x54, 0x0a, 0xec, 0x8b, 0x87, 0x07, 0x33, 0xb0, 0x10, 0xb6,
-	0xde, 0x3d, 0x98, 0x79, 0x7f, 0x56, 0xa0, 0x3e, 0xc5, 0xe7, 0x68, 0x00, 0xc6, 0x50, 0x92, 0x4f,
-	0x02, 0x88, 0xeb, 0xc8, 0xe1, 0x05, 0x8e, 0xfb, 0x04, 0x79, 0x66, 0xd5, 0x55, 0xd9, 0xd9, 0x8f,
-	0xf6, 0x75, 0x27, 0xb0, 0x82, 0x7d, 0x71, 0x5f, 0xee, 0x56, 0x88, 0x05, 0x63, 0x18, 0xb5, 0x3f,
-	0x2e, 0x42, 0x4d, 0x29, 0xd6, 0x0f, 0x20, 0x8c, 0xd7, 0x4e, 0x84, 0xf1, 0x46, 0xbf, 0x22, 0xaf,
-	0xa6, 0x3c, 0x34, 0x70, 0xe7, 0xa6, 0x02, 0x77, 0x2b, 0xf9, 0x49, 0xdd, 0x3b, 0x54, 0xf7, 0xf5,
-	0x22, 0x9c, 0x51, 0xa0, 0xb2, 0x6c, 0xc1, 0xf3, 0x30, 0xe1, 0x51, 0xdd, 0x6c, 0xea, 0x81, 0xb1,
-	0xcb, 0x3f, 0x5f, 0x81, 0x5f, 0x98, 0x39, 0x77, 0x78, 0x30, 0x33, 0x81, 0xf1, 0x0e, 0x4c, 0xc2,
-	0x91, 0x0f, 0xc3, 0x59, 0xe1, 0x7a, 0x5c, 0xd7, 0xef, 0x88, 0xab, 0x83, 0x9c, 0x61, 0x65, 0x11,
-	0xe9, 0x6f, 0x26, 0xbb, 0x30, 0x0d, 0xcb, 0x96, 0xb5, 0x68, 0xda, 0xf2, 0xf5, 0xb6, 0x98, 0x0c,
-	0xe7, 0xc2, 0x84, 0x58, 0xd6, 0xcd, 0x54, 0x1f, 0x0e, 0x40, 0x13, 0x1d, 0x1a, 0x6c, 0x46, 0x9b,
-	0x56, 0x97, 0xba, 0x7d, 0x55, 0x0d, 0x70, 0xa4, 0x68, 0x32, 0x46, 0x68, 0x30, 0x8e, 0x53, 0xfb,
-	0xeb, 0x02, 0x8c, 0x47, 0xfc, 0x3a, 0xf5, 0x60, 0xe6, 0x4e, 0x32, 0x98, 0xb9, 0x90, 0x7b, 0x39,
-	0x0c, 0x09, 0x5f, 0x7e, 0x69, 0x2c, 0x7a, 0x2d, 0x1e, 0xb0, 0xdc, 0x86, 0x69, 0x2b, 0x33, 0x86,
-	0x17, 0x3b, 0x6d, 0xc2, 0x3c, 0xf3, 0xd5, 0xa1, 0x90, 0x78, 0x0f, 0x2c, 0xa4, 0x0f, 0xb5, 0x3d,
-	0xea, 0x05, 0x96, 0x41, 0xd5, 0xfb, 0xad, 0xe4, 0x56, 0xc9, 0x44, 0xfa, 0x53, 0xc4, 0xd3, 0x5b,
-	0x92, 0x00, 0x86, 0xa4, 0xc8, 0x36, 0x54, 0xa8, 0xd9, 0xa6, 0xea, 0x32, 0x67, 0xce, 0x52, 0x29,
-	0x21, 0x3f, 0xd9, 0x93, 0x8f, 0x02, 0x35, 0xf1, 0xa1, 0x6e, 0x2b, 0x57, 0x84, 0x5c, 0x87, 0xa3,
-	0x2b, 0x58, 0xa1, 0x53, 0x23, 0xba, 0xe7, 0x11, 0x36, 0x61, 0x44, 0x87, 0x74, 0xc2, 0xfa, 0x54,
-	0x95, 0x13, 0x3a, 0x3c, 0xee, 0x51, 0xa1, 0xca, 0x87, 0xfa, 0x6d, 0x3d, 0xa0, 0x5e, 0x57, 0xf7,
-	0x3a, 0xd2, 0xda, 0x18, 0xfd, 0x0d, 0x5f, 0x55, 0x98, 0xa2, 0x37, 0x0c, 0x9b, 0x30, 0xa2, 0x43,
-	0x5c, 0xa8, 0x07, 0x52, 0x7d, 0x56, 0x55, 0x2d, 0x46, 0x27, 0xaa, 0x14, 0x71, 0x5f, 0x66, 0xc1,
-	0xa8, 0x47, 0x8c, 0x68, 0x90, 0xbd, 0x44, 0x19, 0x29, 0x51, 0x3c, 0xac, 0x99, 0xa3, 0x86, 0x9d,
-	0x44, 0x15, 0x89, 0x9b, 0xec, 0x72, 0x54, 0xda, 0xdd, 0x52, 0x74, 0x2c, 0x3f, 0xe8, 0xa8, 0xf9,
-	0xb3, 0xc9, 0xa8, 0xf9, 0xe5, 0x74, 0xd4, 0x3c, 0xe5, 0xd1, 0x3a, 0x7e, 0xdc, 0x5c, 0x87, 0x86,
-	0xad, 0xfb, 0xc1, 0x56, 0xcf, 0xd4, 0x03, 0x19, 0x72, 0x69, 0xcc, 0xff, 0x9f, 0xa3, 0x9d, 0x9a,
-	0xec, 0x1c, 0x8e, 0x3c, 0x4c, 0x6b, 0x11, 0x1a, 0x8c, 0xe3, 0x24, 0xcf, 0x40, 0x63, 0x8f, 0x9f,
-	0x04, 0xe2, 0x66, 0x68, 0x85, 0x8b, 0x11, 0x7e, 0xb2, 0xdf, 0x8a, 0x9a, 0x31, 0x0e, 0xc3, 0x86,
-	0x08, 0x0d, 0x24, 0x2a, 0xad, 0x23, 0x87, 0xb4, 0xa2, 0x66, 0x8c, 0xc3, 0xf0, 0xf0, 0x9d, 0xe5,
-	0x74, 0xc4, 0x80, 0x31, 0x3e, 0x40, 0x84, 0xef, 0x54, 0x23, 0x46, 0xfd, 0xe4, 0x2a, 0xd4, 0xfa,
-	0xe6, 0x8e, 0x80, 0xad, 0x71, 0x58, 0xae, 0x61, 0x6e, 0x2d, 0x2d, 0xcb, 0x9b, 0xaa, 0xaa, 0x57,
-	0xfb, 0x5e, 0x01, 0xc8, 0x60, 0x9e, 0x07, 0xd9, 0x85, 0xaa, 0xc3, 0x5d, 0x48, 0xb9, 0x2b, 0x5a,
-	0xc5, 0x3c, 0x51, 0x62, 0x6f, 0xcb, 0x06, 0x89, 0x9f, 0x38, 0x50, 0xa3, 0x77, 0x02, 0xea, 0x39,
-	0x61, 0xde, 0xd7, 0xc9, 0x54, 0xcf, 0x12, 0x2a, 0xb5, 0xc4, 0x8c, 0x21, 0x0d, 0xed, 0x07, 0x45,
-	0x68, 0xc4, 0xe0, 0xee, 0x67, 0x99, 0xf1, 0xeb, 0x19, 0xc2, 0x73, 0xb3, 0xe5, 0xd9, 0x72, 0x99,
-	0xc6, 0xae, 0x67, 0xc8, 0x2e, 0x5c, 0xc3, 0x38, 0x1c, 0x99, 0x07, 0xe8, 0xea, 0x7e, 0x40, 0x3d,
-	0x2e, 0xc2, 0x52, 0x97, 0x22, 0xd6, 0xc3, 0x1e, 0x8c, 0x41, 0x91, 0x2b, 0xb2, 0xfe, 0x59, 0x39,
-	0x59, 0x39, 0x60, 0x48, 0x71, 0xb3, 0xca, 0x09, 0x14, 0x37, 0x23, 0x6d, 0x98, 0x54, 0xb3, 0x56,
-	0xbd, 0xc7, 0xbb, 0x57, 0x2e, 0x8c, 0x80, 0x14, 0x0a, 0x1c, 0x40, 0xaa, 0x7d, 0xa3, 0x00, 0x13,
-	0x09, 0xbf, 0x81, 0xb8, 0xf3, 0xaf, 0xb2, 0x94, 0x12, 0x77, 0xfe, 0x63, 0xc9, 0x45, 0x4f, 0x43,
-	0x55, 0x30, 0x28, 0x1d, 0xc8, 0x14, 0x2c, 0x44, 0xd9, 0xcb, 0x0e, 0x04, 0xe9, 0x99, 0x4c, 0x1f,
-	0x08, 0xd2, 0x75, 0x89, 0xaa, 0x9f, 0xbc, 0x0f, 0x6a, 0x6a, 0x76, 0x92, 0xd3, 0x51, 0x29, 0x40,
-	0xd9, 0x8e, 0x21, 0x84, 0xf6, 0xef, 0x25, 0xe0, 0x6e, 0x7f, 0xf2, 0x3c, 0xd4, 0xbb, 0xd4, 0xd8,
-	0xd5, 0x1d, 0xcb, 0x57, 0x35, 0x3f, 0x98, 0x89, 0x58, 0x5f, 0x57, 0x8d, 0x77, 0x19, 0x82, 0x85,
-	0xd6, 0x1a, 0xcf, 0x86, 0x89, 0x60, 0x89, 0x01, 0xd5, 0xb6, 0xef, 0xeb, 0x3d, 0x2b, 0x77, 0xe1,
-	0x53, 0x51, 0x63, 0x41, 0x6c, 0x22, 0xf1, 0x1b, 0x25, 0x6a, 0x62, 0x40, 0xa5, 0x67, 0xeb, 0x96,
-	0x93, 0xbb, 0xc8, 0x2c, 0x7b, 0x83, 0x0d, 0x86, 0x49, 0xf8, 0x45, 0xf8, 0x4f, 0x14, 0xb8, 0x49,
-	0x1f, 0x1a, 0xbe, 0xe1, 0xe9, 0x5d, 0x7f, 0x57, 0x9f, 0x7f, 0xee, 0x03, 0xb9, 0x35, 0x8d, 0x88,
-	0x94, 0x38, 0xf8, 0x16, 0x71, 0x61, 0xbd, 0xf5, 0xf2, 0xc2, 0xfc, 0x73, 0x1f, 0xc0, 0x38, 0x9d,
-	0x38, 0xd9, 0xe7, 0x9e, 0x99, 0x97, 0xeb, 0xfe, 0xc4, 0xc9, 0x3e, 0xf7, 0xcc, 0x3c, 0xc6, 0xe9,
-	0x68, 0xff, 0x56, 0x80, 0x7a, 0x08, 0x4b, 0xb6, 0x00, 0xd8, 0x0e, 0x94, 0x55, 0x11, 0x8e, 0x55,
-	0xa1, 0x90, 0x9b, 0x96, 0x5b, 0xe1, 0x60, 0x8c, 0x21, 0xca, 0x28, 0x1b, 0x51, 0x3c, 0xe9, 0xb2,
-	0x11, 0x73, 0x50, 0xdf, 0xd5, 0x1d, 0xd3, 0xdf, 0xd5, 0x3b, 0xe2, 0x20, 0x8a, 0x15, 0x52, 0x79,
-	0x59, 0x75, 0x60, 0x04, 0xa3, 0xfd, 0x73, 0x05, 0x44, 0xe9, 0x4e, 0xb6, 0x55, 0x4c, 0xcb, 0x17,
-	0xb9, 0x0a, 0x05, 0x3e, 0x32, 0xdc, 0x2a, 0x4b, 0xb2, 0x1d, 0x43, 0x08, 0x72, 0x11, 0x4a, 0x5d,
-	0xcb, 0x91, 0x61, 0x03, 0xee, 0x35, 0x5a, 0xb7, 0x1c, 0x64, 0x6d, 0xbc, 0x4b, 0xbf, 0x23, 0xc3,
-	0x4c, 0xa2, 0x4b, 0xbf, 0x83, 0xac, 0x8d, 0xd9, 0x71, 0xb6, 0xeb, 0x76, 0xb6, 0x75, 0xa3, 0xa3,
-	0xa2, 0x51, 0x65, 0x2e, 0xb0, 0xb8, 0x1d, 0xb7, 0x96, 0xec, 0xc2, 0x34, 0x2c, 0x59, 0x81, 0xb3,
-	0x86, 0xeb, 0xda, 0xa6, 0x7b, 0xdb, 0x51, 0xc3, 0x85, 0xfc, 0xe5, 0xee, 0xf8, 0x25, 0xda, 0xf3,
-	0xa8, 0xc1, 0x84, 0xf4, 0x62, 0x12, 0x08, 0xd3, 0xa3, 0xc8, 0x16, 0x3c, 0xfe, 0x16, 0xf5, 0x5c,
-	0x79, 0x5c, 0xb4, 0x6c, 0x4a, 0x7b, 0x0a, 0xa1, 0x90, 0xce, 0x3c, 0x3a, 0xf6, 0xf1, 0x6c, 0x10,
-	0x1c, 0x36, 0x96, 0xc7, 0xd9, 0x75, 0xaf, 0x4d, 0x83, 0x0d, 0xcf, 0x35, 0xa8, 0xef, 0x5b, 0x4e,
-	0x5b, 0xa1, 0x1d, 0x8b, 0xd0, 0x6e, 0x66, 0x83, 0xe0, 0xb0, 0xb1, 0xe4, 0x35, 0x98, 0x12, 0x5d,
-	0x42, 0x6a, 0x2f, 0xec, 0xe9, 0x96, 0xad, 0x6f, 0x5b, 0xb6, 0x2a, 0xaa, 0x3e, 0x21, 0xbc, 0xfc,
-	0x9b, 0x43, 0x60, 0x70, 0xe8, 0x68, 0x5e, 0x0a, 0x5d, 0xc6, 0x78, 0x36, 0xa8, 0xc7, 0xd7, 0x01,
-	0x77, 0x18, 0x4b, 0xc3, 0x18, 0x53, 0x7d, 0x38, 0x00, 0x4d, 0x10, 0x2e, 0xf0, 0x92, 0xaf, 0x5b,
-	0xbd, 0x14, 0xd3, 0x79, 0x12, 0xd1, 0x84, 0x08, 0xe6, 0xb4, 0x32, 0x21, 0x70, 0xc8, 0x48, 0xf6,
-	0xbe, 0xbc, 0x67, 0xc9, 0xbd, 0xed, 0xa4, 0xb1, 0x36, 0xa2, 0xf7, 0x6d, 0x0d, 0x81, 0xc1, 0xa1,
-	0xa3, 0xb5, 0x1d, 0x98, 0x68, 0x31, 0xde, 0xba, 0x8e, 0x2c, 0x66, 0xb4, 0x05, 0x63, 0x81, 0xb4,
-	0xe9, 0x47, 0x4b, 0x73, 0xe7, 0xfe, 0x35, 0x65, 0xcf, 0x2b, 0x5c, 0xda, 0xb7, 0x8b, 0x50, 0x0f,
-	0xf5, 0xef, 0x23, 0x14, 0x09, 0x72, 0xa1, 0x1e, 0x66, 0x6d, 0xe4, 0xae, 0x51, 0x1e, 0x95, 0xbd,
-	0xe5, 0x2a, 0x63, 0xf8, 0x88, 0x11, 0x8d, 0x78, 0xdd, 0xe2, 0x52, 0x8e, 0xba, 0xc5, 0x3d, 0x18,
-	0x0b, 0x3c, 0xab, 0xdd, 0x96, 0x7a, 0x4c, 0x63, 0x7e, 0x35, 0xbf, 0x05, 0xb3, 0x29, 0x10, 0x4a,
-	0xce, 0x8a, 0x07, 0x54, 0x64, 0xb4, 0x37, 0x61, 0x32, 0x0d, 0xc9, 0x85, 0xbc, 0xb1, 0x4b, 0xcd,
-	0xbe, 0xad, 0x78, 0x1c, 0x09, 0x79, 0xd9, 0x8e, 0x21, 0x04, 0xd3, 0x96, 0xd9, 0x67, 0x7a, 0xcb,
-	0x75, 0x94, 0x1d, 0xc2, 0xf5, 0xa5, 0x4d, 0xd9, 0x86, 0x61, 0xaf, 0xf6, 0x8f, 0x25, 0xb8, 0x18,
-	0x59, 0x51, 0xeb, 0xba, 0xa3, 0xb7, 0x8f, 0x50, 0x98, 0xfa, 0xa7, 0x49, 0x48, 0xc7, 0xad, 0xf4,
-	0x56, 0x7a, 0x08, 0x2a, 0xbd, 0xfd, 0xb0, 0x00, 0xbc, 0xfc, 0x3b, 0xf9, 0x2c, 0x8c, 0xeb, 0xb1,
-	0xff, 0x24, 0x90, 0x9f, 0xf3, 0x5a, 0xee, 0xcf, 0xc9, 0xab, 0xcc, 0x87, 0x59, 0x83, 0xf1, 0x56,
-	0x4c, 0x10, 0x24, 0x2e, 0xd4, 0x76, 0x74, 0xdb, 0x66, 0x72, 0x2f, 0xb7, 0x57, 0x38, 0x41, 0x9c,
-	0x2f, 0xf3, 0x65, 0x89, 0x1a, 0x43, 0x22, 0xda, 0x3f, 0x14, 0x60, 0xa2, 0x65, 0x5b, 0xa6, 0xe5,
-	0xb4, 0x4f, 0xb1, 0xc4, 0xdb, 0x4d, 0xa8, 0xf8, 0xb6, 0x65, 0xd2, 0x11, 0xaf, 0xfb, 0x70, 0x05,
-	0x95, 0xcd, 0x92, 0xa2, 0xc0, 0x93, 0xac, 0x19, 0x57, 0x3a, 0x42, 0xcd, 0xb8, 0x2f, 0x55, 0x41,
-	0xfe, 0x85, 0x00, 0xe9, 0x43, 0xbd, 0xad, 0x4a, 0x51, 0xc9, 0x77, 0x7c, 0x39, 0xc7, 0x8d, 0xfa,
-	0x44, 0x51, 0x2b, 0x71, 0xea, 0x86, 0x8d, 0x18, 0x51, 0x22, 0x34, 0xf9, 0x37, 0x14, 0x4b, 0x39,
-	0xff, 0x86, 0x42, 0x90, 0x1b, 0xfc, 0x23, 0x0a, 0x1d, 0xca, 0xbb, 0x41, 0xd0, 0x93, 0xfb, 0x6a,
-	0xf4, 0x5b, 0x6d, 0xd1, 0x85, 0x35, 0x11, 0xb8, 0x63, 0xcf, 0xc8, 0x51, 0x33, 0x12, 0x8e, 0x1e,
-	0x56, 0x3f, 0x5e, 0xcc, 0x15, 0x19, 0x8c, 0x93, 0x60, 0xcf, 0xc8, 0x51, 0x93, 0x4f, 0x43, 0x23,
-	0xf0, 0x74, 0xc7, 0xdf, 0x71, 0xbd, 0x2e, 0xf5, 0xa4, 0x25, 0xb0, 0x9c, 0xe3, 0x9f, 0x18, 0x36,
-	0x23, 0x6c, 0x22, 0xe4, 0x90, 0x68, 0xc2, 0x38, 0x35, 0xd2, 0x81, 0x5a, 0xdf, 0x14, 0x13, 0x93,
-	0x26, 0xf2, 0x42, 0x9e, 0x3f, 0xd7, 0x88, 0xc5, 0xfd, 0xd4, 0x13, 0x86, 0x04, 0x92, 0x85, 0xbe,
-	0xc7, 0x4e, 0xaa, 0xd0, 0x77, 0x7c, 0x35, 0x66, 0xde, 0xa6, 0xe9, 0x82, 0xf4, 0xcf, 0x11, 0x23,
-	0x51, 0x9f, 0x52, 0xe4, 0x6f, 0xcd, 0x1d, 0x6d, 0x83, 0x86, 0x85, 0x12, 0x63, 0xf5, 0x71, 0x32,
-	0x0b, 0x51, 0x6a, 0x7f, 0x53, 0x84, 0xd2, 0xe6, 0x5a, 0x4b, 0x94, 0x5f, 0xe0, 0xc5, 0x5f, 0x69,
-	0xab, 0x63, 0xf5, 0x6e, 0x51, 0xcf, 0xda, 0xd9, 0x97, 0x16, 0x47, 0xac, 0xfc, 0x42, 0x1a, 0x02,
-	0x33, 0x46, 0x91, 0xd7, 0x61, 0xdc, 0xd0, 0x17, 0xa9, 0x17, 0x8c, 0x62, 0x4f, 0xf1, 0x44, 0xd5,
-	0xc5, 0x85, 0x68, 0x38, 0x26, 0x90, 0x31, 0x2b, 0xd0, 0x88, 0x50, 0x97, 0x8e, 0x6d, 0x05, 0xc6,
-	0x10, 0xc7, 0x10, 0x11, 0x84, 0x7a, 0x87, 0x81, 0x72, 0xac, 0xe5, 0xe3, 0x60, 0xe5, 0x9f, 0xf2,
-	0xba, 0x1a, 0x8b, 0x11, 0x1a, 0xcd, 0x81, 0x89, 0x44, 0xd1, 0x4a, 0xf2, 0x41, 0xa8, 0xb9, 0xbd,
-	0xd8, 0xf9, 0x56, 0xe7, 0x26, 0x52, 0xed, 0xa6, 0x6c, 0xbb, 0x7b, 0x30, 0x33, 0xb1, 0xe6, 0xb6,
-	0x2d, 0x43, 0x35, 0x60, 0x08, 0x4e, 0x34, 0xa8, 0xf2, 0xec, 0x32, 0x55, 0xb2, 0x92, 0x1f, 0xe6,
-	0xbc, 0xaa, 0x9c, 0x8f, 0xb2, 0x47, 0xfb, 0x5c, 0x19, 0x22, 0xaf, 0x36, 0xf1, 0xa1, 0x6a, 0xf2,
-	0xca, 0x72, 0xf2, 0x28, 0x1d, 0x3d, 0x3a, 0x90, 0x2c, 0xbb, 0x2b, 0x2c, 0xde, 0x64, 0x1b, 0x4a,
-	0x52, 0xa4, 0x0d, 0xa5, 0x37, 0xdd, 0xed, 0xdc, 0x27, 0x69, 0x2c, 0xad, 0x5c, 0xb8, 0x08, 0x62,
-	0x0d, 0xc8, 0x28, 0x90, 0x5f, 0x2f, 0xc0, 0x39, 0x3f, 0xad, 0x05, 0xca, 0xe5, 0x80, 0xf9, 0xd5,
-	0xdd, 0xb4, 0x5e, 0x29, 0x53, 0xcb, 0x86, 0x75, 0xe3, 0xe0, 0x5c, 0x18, 0xff, 0x85, 0xbb, 0x59,
-	0x2e, 0xa7, 0x95, 0x9c, 0x85, 0xd6, 0x93, 0xfc, 0x4f, 0xb6, 0xa1, 0x24, 0xa5, 0x7d, 0xa1, 0x08,
-	0x8d, 0xd8, 0xf1, 0x99, 0xbb, 0x12, 0xea, 0x9d, 0x54, 0x25, 0xd4, 0x8d, 0xd1, 0xa3, 0x2f, 0xd1,
-	0xac, 0x4e, 0xbb, 0x18, 0xea, 0x9f, 0x14, 0xa1, 0xb4, 0xb5, 0xb4, 0x9c, 0xb4, 0xdf, 0x0a, 0x0f,
-	0xc0, 0x7e, 0xdb, 0x85, 0xb1, 0xed, 0xbe, 0x65, 0x07, 0x96, 0x93, 0xfb, 0xe2, 0x8b, 0x2a, 0x1c,
-	0x2b, 0xf3, 0xc7, 0x05, 0x56, 0x54, 0xe8, 0x49, 0x1b, 0xc6, 0xda, 0xa2, 0x5a, 0x40, 0xee, 0x9c,
-	0x14, 0x59, 0x75, 0x40, 0x10, 0x92, 0x0f, 0xa8, 0xb0, 0x6b, 0x9f, 0x01, 0xf9, 0xd7, 0x53, 0xc4,
-	0x3f, 0x1d, 0x6e, 0x86, 0xda, 0x61, 0x16, 0x47, 0xb5, 0x4f, 0x43, 0x28, 0x9a, 0x1f, 0xf8, 0xe7,
-	0xd4, 0xfe, 0xa5, 0x00, 0x49, 0x6d, 0xe4, 0xc1, 0xaf, 0xa8, 0x4e, 0x7a, 0x45, 0x2d, 0x9d, 0xc4,
-	0x06, 0xcc, 0x5e, 0x54, 0xda, 0x1f, 0x16, 0xa1, 0x2a, 0xff, 0x4c, 0xec, 0xf4, 0x53, 0x6c, 0x68,
-	0x22, 0xc5, 0x66, 0x31, 0xe7, 0xe1, 0x38, 0x34, 0xc1, 0xa6, 0x9b, 0x4a, 0xb0, 0xc9, 0xfb, 0x77,
-	0x17, 0xf7, 0x49, 0xaf, 0xf9, 0xcb, 0x02, 0xc8, 0xa3, 0x79, 0xd5, 0xf1, 0x03, 0xdd, 0x31, 0xf8,
-	0xbf, 0xae, 0x49, 0x39, 0x90, 0x37, 0x8e, 0x2b, 0x73, 0x1d, 0x84, 0xe8, 0xe7, 0xbf, 0xd5, 0xb9,
-	0x4f, 0xde, 0x07, 0xb5, 0x5d, 0xd7, 0x0f, 0xf8, 0x59, 0x5f, 0x4c, 0x3a, 0x5b, 0x5e, 0x96, 0xed,
-	0x18, 0x42, 0xa4, 0x43, 0x35, 0x95, 0xe1, 0xa1, 0x1a, 0xed, 0x6b, 0x45, 0x18, 0x4f, 0xfc, 0xc9,
-	0xc9, 0xc8, 0xd9, 0x42, 0xa9, 0x64, 0x9d, 0xe2, 0xc9, 0x27, 0xeb, 0x64, 0x25, 0x24, 0x95, 0x72,
-	0x26, 0x24, 0x95, 0x8f, 0x93, 0x90, 0xa4, 0x7d, 0xab, 0x00, 0xa0, 0xb8, 0x75, 0xea, 0xb9, 0x42,
-	0x66, 0x32, 0x57, 0x28, 0xf7, 0xba, 0xca, 0xce, 0x14, 0xfa, 0xfd, 0x8a, 0x7a, 0x25, 0x9e, 0x27,
-	0xf4, 0x76, 0x01, 0xce, 0xe8, 0x89, 0xdc, 0x9b, 0xdc, 0xea, 0x65, 0x2a, 0x95, 0x27, 0xfc, 0xbb,
-	0xb1, 0x64, 0x3b, 0xa6, 0xc8, 0x92, 0x17, 0x60, 0xbc, 0x27, 0x13, 0x13, 0x6e, 0x44, 0xcb, 0x3e,
-	0xf4, 0xe5, 0x6c, 0xc4, 0xfa, 0x30, 0x01, 0x79, 0x9f, 0x5c, 0xa7, 0xd2, 0x89, 0xe4, 0x3a, 0xc5,
-	0x6f, 0x71, 0x94, 0xef, 0x79, 0x8b, 0x63, 0x0f, 0xea, 0x3b, 0x9e, 0xdb, 0xe5, 0xe9, 0x44, 0xf2,
-	0x8f, 0x32, 0xae, 0xe5, 0x90, 0x29, 0xd1, 0x5f, 0x44, 0x45, 0xa2, 0x75, 0x59, 0xe1, 0xc7, 0x88,
-	0x14, 0xf7, 0x12, 0xbb, 0x82, 0x6a, 0xf5, 0x24, 0xa9, 0x86, 0x67, 0xc9, 0xa6, 0xc0, 0x8e, 0x8a,
-	0x4c, 0x32, 0x85, 0x68, 0xec, 0xc1, 0xa4, 0x10, 0x69, 0xdf, 0x0e, 0x0f, 0xb0, 0x56, 0xaa, 0x48,
-	0x44, 0x61, 0x48, 0x91, 0x08, 0x59, 0x51, 0x2b, 0x9e, 0xec, 0xf2, 0x34, 0x54, 0x3d, 0xaa, 0xfb,
-	0xae, 0x23, 0x0b, 0x12, 0x86, 0xc7, 0x3f, 0xf2, 0x56, 0x94, 0xbd, 0xf1, 0xa4, 0x98, 0xe2, 0x7d,
-	0x92, 0x62, 0xde, 0x17, 0x5b, 0x20, 0x22, 0xeb, 0x31, 0xdc, 0xeb, 0x19, 0x8b, 0x84, 0x47, 0xcc,
-	0xe5, 0x1f, 0x3d, 0x57, 0xd2, 0x11, 0x73, 0xf9, 0x27, 0xcc, 0x21, 0x04, 0x31, 0x61, 0xdc, 0xd6,
-	0xfd, 0x80, 0x87, 0x62, 0xcc, 0x85, 0x60, 0x84, 0x8c, 0x9b, 0x70, 0x1b, 0xad, 0xc5, 0xf0, 0x60,
-	0x02, 0xab, 0x76, 0x50, 0x82, 0x94, 0x19, 0xf2, 0x53, 0xef, 0xfb, 0x7f, 0x2b, 0xef, 0xfb, 0x3b,
-	0x45, 0x88, 0xf6, 0xd4, 0x31, 0x23, 0xd1, 0xaf, 0x41, 0xad, 0xab, 0xdf, 0x59, 0xa2, 0xb6, 0xbe,
-	0x9f, 0xe7, 0x8f, 0x02, 0xd6, 0x25, 0x0e, 0x0c, 0xb1, 0x11, 0x1f, 0xc0, 0x0a, 0x6b, 0x62, 0xe5,
-	0xf6, 0xa6, 0x46, 0xe5, 0xb5, 0x84, 0x7b, 0x28, 0x7a, 0xc6, 0x18, 0x19, 0xed, 0x2f, 0x8a, 0x20,
-	0x8b, 0xa7, 0x11, 0x0a, 0x95, 0x1d, 0xeb, 0x0e, 0x35, 0x73, 0x67, 0x65, 0xc5, 0xfe, 0xbe, 0x45,
-	0xb8, 0x8b, 0x79, 0x03, 0x0a, 0xec, 0xa4, 0x0b, 0x63, 0xbe, 0x70, 0xff, 0x4b, 0xfe, 0x8d, 0xee,
-	0x64, 0x4d, 0x84, 0x11, 0x64, 0x29, 0x34, 0xd1, 0x84, 0x8a, 0x06, 0x27, 0x27, 0x62, 0xb0, 0x92,
-	0xa5, 0x39, 0xc8, 0xc5, 0x63, 0xb9, 0x92, 0x9c, 0x68, 0x42, 0x45, 0xa3, 0xf9, 0x89, 0x6f, 0x7e,
-	0xf7, 0xf2, 0x23, 0xdf, 0xfa, 0xee, 0xe5, 0x47, 0xbe, 0xf3, 0xdd, 0xcb, 0x8f, 0x7c, 0xee, 0xf0,
-	0x72, 0xe1, 0x9b, 0x87, 0x97, 0x0b, 0xdf, 0x3a, 0xbc, 0x5c, 0xf8, 0xce, 0xe1, 0xe5, 0xc2, 0xdf,
-	0x1d, 0x5e, 0x2e, 0xfc, 0xca, 0xdf, 0x5f, 0x7e, 0xe4, 0xe3, 0xcf, 0x47, 0x53, 0x98, 0x53, 0x53,
-	0x98, 0x53, 0x04, 0xf9, 0x3f, 0xf5, 0xb3, 0x29, 0x44, 0x2d, 0x6a, 0x0a, 0xff, 0x15, 0x00, 0x00,
-	0xff, 0xff, 0x01, 0x37, 0x0f, 0xd2, 0x4c, 0x81, 0x00, 0x00,
+	// 8870 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x6c, 0x24, 0x57,
+	0x76, 0x18, 0xac, 0x7e, 0x91, 0xdd, 0xa7, 0xc9, 0x79, 0xdc, 0x91, 0xb4, 0xa5, 0x59, 0x69, 0x38,
+	0x2e, 0x7d, 0x2b, 0x8f, 0xfd, 0xad, 0xc9, 0x68, 0xb2, 0x5a, 0x69, 0xd7, 0xf1, 0x4a, 0x6c, 0x72,
+	0x38, 0xa2, 0x86, 0x9c, 0xa1, 0x4e, 0x93, 0x33, 0xeb, 0x55, 0xbc, 0x72, 0xb1, 0xfb, 0x76, 0xb3,
+	0xc4, 0xea, 0xaa, 0x56, 0x55, 0x35, 0x67, 0xa8, 0xd8, 0xf0, 0xda, 0x0e, 0xa2, 0x4d, 0x9c, 0xc7,
+	0x22, 0xf9, 0x61, 0x03, 0x76, 0x1e, 0x06, 0x82, 0x04, 0x48, 0xe0, 0x3f, 0x01, 0x9c, 0x20, 0x09,
+	0x8c, 0x24, 0x3f, 0x62, 0x6c, 0xde, 0xfb, 0x23, 0x40, 0x1c, 0x04, 0x60, 0xb2, 0x0c, 0xf2, 0x23,
+	0x31, 0x62, 0x18, 0x71, 0x90, 0xc7, 0x20, 0x88, 0x83, 0xfb, 0xac, 0x5b, 0xd5, 0xd5, 0x33, 0xc3,
+	0x2e, 0x52, 0x3b, 0x4a, 0xf6, 0x57, 0x77, 0x9d, 0x73, 0xee, 0x39, 0xb7, 0x6e, 0xdd, 0xc7, 0xb9,
+	0xe7, 0x9c, 0x7b, 0x2e, 0xdc, 0xec, 0xbb, 0xf1, 0xde, 0x68, 0x77, 0xb1, 0x13, 0x0c, 0x96, 0xfc,
+	0xd1, 0xc0, 0x19, 0x86, 0xc1, 0x07, 0xfc, 0x4f, 0xcf, 0x0b, 0xee, 0x2f, 0x0d, 0xf7, 0xfb, 0x4b,
+	0xce, 0xd0, 0x8d, 0x12, 0xc8, 0xc1, 0xab, 0x8e, 0x37, 0xdc, 0x73, 0x5e, 0x5d, 0xea, 0x53, 0x9f,
+	0x86, 0x4e, 0x4c, 0xbb, 0x8b, 0xc3, 0x30, 0x88, 0x03, 0xf2, 0x7a, 0xc2, 0x68, 0x51, 0x31, 0x5a,
+	0x54, 0xc5, 0x16, 0x87, 0xfb, 0xfd, 0x45, 0xc6, 0x28, 0x81, 0x28, 0x46, 0x97, 0x7f, 0xc4, 0xa8,
+	0x41, 0x3f, 0xe8, 0x07, 0x4b, 0x9c, 0xdf, 0xee, 0xa8, 0xc7, 0x9f, 0xf8, 0x03, 0xff, 0x27, 0xe4,
+	0x5c, 0xb6, 0xf7, 0xdf, 0x88, 0x16, 0xdd, 0x80, 0x55, 0x6b, 0xa9, 0x13, 0x84, 0x74, 0xe9, 0x60,
+	0xac, 0x2e, 0x97, 0xbf, 0x90, 0xd0, 0x0c, 0x9c, 0xce, 0x9e, 0xeb, 0xd3, 0xf0, 0x50, 0xbd, 0xcb,
+	0x52, 0x48, 0xa3, 0x60, 0x14, 0x76, 0xe8, 0x89, 0x4a, 0x45, 0x4b, 0x03, 0x1a, 0x3b, 0x79, 0xb2,
+	0x96, 0x26, 0x95, 0x0a, 0x47, 0x7e, 0xec, 0x0e, 0xc6, 0xc5, 0x7c, 0xf1, 0x71, 0x05, 0xa2, 0xce,
+	0x1e, 0x1d, 0x38, 0xd9, 0x72, 0xf6, 0xbf, 0x69, 0xc0, 0xa5, 0xe5, 0xdd, 0x28, 0x0e, 0x9d, 0x4e,
+	0xbc, 0x15, 0x74, 0xb7, 0xe9, 0x60, 0xe8, 0x39, 0x31, 0x25, 0xfb, 0x50, 0x67, 0x75, 0xeb, 0x3a,
+	0xb1, 0x63, 0x95, 0xae, 0x96, 0xae, 0x35, 0xaf, 0x2f, 0x2f, 0x4e, 0xf9, 0x2d, 0x16, 0x37, 0x25,
+	0xa3, 0xd6, 0xdc, 0xf1, 0xd1, 0x42, 0x5d, 0x3d, 0xa1, 0x16, 0x40, 0x7e, 0xa9, 0x04, 0x73, 0x7e,
+	0xd0, 0xa5, 0x6d, 0xea, 0xd1, 0x4e, 0x1c, 0x84, 0x56, 0xf9, 0x6a, 0xe5, 0x5a, 0xf3, 0xfa, 0xd7,
+	0xa7, 0x96, 0x98, 0xf3, 0x46, 0x8b, 0xb7, 0x0d, 0x01, 0x37, 0xfc, 0x38, 0x3c, 0x6c, 0x3d, 0xfb,
+	0xed, 0xa3, 0x85, 0x67, 0x8e, 0x8f, 0x16, 0xe6, 0x4c, 0x14, 0xa6, 0x6a, 0x42, 0x76, 0xa0, 0x19,
+	0x07, 0x1e, 0x6b, 0x32, 0x37, 0xf0, 0x23, 0xab, 0xc2, 0x2b, 0x76, 0x65, 0x51, 0xb4, 0x36, 0x13,
+	0xbf, 0xc8, 0xba, 0xcb, 0xe2, 0xc1, 0xab, 0x8b, 0xdb, 0x9a, 0xac, 0x75, 0x49, 0x32, 0x6e, 0x26,
+	0xb0, 0x08, 0x4d, 0x3e, 0x84, 0xc2, 0xf9, 0x88, 0x76, 0x46, 0xa1, 0x1b, 0x1f, 0xae, 0x04, 0x7e,
+	0x4c, 0x1f, 0xc4, 0x56, 0x95, 0xb7, 0xf2, 0x2b, 0x79, 0xac, 0xb7, 0x82, 0x6e, 0x3b, 0x4d, 0xdd,
+	0xba, 0x74, 0x7c, 0xb4, 0x70, 0x3e, 0x03, 0xc4, 0x2c, 0x4f, 0xe2, 0xc3, 0x05, 0x77, 0xe0, 0xf4,
+	0xe9, 0xd6, 0xc8, 0xf3, 0xda, 0xb4, 0x13, 0xd2, 0x38, 0xb2, 0x6a, 0xfc, 0x15, 0xae, 0xe5, 0xc9,
+	0xd9, 0x08, 0x3a, 0x8e, 0x77, 0x67, 0xf7, 0x03, 0xda, 0x89, 0x91, 0xf6, 0x68, 0x48, 0xfd, 0x0e,
+	0x6d, 0x59, 0xf2, 0x65, 0x2e, 0xac, 0x67, 0x38, 0xe1, 0x18, 0x6f, 0x72, 0x13, 0x2e, 0x0e, 0x43,
+	0x37, 0xe0, 0x55, 0xf0, 0x9c, 0x28, 0xba, 0xed, 0x0c, 0xa8, 0x35, 0x73, 0xb5, 0x74, 0xad, 0xd1,
+	0x7a, 0x41, 0xb2, 0xb9, 0xb8, 0x95, 0x25, 0xc0, 0xf1, 0x32, 0xe4, 0x1a, 0xd4, 0x15, 0xd0, 0x9a,
+	0xbd, 0x5a, 0xba, 0x56, 0x13, 0x7d, 0x47, 0x95, 0x45, 0x8d, 0x25, 0x6b, 0x50, 0x77, 0x7a, 0x3d,
+	0xd7, 0x67, 0x94, 0x75, 0xde, 0x84, 0x2f, 0xe6, 0xbd, 0xda, 0xb2, 0xa4, 0x11, 0x7c, 0xd4, 0x13,
+	0xea, 0xb2, 0xe4, 0x1d, 0x20, 0x11, 0x0d, 0x0f, 0xdc, 0x0e, 0x5d, 0xee, 0x74, 0x82, 0x91, 0x1f,
+	0xf3, 0xba, 0x37, 0x78, 0xdd, 0x2f, 0xcb, 0xba, 0x93, 0xf6, 0x18, 0x05, 0xe6, 0x94, 0x22, 0x6f,
+	0xc1, 0x05, 0x39, 0xec, 0x92, 0x56, 0x00, 0xce, 0xe9, 0x59, 0xd6, 0x90, 0x98, 0xc1, 0xe1, 0x18,
+	0x35, 0xe9, 0xc2, 0x8b, 0xce, 0x28, 0x0e, 0x06, 0x8c, 0x65, 0x5a, 0xe8, 0x76, 0xb0, 0x4f, 0x7d,
+	0xab, 0x79, 0xb5, 0x74, 0xad, 0xde, 0xba, 0x7a, 0x7c, 0xb4, 0xf0, 0xe2, 0xf2, 0x23, 0xe8, 0xf0,
+	0x91, 0x5c, 0xc8, 0x1d, 0x68, 0x74, 0xfd, 0x68, 0x2b, 0xf0, 0xdc, 0xce, 0xa1, 0x35, 0xc7, 0x2b,
+	0xf8, 0xaa, 0x7c, 0xd5, 0xc6, 0xea, 0xed, 0xb6, 0x40, 0x3c, 0x3c, 0x5a, 0x78, 0x71, 0x7c, 0x76,
+	0x5c, 0xd4, 0x78, 0x4c, 0x78, 0x90, 0x4d, 0xce, 0x70, 0x25, 0xf0, 0x7b, 0x6e, 0xdf, 0x9a, 0xe7,
+	0x5f, 0xe3, 0xea, 0x84, 0x0e, 0xbd, 0x7a, 0xbb, 0x2d, 0xe8, 0x5a, 0xf3, 0x52, 0x9c, 0x78, 0xc4,
+	0x84, 0xc3, 0xe5, 0x37, 0xe1, 0xe2, 0xd8, 0xa8, 0x25, 0x17, 0xa0, 0xb2, 0x4f, 0x0f, 0xf9, 0xa4,
+	0xd4, 0x40, 0xf6, 0x97, 0x3c, 0x0b, 0xb5, 0x03, 0xc7, 0x1b, 0x51, 0xab, 0xcc, 0x61, 0xe2, 0xe1,
+	0xcb, 0xe5, 0x37, 0x4a, 0xf6, 0x2f, 0xce, 0xc2, 0x9c, 0x9a, 0x0b, 0xda, 0xae, 0xbf, 0x4f, 0xee,
+	0x41, 0xc5, 0x0b, 0xfa, 0x72, 0x46, 0xfb, 0x43, 0x53, 0xcf, 0x2f, 0x1b, 0x41, 0xbf, 0x35, 0x7b,
+	0x7c, 0xb4, 0x50, 0xd9, 0x08, 0xfa, 0xc8, 0x38, 0x92, 0x0e, 0xd4, 0xf6, 0x9d, 0xde, 0xbe, 0xc3,
+	0xeb, 0xd0, 0xbc, 0xde, 0x9a, 0x9a, 0xf5, 0x2d, 0xc6, 0x85, 0xd5, 0xb5, 0xd5, 0x38, 0x3e, 0x5a,
+	0xa8, 0xf1, 0x47, 0x14, 0xbc, 0x49, 0x00, 0x8d, 0x5d, 0xcf, 0xe9, 0xec, 0xef, 0x05, 0x1e, 0xb5,
+	0x2a, 0x05, 0x05, 0xb5, 0x14, 0x27, 0xf1, 0x01, 0xf4, 0x23, 0x26, 0x32, 0x48, 0x07, 0x66, 0x46,
+	0xdd, 0xc8, 0xf5, 0xf7, 0xe5, 0xec, 0xf4, 0xe6, 0xd4, 0xd2, 0x76, 0x56, 0xf9, 0x3b, 0xc1, 0xf1,
+	0xd1, 0xc2, 0x8c, 0xf8, 0x8f, 0x92, 0x35, 0x79, 0x1f, 0xaa, 0x7b, 0x71, 0x3c, 0xb4, 0x6a, 0x05,
+	0x97, 0x99, 0xb7, 0xb7, 0xb7, 0xb7, 0xb8, 0x90, 0xfa, 0xf1, 0xd1, 0x42, 0x95, 0x3d, 0x21, 0x67,
+	0xcc, 0x04, 0xf4, 0x5c, 0x4f, 0x4c, 0x44, 0x45, 0x04, 0xac, 0xb9, 0x1e, 0x4d, 0x04, 0xb0, 0x27,
+	0xe4, 0x8c, 0x99, 0x00, 0xdf, 0x89, 0x23, 0x6b, 0xb6, 0xa0, 0x80, 0xdb, 0x4e, 0x1c, 0x25, 0x02,
+	0xd8, 0x13, 0x72, 0xc6, 0x24, 0x82, 0xc6, 0x07, 0x34, 0x8e, 0xe2, 0x90, 0x3a, 0x03, 0x39, 0xcb,
+	0xad, 0x4d, 0x2d, 0xe5, 0x1d, 0x1a, 0xb7, 0x39, 0x27, 0x2e, 0x8a, 0x7f, 0x7c, 0x0d, 0xc2, 0x44,
+	0x0e, 0x79, 0x0f, 0x2a, 0xd1, 0x87, 0x1e, 0x9f, 0x02, 0x9b, 0xd7, 0xdf, 0x9a, 0x5a, 0x5c, 0xfb,
+	0xdd, 0x0d, 0x2e, 0x88, 0x8f, 0x97, 0xf6, 0xbb, 0x1b, 0xc8, 0xb8, 0xda, 0xbf, 0xd3, 0x84, 0x73,
+	0x6a, 0x64, 0xde, 0xa5, 0x61, 0x4c, 0x1f, 0x90, 0xab, 0xac, 0x15, 0x07, 0x54, 0x8c, 0xec, 0xd6,
+	0x9c, 0x9c, 0x88, 0xaa, 0x7c, 0x86, 0xe4, 0x18, 0xd6, 0x1d, 0x85, 0x96, 0x65, 0x95, 0x0b, 0x76,
+	0xc7, 0x36, 0x67, 0x23, 0xba, 0xa3, 0xf8, 0x8f, 0x92, 0x35, 0x79, 0x0f, 0xaa, 0xbc, 0xc7, 0x8b,
+	0xf1, 0xf5, 0x63, 0xd3, 0x8b, 0xd0, 0x1f, 0x92, 0xfd, 0xc3, 0x6a, 0x24, 0xe7, 0x9f, 0x51, 0xb7,
+	0x67, 0x55, 0x0b, 0xce, 0x3f, 0x3b, 0xab, 0x6b, 0xa2, 0x3d, 0x77, 0x56, 0xd7, 0x90, 0x71, 0x24,
+	0x7f, 0xa6, 0x04, 0x17, 0x3b, 0x81, 0x1f, 0x3b, 0x4c, 0xf3, 0x53, 0x3a, 0x8f, 0x1c, 0x52, 0xef,
+	0x4c, 0x2d, 0x67, 0x25, 0xcb, 0xb1, 0xf5, 0x1c, 0x5b, 0xc2, 0xc7, 0xc0, 0x38, 0x2e, 0x9b, 0xfc,
+	0x72, 0x09, 0x9e, 0x63, 0x4b, 0xeb, 0x18, 0xb1, 0x35, 0x73, 0xea, 0xb5, 0x7a, 0xe1, 0xf8, 0x68,
+	0xe1, 0xb9, 0xf5, 0x3c, 0x61, 0x98, 0x5f, 0x07, 0x56, 0xbb, 0x4b, 0xce, 0xb8, 0x96, 0x28, 0x87,
+	0xf0, 0xc6, 0x69, 0x6a, 0x9e, 0xad, 0xcf, 0xca, 0xae, 0x9c, 0xa7, 0x68, 0x63, 0x5e, 0x2d, 0xc8,
+	0x0d, 0x98, 0x3d, 0x08, 0xbc, 0xd1, 0x80, 0x46, 0x56, 0x9d, 0xab, 0x6b, 0x97, 0xf3, 0x56, 0xd1,
+	0xbb, 0x9c, 0xa4, 0x75, 0x5e, 0xb2, 0x9f, 0x15, 0xcf, 0x11, 0xaa, 0xb2, 0xc4, 0x85, 0x19, 0xcf,
+	0x1d, 0xb8, 0x71, 0x24, 0x07, 0xf1, 0x8d, 0xa9, 0x5f, 0x4b, 0x0c, 0xd1, 0x0d, 0xce, 0x4c, 0x8c,
+	0x1a, 0xf1, 0x1f, 0xa5, 0x00, 0xb6, 0xfe, 0x45, 0x1d, 0xc7, 0x13, 0x7a, 0x4e, 0xf3, 0xfa, 0x57,
+	0xa6, 0x1f, 0x36, 0x8c, 0x4b, 0x6b, 0x5e, 0xbe, 0x53, 0x8d, 0x3f, 0xa2, 0xe0, 0x4d, 0x7e, 0x02,
+	0xce, 0xa5, 0xbe, 0x66, 0x64, 0x35, 0x79, 0xeb, 0xbc, 0x94, 0xd7, 0x3a, 0x9a, 0xaa, 0xf5, 0xbc,
+	0x64, 0x76, 0x2e, 0xd5, 0x43, 0x22, 0xcc, 0x30, 0x23, 0xb7, 0xa0, 0x1e, 0xb9, 0x5d, 0xda, 0x71,
+	0xc2, 0xc8, 0x9a, 0x7b, 0x12, 0xc6, 0x17, 0x24, 0xe3, 0x7a, 0x5b, 0x16, 0x43, 0xcd, 0x80, 0x2c,
+	0x02, 0x0c, 0x9d, 0x30, 0x76, 0xc5, 0xbe, 0x61, 0x9e, 0xeb, 0xb0, 0xe7, 0x8e, 0x8f, 0x16, 0x60,
+	0x4b, 0x43, 0xd1, 0xa0, 0x60, 0xf4, 0xac, 0xec, 0xba, 0x3f, 0x1c, 0xc5, 0x91, 0x75, 0xee, 0x6a,
+	0xe5, 0x5a, 0x43, 0xd0, 0xb7, 0x35, 0x14, 0x0d, 0x0a, 0xf2, 0x6b, 0x25, 0xf8, 0x6c, 0xf2, 0x38,
+	0x3e, 0xc8, 0xce, 0x9f, 0xfa, 0x20, 0x5b, 0x38, 0x3e, 0x5a, 0xf8, 0x6c, 0x7b, 0xb2, 0x48, 0x7c,
+	0x54, 0x7d, 0xec, 0x7b, 0x30, 0xbf, 0x3c, 0x8a, 0xf7, 0x82, 0xd0, 0xfd, 0x88, 0xef, 0x81, 0xc8,
+	0x1a, 0xd4, 0x62, 0xae, 0xcb, 0x0a, 0x65, 0xec, 0x73, 0x79, 0x4d, 0x2d, 0xf6, 0x15, 0xb7, 0xe8,
+	0xa1, 0x52, 0x01, 0x85, 0x52, 0x24, 0x74, 0x5b, 0x51, 0xdc, 0xfe, 0xd5, 0x12, 0x34, 0x5a, 0x4e,
+	0xe4, 0x76, 0x18, 0x7b, 0xb2, 0x02, 0xd5, 0x51, 0x44, 0xc3, 0x93, 0x31, 0xe5, 0xb3, 0xf4, 0x4e,
+	0x44, 0x43, 0xe4, 0x85, 0xc9, 0x1d, 0xa8, 0x0f, 0x9d, 0x28, 0xba, 0x1f, 0x84, 0x5d, 0xab, 0x7c,
+	0x12, 0x46, 0x62, 0x93, 0x22, 0x8b, 0xa2, 0x66, 0x62, 0x37, 0x21, 0xd1, 0xaf, 0xec, 0xdf, 0x2b,
+	0xc1, 0xa5, 0xd6, 0xa8, 0xd7, 0xa3, 0xa1, 0xd4, 0xc9, 0x85, 0xb6, 0x4b, 0x28, 0xd4, 0x42, 0xda,
+	0x75, 0x23, 0x59, 0xf7, 0xd5, 0xa9, 0x3f, 0x1d, 0x32, 0x2e, 0x52, 0xb9, 0xe6, 0xed, 0xc5, 0x01,
+	0x28, 0xb8, 0x93, 0x91, 0xa9, 0x4b, 0x88, 0xb7, 0x7b, 0xbb, 0xb8, 0x2e, 0x61, 0xea, 0xf2, 0x79,
+	0xda, 0x84, 0xfd, 0x0f, 0x6a, 0x30, 0xb7, 0x12, 0x0c, 0x76, 0x5d, 0x9f, 0x76, 0x6f, 0x74, 0xfb,
+	0x5c, 0x69, 0xa2, 0xdd, 0x3e, 0xb5, 0x4a, 0x05, 0xd7, 0x59, 0xc6, 0x2c, 0xd1, 0x16, 0xd8, 0x13,
+	0x72, 0xc6, 0x64, 0x03, 0xce, 0xf5, 0xc2, 0x60, 0x20, 0xa6, 0xae, 0xed, 0xc3, 0xa1, 0xdc, 0x1f,
+	0xb4, 0xfe, 0x3f, 0x35, 0x1d, 0xac, 0xa5, 0xb0, 0x0f, 0x8f, 0x16, 0x20, 0x79, 0xc2, 0x4c, 0x59,
+	0xf2, 0x55, 0xb0, 0x12, 0x88, 0x1e, 0xc3, 0x2b, 0x6c, 0x33, 0xc5, 0x55, 0x85, 0x5a, 0xeb, 0xc5,
+	0xe3, 0xa3, 0x05, 0x6b, 0x6d, 0x02, 0x0d, 0x4e, 0x2c, 0x4d, 0x3e, 0x2e, 0xc1, 0x85, 0x04, 0x29,
+	0xe6, 0x55, 0xab, 0x7a, 0x9a, 0x13, 0x36, 0xdf, 0x75, 0xae, 0x65, 0x44, 0xe0, 0x98, 0x50, 0xb2,
+	0x06, 0x73, 0x71, 0x60, 0xb4, 0x57, 0x8d, 0xb7, 0x97, 0xad, 0xcc, 0x24, 0xdb, 0xc1, 0xc4, 0xd6,
+	0x4a, 0x95, 0x23, 0x08, 0xcf, 0xc7, 0x41, 0xde, 0xbb, 0xf2, 0xa5, 0xbf, 0xd6, 0xba, 0x7c, 0x7c,
+	0xb4, 0xf0, 0xfc, 0x76, 0x2e, 0x05, 0x4e, 0x28, 0x49, 0x7e, 0xb6, 0x04, 0xe7, 0xe2, 0xc0, 0xac,
+	0xae, 0x35, 0x7b, 0x9a, 0x6d, 0x44, 0x58, 0x8f, 0xd8, 0x4e, 0x09, 0xc0, 0x8c, 0x40, 0xfb, 0x7f,
+	0x54, 0xa1, 0xa1, 0x67, 0x36, 0xf2, 0x32, 0xd4, 0xb8, 0x01, 0x44, 0x2a, 0xac, 0x7a, 0xc9, 0xe2,
+	0x76, 0x12, 0x14, 0x38, 0xf2, 0x39, 0x98, 0xed, 0x04, 0x83, 0x81, 0xe3, 0x77, 0xb9, 0x51, 0xab,
+	0xd1, 0x6a, 0xb2, 0x95, 0x7a, 0x45, 0x80, 0x50, 0xe1, 0xc8, 0x8b, 0x50, 0x75, 0xc2, 0xbe, 0xb0,
+	0x2f, 0x35, 0xc4, 0x7c, 0xb4, 0x1c, 0xf6, 0x23, 0xe4, 0x50, 0xf2, 0x25, 0xa8, 0x50, 0xff, 0xc0,
+	0xaa, 0x4e, 0x56, 0x05, 0x6e, 0xf8, 0x07, 0x77, 0x9d, 0xb0, 0xd5, 0x94, 0x75, 0xa8, 0xdc, 0xf0,
+	0x0f, 0x90, 0x95, 0x21, 0x1b, 0x30, 0x4b, 0xfd, 0x03, 0xf6, 0xed, 0xa5, 0xe1, 0xe7, 0x07, 0x26,
+	0x14, 0x67, 0x24, 0x52, 0x2b, 0xd6, 0x0a, 0x85, 0x04, 0xa3, 0x62, 0x41, 0x7e, 0x1c, 0xe6, 0x84,
+	0x6e, 0xb1, 0xc9, 0xbe, 0x49, 0x64, 0xcd, 0x70, 0x96, 0x0b, 0x93, 0x95, 0x13, 0x4e, 0x97, 0x18,
+	0xda, 0x0c, 0x60, 0x84, 0x29, 0x56, 0xe4, 0xc7, 0xa1, 0xa1, 0x6c, 0xa8, 0xea, 0xcb, 0xe6, 0xda,
+	0xa8, 0x50, 0x12, 0x21, 0xfd, 0x70, 0xe4, 0x86, 0x74, 0x40, 0xfd, 0x38, 0x6a, 0x5d, 0x54, 0x56,
+	0x0b, 0x85, 0x8d, 0x30, 0xe1, 0x46, 0x76, 0xc7, 0x8d, 0x6d, 0x62, 0x0f, 0xf5, 0xf2, 0x84, 0x59,
+	0x7d, 0x0a, 0x4b, 0xdb, 0xd7, 0xe1, 0xbc, 0xb6, 0x86, 0x49, 0x83, 0x8a, 0xb0, 0x1d, 0x7d, 0x81,
+	0x15, 0x5f, 0x4f, 0xa3, 0x1e, 0x1e, 0x2d, 0xbc, 0x94, 0x63, 0x52, 0x49, 0x08, 0x30, 0xcb, 0xcc,
+	0xfe, 0x7b, 0x15, 0x18, 0x57, 0xbb, 0xd3, 0x8d, 0x56, 0x3a, 0xed, 0x46, 0xcb, 0xbe, 0x90, 0x98,
+	0x3e, 0xdf, 0x90, 0xc5, 0x8a, 0xbf, 0x54, 0xde, 0x87, 0xa9, 0x9c, 0xf6, 0x87, 0x79, 0x5a, 0xc6,
+	0x8e, 0xfd, 0xcd, 0x2a, 0x9c, 0x5b, 0x75, 0xe8, 0x20, 0xf0, 0x1f, 0xbb, 0x09, 0x29, 0x3d, 0x15,
+	0x9b, 0x90, 0x6b, 0x50, 0x0f, 0xe9, 0xd0, 0x73, 0x3b, 0x4e, 0x64, 0x95, 0x13, 0x1b, 0x2c, 0x4a,
+	0x18, 0x6a, 0xec, 0x84, 0xcd, 0x67, 0xe5, 0xa9, 0xdc, 0x7c, 0x56, 0xbf, 0xf7, 0x9b, 0x4f, 0xfb,
+	0x67, 0xcb, 0xc0, 0x15, 0x15, 0x66, 0xf2, 0x60, 0x8b, 0x70, 0xd6, 0xe4, 0xc1, 0x3b, 0x0e, 0xc7,
+	0x90, 0xcb, 0x50, 0x8e, 0x03, 0x39, 0xf2, 0x40, 0xe2, 0xcb, 0xdb, 0x01, 0x96, 0xe3, 0x80, 0x7c,
+	0x04, 0xd0, 0x09, 0xfc, 0xae, 0xab, 0x5c, 0x13, 0xc5, 0x5e, 0x6c, 0x2d, 0x08, 0xef, 0x3b, 0x61,
+	0x77, 0x45, 0x73, 0x14, 0xdb, 0x8f, 0xe4, 0x19, 0x0d, 0x69, 0xe4, 0x4d, 0x98, 0x09, 0xfc, 0xb5,
+	0x91, 0xe7, 0xf1, 0x06, 0x6d, 0xb4, 0x7e, 0x90, 0xed, 0x09, 0xef, 0x70, 0xc8, 0xc3, 0xa3, 0x85,
+	0x17, 0x84, 0x7e, 0xcb, 0x9e, 0xee, 0x85, 0x6e, 0xec, 0xfa, 0xfd, 0x76, 0x1c, 0x3a, 0x31, 0xed,
+	0x1f, 0xa2, 0x2c, 0x66, 0xff, 0xa5, 0x0a, 0xd4, 0x95, 0x41, 0x8d, 0xbc, 0x02, 0x33, 0x62, 0x31,
+	0x90, 0x2d, 0x71, 0x4e, 0xbe, 0xe9, 0x8c, 0x58, 0x30, 0x50, 0x62, 0x59, 0x7b, 0x0d, 0x9d, 0x78,
+	0xcf, 0x2a, 0xa7, 0xdb, 0x6b, 0xcb, 0x89, 0xf7, 0x90, 0x63, 0x18, 0xa7, 0x61, 0x48, 0x7b, 0xee,
+	0x03, 0xab, 0x92, 0xe6, 0xb4, 0xc5, 0xa1, 0x28, 0xb1, 0xe4, 0x0d, 0x98, 0xe9, 0x05, 0xe1, 0xc0,
+	0x89, 0x65, 0xfd, 0xaf, 0x2a, 0xba, 0x35, 0x0e, 0x7d, 0xc8, 0xd4, 0x43, 0x59, 0x3b, 0x01, 0x41,
+	0x49, 0x2f, 0x56, 0x74, 0x6f, 0x34, 0xf0, 0x85, 0x2b, 0x45, 0xaf, 0xe8, 0x1c, 0x84, 0x0a, 0x47,
+	0x36, 0xa0, 0xd9, 0x09, 0x06, 0xc3, 0x90, 0x46, 0x91, 0x1b, 0xf8, 0xd2, 0x09, 0xf2, 0xc3, 0xca,
+	0x31, 0xb4, 0x92, 0xa0, 0x1e, 0x1e, 0x2d, 0x5c, 0x52, 0xa2, 0x0c, 0x30, 0x9a, 0xc5, 0x49, 0x04,
+	0xf5, 0x30, 0x88, 0xf9, 0xc6, 0x49, 0x2e, 0x8e, 0xeb, 0x85, 0xcd, 0x98, 0x28, 0x19, 0xca, 0x61,
+	0x2d, 0x9f, 0x50, 0x0b, 0xb2, 0x7f, 0xb1, 0x0c, 0x17, 0xb2, 0xc4, 0x64, 0x07, 0x66, 0x07, 0xce,
+	0x83, 0xb6, 0xfb, 0x91, 0x9a, 0xa6, 0x16, 0x8d, 0x49, 0x51, 0xbb, 0x1e, 0x95, 0xfc, 0x45, 0xb5,
+	0xa0, 0x2c, 0xbe, 0x3b, 0x72, 0xfc, 0x98, 0x39, 0x60, 0x78, 0x73, 0x6d, 0x0a, 0x16, 0xa8, 0x78,
+	0x91, 0xaf, 0x42, 0xdd, 0xf5, 0x63, 0x1a, 0x1e, 0x38, 0x9e, 0x55, 0x7e, 0x3c, 0xdf, 0x68, 0x71,
+	0x40, 0x63, 0x87, 0x4d, 0xbf, 0xab, 0xa3, 0xd0, 0x78, 0x8b, 0x75, 0xc9, 0x03, 0x35, 0x37, 0x82,
+	0x30, 0x73, 0xdf, 0xf5, 0xbb, 0xc1, 0x7d, 0xab, 0x32, 0x15, 0x5f, 0x6e, 0xed, 0xb8, 0xc7, 0x39,
+	0xa0, 0xe4, 0x64, 0xff, 0xb9, 0x32, 0x00, 0x6f, 0x19, 0x61, 0x32, 0x54, 0xdd, 0xb2, 0x34, 0xb1,
+	0x5b, 0x66, 0x15, 0xa7, 0xf2, 0xe9, 0x29, 0x4e, 0x36, 0xeb, 0xc9, 0x9e, 0x27, 0xdf, 0xaf, 0x2e,
+	0xea, 0xbb, 0xc6, 0x21, 0x28, 0x31, 0xa4, 0x0b, 0x73, 0xc3, 0xc0, 0xf3, 0x54, 0xeb, 0x58, 0xd5,
+	0xa9, 0x5a, 0xe2, 0x02, 0xab, 0xc9, 0x96, 0xc1, 0x07, 0x53, 0x5c, 0xed, 0x3f, 0x5b, 0x82, 0xe6,
+	0x9a, 0xfb, 0x80, 0x76, 0x45, 0x6b, 0xb1, 0x96, 0xf7, 0xa8, 0xdf, 0x97, 0x0d, 0x33, 0x65, 0xcb,
+	0x6f, 0x70, 0x0e, 0x28, 0x39, 0x91, 0x25, 0x68, 0x88, 0x0d, 0xa5, 0xeb, 0xf7, 0x79, 0x47, 0xa9,
+	0x27, 0x7a, 0x4c, 0x5b, 0x21, 0x30, 0xa1, 0xb1, 0x0f, 0xe1, 0xe2, 0xd8, 0xcc, 0x46, 0xba, 0x50,
+	0x8d, 0x9d, 0xbe, 0x52, 0x99, 0xa6, 0x37, 0xa5, 0x6f, 0x3b, 0x7d, 0x63, 0xbe, 0xe4, 0x6a, 0xfb,
+	0xb6, 0xc3, 0xd4, 0x76, 0xc6, 0xdd, 0xfe, 0x5f, 0x25, 0xa8, 0xaf, 0x8d, 0xfc, 0x0e, 0x1f, 0x37,
+	0x8f, 0xb7, 0x6e, 0xab, 0x3d, 0x40, 0x39, 0x77, 0x0f, 0x30, 0x82, 0x99, 0xfd, 0xfb, 0x7a, 0x8f,
+	0xd0, 0xbc, 0xbe, 0x39, 0xfd, 0xf8, 0x97, 0x55, 0x5a, 0xbc, 0xc5, 0xf9, 0x09, 0x5f, 0xb8, 0x9e,
+	0x27, 0x6f, 0xdd, 0xe3, 0x42, 0xa5, 0xb0, 0xcb, 0x5f, 0x82, 0xa6, 0x41, 0x76, 0x22, 0xe7, 0xdb,
+	0xdf, 0xac, 0xc2, 0xcc, 0xcd, 0x76, 0x7b, 0x79, 0x6b, 0x9d, 0xbc, 0x06, 0x4d, 0xe9, 0x26, 0xbd,
+	0x9d, 0xb4, 0x81, 0xf6, 0x92, 0xb7, 0x13, 0x14, 0x9a, 0x74, 0x6c, 0x87, 0x15, 0x52, 0xc7, 0x1b,
+	0x58, 0xe5, 0xf4, 0x0e, 0x0b, 0x19, 0x10, 0x05, 0x8e, 0x38, 0x70, 0x8e, 0x19, 0x6d, 0x58, 0x13,
+	0x0a, 0x83, 0x8c, 0x55, 0x39, 0x89, 0xc9, 0x86, 0xef, 0xfb, 0x76, 0x52, 0x0c, 0x30, 0xc3, 0x90,
+	0xbc, 0x01, 0x75, 0x67, 0x14, 0xef, 0xf1, 0x3d, 0xb1, 0x58, 0x2e, 0x5e, 0xe4, 0x5e, 0x64, 0x09,
+	0x7b, 0x78, 0xb4, 0x30, 0x77, 0x0b, 0x5b, 0xaf, 0xa9, 0x67, 0xd4, 0xd4, 0xac, 0x72, 0xca, 0x08,
+	0x24, 0x2b, 0x57, 0x3b, 0x71, 0xe5, 0xb6, 0x52, 0x0c, 0x30, 0xc3, 0x90, 0xbc, 0x07, 0x73, 0xfb,
+	0xf4, 0x30, 0x76, 0x76, 0xa5, 0x80, 0x99, 0x93, 0x08, 0xe0, 0x43, 0xfa, 0x96, 0x51, 0x1c, 0x53,
+	0xcc, 0x48, 0x04, 0xcf, 0xee, 0xd3, 0x70, 0x97, 0x86, 0x81, 0x34, 0x28, 0x49, 0x21, 0xb3, 0x27,
+	0x11, 0x62, 0x1d, 0x1f, 0x2d, 0x3c, 0x7b, 0x2b, 0x87, 0x0d, 0xe6, 0x32, 0xb7, 0x7f, 0xa1, 0x0c,
+	0xd6, 0x4d, 0x11, 0xa7, 0x12, 0x84, 0xb7, 0xe8, 0xe1, 0xaa, 0x1b, 0xc5, 0xa1, 0xbb, 0x3b, 0xe2,
+	0xe3, 0xe8, 0x2d, 0xa8, 0xc6, 0x87, 0x43, 0xd5, 0x87, 0x3e, 0xaf, 0xc6, 0x91, 0xfc, 0x0e, 0x2f,
+	0x4e, 0x2a, 0xc7, 0xbf, 0x0b, 0x2f, 0x49, 0xde, 0x80, 0xb9, 0x8f, 0xdc, 0x61, 0xef, 0xc6, 0x83,
+	0x61, 0xe0, 0x53, 0x3f, 0x96, 0x9d, 0x4b, 0x4f, 0xb5, 0x5f, 0x33, 0x70, 0x98, 0xa2, 0x24, 0xaf,
+	0x42, 0x73, 0x2f, 0x60, 0xaf, 0x66, 0x9a, 0x7d, 0xce, 0xb3, 0x2e, 0xfc, 0x76, 0x02, 0x46, 0x93,
+	0x86, 0x85, 0x02, 0x88, 0xc7, 0x2d, 0x1a, 0x76, 0xa8, 0x1f, 0x3b, 0x7d, 0xd1, 0x85, 0xe6, 0x85,
+	0x51, 0xe6, 0xed, 0x0c, 0x0e, 0xc7, 0xa8, 0xed, 0x5f, 0x29, 0xc1, 0x45, 0xfd, 0x56, 0x1b, 0x4e,
+	0x4c, 0x57, 0x9d, 0xd8, 0x21, 0xd7, 0x01, 0x86, 0x09, 0xc7, 0x12, 0xe7, 0x48, 0xe4, 0x2b, 0x80,
+	0xc1, 0xcf, 0xa0, 0x22, 0x6d, 0xa8, 0x75, 0xa9, 0xe7, 0x1c, 0x4e, 0xb9, 0xc0, 0xea, 0xe1, 0xb7,
+	0xca, 0x98, 0xa0, 0xe0, 0x65, 0xff, 0xdb, 0x1a, 0x9c, 0xd7, 0xd5, 0x93, 0xeb, 0xe1, 0x0b, 0x50,
+	0x09, 0x87, 0x23, 0x5e, 0xab, 0x8a, 0xf0, 0x53, 0xe1, 0xd6, 0x0e, 0x32, 0x18, 0x5b, 0xe7, 0xbb,
+	0x92, 0x61, 0x91, 0x75, 0x5e, 0x3d, 0xa1, 0xe6, 0xc6, 0xf4, 0xb2, 0x41, 0xd4, 0xe7, 0x8a, 0x89,
+	0xf8, 0x30, 0x42, 0xd1, 0x10, 0x20, 0x54, 0x38, 0xb6, 0xab, 0xd9, 0x57, 0x1f, 0xb0, 0x9a, 0xec,
+	0x6a, 0xf4, 0xd7, 0xd3, 0x58, 0xb2, 0xa0, 0x66, 0x36, 0x36, 0x64, 0xab, 0xc2, 0x92, 0x7a, 0x97,
+	0x01, 0xe4, 0x24, 0xc7, 0xd6, 0xb7, 0x0f, 0xdc, 0x38, 0xa6, 0xa1, 0x35, 0x33, 0xd5, 0x9b, 0xf0,
+	0xf5, 0xed, 0x1d, 0xce, 0x01, 0x25, 0x27, 0xf2, 0xff, 0x43, 0x83, 0x33, 0x6f, 0x79, 0xc1, 0x2e,
+	0x1f, 0x65, 0x0d, 0x61, 0x53, 0xbd, 0xab, 0x80, 0x98, 0xe0, 0xd9, 0xbb, 0xc4, 0x6a, 0x5f, 0x53,
+	0x17, 0xeb, 0x0a, 0x7b, 0x17, 0xbd, 0xfd, 0xd0, 0x58, 0xf2, 0xad, 0x12, 0x9c, 0xdf, 0x4f, 0x8f,
+	0x08, 0xe9, 0x13, 0x7a, 0x77, 0xea, 0x75, 0x64, 0xd2, 0x50, 0x13, 0x1b, 0xf3, 0x0c, 0x10, 0xb3,
+	0xe2, 0x49, 0x0c, 0x75, 0x4f, 0xf6, 0x66, 0x0b, 0x0a, 0xee, 0x5d, 0xc6, 0xc6, 0x87, 0x68, 0x08,
+	0xf5, 0x84, 0x5a, 0x12, 0x1b, 0xc2, 0x03, 0xe7, 0xc1, 0x26, 0x8d, 0x22, 0xa7, 0x4f, 0x23, 0x1e,
+	0x47, 0x53, 0x11, 0x43, 0x78, 0x33, 0x01, 0xa3, 0x49, 0x63, 0xff, 0x7e, 0x19, 0x9e, 0xbf, 0x49,
+	0x63, 0xb1, 0x77, 0x5f, 0xa5, 0x43, 0x2f, 0x38, 0x1c, 0xb0, 0xb9, 0x81, 0x7e, 0x48, 0xde, 0x02,
+	0x70, 0xa3, 0xdd, 0xf6, 0x41, 0x67, 0x3b, 0x99, 0x92, 0xd4, 0x4e, 0x02, 0xd6, 0xdb, 0x2d, 0x89,
+	0x79, 0x98, 0x7a, 0x42, 0xa3, 0x4c, 0x62, 0x44, 0x2c, 0x3f, 0xc2, 0x88, 0xd8, 0x06, 0x18, 0x26,
+	0x66, 0x18, 0xb1, 0xb1, 0xf9, 0x83, 0x7a, 0xb0, 0x9f, 0xc0, 0x02, 0x63, 0xb0, 0x29, 0x62, 0x18,
+	0xf1, 0xe1, 0x42, 0x97, 0xf6, 0x9c, 0x91, 0x17, 0x6b, 0xd3, 0x91, 0x55, 0x3b, 0xa1, 0xf5, 0x49,
+	0x87, 0x95, 0xad, 0x66, 0x38, 0xe1, 0x18, 0x6f, 0xfb, 0xef, 0x54, 0xe0, 0xf2, 0x4d, 0x1a, 0x27,
+	0x81, 0x0b, 0x42, 0x47, 0x68, 0x0f, 0x69, 0x87, 0x7d, 0x85, 0x8f, 0x4b, 0x30, 0xe3, 0x39, 0xbb,
+	0xd4, 0x63, 0x0a, 0x1d, 0x7b, 0x9b, 0xf7, 0x0b, 0x74, 0xa4, 0x49, 0x52, 0x16, 0x37, 0xb8, 0x84,
+	0x8c, 0xb6, 0x24, 0x80, 0x28, 0xc5, 0x33, 0x3d, 0xa7, 0xe3, 0x8d, 0xa2, 0x98, 0x86, 0x5b, 0x41,
+	0x18, 0x4b, 0xab, 0x89, 0xd6, 0x73, 0x56, 0x12, 0x14, 0x9a, 0x74, 0x6c, 0x32, 0xef, 0x78, 0x2e,
+	0xf5, 0x63, 0x5e, 0x4a, 0xcc, 0x5e, 0x7a, 0x32, 0x5f, 0xd1, 0x18, 0x34, 0xa8, 0x98, 0xa8, 0x41,
+	0xe0, 0xbb, 0x71, 0x20, 0x44, 0x55, 0xd3, 0xa2, 0x36, 0x13, 0x14, 0x9a, 0x74, 0xbc, 0x18, 0x8d,
+	0x43, 0xb7, 0x13, 0xf1, 0x62, 0xb5, 0x4c, 0xb1, 0x04, 0x85, 0x26, 0x1d, 0x53, 0x03, 0x8d, 0xf7,
+	0x3f, 0x91, 0x1a, 0xf8, 0xd7, 0x1b, 0x70, 0x25, 0xd5, 0xac, 0xb1, 0x13, 0xd3, 0xde, 0xc8, 0x6b,
+	0xd3, 0x58, 0x7d, 0xc0, 0x29, 0xd5, 0xc3, 0x5f, 0x48, 0xbe, 0xbb, 0xd8, 0x4f, 0x75, 0x4e, 0xe7,
+	0xbb, 0x8f, 0x55, 0xf0, 0x89, 0xbe, 0xfd, 0x12, 0x34, 0x7c, 0x27, 0x8e, 0xf8, 0xc0, 0x95, 0x63,
+	0x54, 0xef, 0x4c, 0x6e, 0x2b, 0x04, 0x26, 0x34, 0x64, 0x0b, 0x9e, 0x95, 0x4d, 0xcc, 0x14, 0x8c,
+	0x30, 0xa6, 0xa1, 0x28, 0x2b, 0x35, 0x4c, 0x59, 0xf6, 0xd9, 0xcd, 0x1c, 0x1a, 0xcc, 0x2d, 0x49,
+	0x36, 0xe1, 0x52, 0x47, 0x04, 0xd1, 0x51, 0x2f, 0x70, 0xba, 0x8a, 0xa1, 0x70, 0xe3, 0x68, 0x03,
+	0xe0, 0xca, 0x38, 0x09, 0xe6, 0x95, 0xcb, 0xf6, 0xe6, 0x99, 0xa9, 0x7a, 0xf3, 0xec, 0x34, 0xbd,
+	0xb9, 0x3e, 0x5d, 0x6f, 0x6e, 0x3c, 0x59, 0x6f, 0x66, 0x2d, 0xcf, 0xfa, 0x11, 0x0d, 0x99, 0xc6,
+	0x2e, 0x94, 0x4e, 0x23, 0x46, 0x53, 0xb7, 0x7c, 0x3b, 0x87, 0x06, 0x73, 0x4b, 0x92, 0x5d, 0xb8,
+	0x2c, 0xe0, 0x37, 0xfc, 0x4e, 0x78, 0x38, 0x64, 0x0b, 0x9c, 0xc1, 0xb7, 0x99, 0xf2, 0xa3, 0x5d,
+	0x6e, 0x4f, 0xa4, 0xc4, 0x47, 0x70, 0x21, 0x3f, 0x0a, 0xf3, 0xe2, 0x2b, 0x6d, 0x3a, 0x43, 0xce,
+	0x56, 0x44, 0x6c, 0x3e, 0x27, 0xd9, 0xce, 0xaf, 0x98, 0x48, 0x4c, 0xd3, 0x92, 0x65, 0x38, 0x3f,
+	0x3c, 0xe8, 0xb0, 0xbf, 0xeb, 0xbd, 0xdb, 0x94, 0x76, 0x69, 0x97, 0xc7, 0x24, 0x34, 0x5a, 0x9f,
+	0x51, 0xe6, 0xfc, 0xad, 0x34, 0x1a, 0xb3, 0xf4, 0x4c, 0x6f, 0x8e, 0x62, 0x27, 0x8c, 0xa5, 0xf3,
+	0xca, 0x3a, 0x97, 0xd6, 0x9b, 0xdb, 0x06, 0x0e, 0x53, 0x94, 0xb9, 0xeb, 0xc5, 0xf9, 0xb3, 0x5b,
+	0x2f, 0x8a, 0xcc, 0x56, 0x0f, 0xc5, 0x62, 0xcf, 0x3d, 0xe6, 0x99, 0x65, 0xe6, 0xe7, 0xb3, 0xcb,
+	0xcc, 0x7b, 0x45, 0xa6, 0x9b, 0x1c, 0x09, 0x4f, 0x34, 0xcd, 0xbc, 0x03, 0x24, 0x94, 0xfe, 0x7d,
+	0x61, 0x55, 0x36, 0x56, 0x1a, 0x1d, 0xa7, 0x8c, 0x63, 0x14, 0x98, 0x53, 0x8a, 0xb4, 0xe1, 0xb9,
+	0x88, 0xfa, 0xb1, 0xeb, 0x53, 0x2f, 0xcd, 0x4e, 0x2c, 0x41, 0x2f, 0x49, 0x76, 0xcf, 0xb5, 0xf3,
+	0x88, 0x30, 0xbf, 0x6c, 0x91, 0xc6, 0xff, 0xa7, 0xc0, 0xd7, 0x79, 0xd1, 0x34, 0xa7, 0xb6, 0x4c,
+	0x7c, 0x9c, 0x5d, 0x26, 0xde, 0x2f, 0xfe, 0xdd, 0xa6, 0x5b, 0x22, 0xae, 0x03, 0xf0, 0xaf, 0x60,
+	0xae, 0x11, 0x7a, 0x66, 0x44, 0x8d, 0x41, 0x83, 0x8a, 0x8d, 0x7a, 0xd5, 0xce, 0xe6, 0xf2, 0xa0,
+	0x47, 0x7d, 0xdb, 0x44, 0x62, 0x9a, 0x76, 0xe2, 0x12, 0x53, 0x9b, 0x7a, 0x89, 0x79, 0x07, 0x48,
+	0xca, 0xa7, 0x21, 0xf8, 0xcd, 0xa4, 0xc3, 0xe4, 0xd7, 0xc7, 0x28, 0x30, 0xa7, 0xd4, 0x84, 0xae,
+	0x3c, 0x7b, 0xba, 0x5d, 0xb9, 0x3e, 0x7d, 0x57, 0x26, 0xef, 0xc3, 0x0b, 0x5c, 0x94, 0x6c, 0x9f,
+	0x34, 0x63, 0xb1, 0xd8, 0xfc, 0x80, 0x64, 0xfc, 0x02, 0x4e, 0x22, 0xc4, 0xc9, 0x3c, 0xd8, 0xf7,
+	0xe9, 0x84, 0xb4, 0xcb, 0x84, 0x3b, 0xde, 0xe4, 0x85, 0x68, 0x25, 0x87, 0x06, 0x73, 0x4b, 0xb2,
+	0x2e, 0x16, 0xb3, 0x6e, 0xe8, 0xec, 0x7a, 0xb4, 0x2b, 0x8f, 0x09, 0xe8, 0x2e, 0xb6, 0xbd, 0xd1,
+	0x96, 0x18, 0x34, 0xa8, 0xf2, 0xd6, 0x86, 0xb9, 0x13, 0xae, 0x0d, 0x37, 0xb9, 0x03, 0xb0, 0x97,
+	0x5a, 0x82, 0xac, 0xf9, 0xf4, 0xc1, 0x8f, 0x95, 0x2c, 0x01, 0x8e, 0x97, 0xe1, 0x4b, 0x73, 0x27,
+	0x74, 0x87, 0x71, 0x94, 0xe6, 0x75, 0x2e, 0xb3, 0x34, 0xe7, 0xd0, 0x60, 0x6e, 0x49, 0xa6, 0x14,
+	0xed, 0x51, 0xc7, 0x8b, 0xf7, 0xd2, 0x0c, 0xcf, 0xa7, 0x95, 0xa2, 0xb7, 0xc7, 0x49, 0x30, 0xaf,
+	0x5c, 0xee, 0x5a, 0x76, 0xe1, 0xe9, 0x5c, 0xcb, 0x7e, 0xae, 0x02, 0x2f, 0xdc, 0xa4, 0xb1, 0x0e,
+	0xd9, 0xfb, 0xfe, 0xde, 0xf5, 0x7b, 0xb0, 0x77, 0xfd, 0x27, 0x15, 0xb8, 0x74, 0x93, 0xca, 0x18,
+	0x77, 0x76, 0x90, 0x4b, 0x2e, 0x66, 0xff, 0x8f, 0x36, 0xff, 0x26, 0x5c, 0x4a, 0xa2, 0x44, 0xdb,
+	0x71, 0x10, 0x8a, 0xb5, 0x3c, 0xb3, 0x45, 0x69, 0x8f, 0x93, 0x60, 0x5e, 0xb9, 0xdc, 0xaf, 0x39,
+	0x73, 0x86, 0x5f, 0xf3, 0xbf, 0x94, 0x61, 0xf6, 0x66, 0x18, 0x8c, 0x86, 0xad, 0x43, 0xd2, 0xd7,
+	0x8e, 0xc5, 0x52, 0xc1, 0xd3, 0x08, 0xc2, 0x5f, 0x96, 0xa8, 0x0d, 0x69, 0x6f, 0x23, 0xfb, 0xd0,
+	0xfb, 0xf4, 0x90, 0x76, 0xa5, 0xbf, 0x4b, 0x7f, 0xe8, 0x5b, 0x0c, 0x88, 0x02, 0x47, 0x06, 0x70,
+	0xde, 0x61, 0xbe, 0x3e, 0xda, 0x65, 0x56, 0x2f, 0x9f, 0x46, 0xd1, 0x94, 0xfe, 0x4e, 0x6e, 0xbd,
+	0x5b, 0x4e, 0xb3, 0xc2, 0x2c, 0x6f, 0xf2, 0x01, 0xcc, 0x46, 0x71, 0x10, 0x2a, 0x85, 0xa4, 0x79,
+	0x7d, 0x65, 0xea, 0xb7, 0xdf, 0x6a, 0xbd, 0xdb, 0x16, 0xac, 0x84, 0xc9, 0x56, 0x3e, 0xa0, 0x12,
+	0x60, 0xff, 0xb1, 0x1a, 0xd4, 0xd5, 0xe1, 0x1e, 0xf2, 0x12, 0x54, 0x46, 0xa1, 0x27, 0x07, 0x8c,
+	0xee, 0x5f, 0x3b, 0xb8, 0x81, 0x0c, 0xce, 0xfc, 0xff, 0x03, 0x1a, 0xef, 0x05, 0x5d, 0x39, 0x2a,
+	0x74, 0x9b, 0x6e, 0x72, 0x28, 0x4a, 0x2c, 0x39, 0x84, 0xd9, 0x3d, 0xca, 0xb6, 0xba, 0xca, 0x9f,
+	0x76, 0xbb, 0xf0, 0xb9, 0xa3, 0xc5, 0xb7, 0x05, 0x43, 0xa1, 0x03, 0xea, 0x40, 0x20, 0x09, 0x45,
+	0x25, 0x8f, 0xf4, 0xa1, 0xb6, 0xeb, 0xc4, 0x9d, 0x3d, 0xab, 0x5a, 0xd0, 0xfb, 0xa8, 0x04, 0xb7,
+	0x18, 0x37, 0x61, 0x9f, 0xe6, 0x7f, 0x51, 0xf0, 0x27, 0x1d, 0xa8, 0x32, 0x47, 0x94, 0x55, 0x2b,
+	0x18, 0x27, 0xa9, 0xe4, 0xb0, 0x3d, 0xaf, 0xf4, 0x4b, 0x8e, 0x98, 0x67, 0x9b, 0x31, 0x67, 0x27,
+	0x5a, 0x62, 0x4f, 0x0d, 0xba, 0xe9, 0x4f, 0xb4, 0x6c, 0x6f, 0xb4, 0x85, 0xa7, 0x60, 0x7b, 0xa3,
+	0x8d, 0x8c, 0x23, 0x0b, 0x34, 0x88, 0xdd, 0x01, 0x0d, 0x46, 0xca, 0xdb, 0x74, 0xd2, 0x8e, 0xcc,
+	0x3b, 0xd3, 0xb6, 0x60, 0x81, 0x8a, 0xd7, 0xe5, 0x2f, 0xc3, 0x9c, 0xf9, 0x9d, 0x4e, 0xb4, 0xa0,
+	0xfe, 0xdd, 0x12, 0xcc, 0x99, 0x8d, 0xc1, 0x4e, 0x3d, 0xec, 0x3a, 0x91, 0xdb, 0xb1, 0x4a, 0x45,
+	0x0f, 0xe3, 0xa9, 0x00, 0x76, 0xf5, 0x19, 0x23, 0xb7, 0x83, 0x82, 0x77, 0x12, 0x28, 0x5f, 0x2e,
+	0x16, 0x28, 0xff, 0x45, 0x98, 0x4f, 0xf5, 0x18, 0xee, 0x31, 0x31, 0x42, 0x39, 0xe6, 0xf3, 0x43,
+	0x33, 0xec, 0xbf, 0x55, 0x06, 0xe0, 0x05, 0x85, 0x73, 0xa7, 0x2b, 0x7b, 0x55, 0x51, 0xdf, 0x79,
+	0xea, 0x34, 0xc0, 0x58, 0xb7, 0xfa, 0x21, 0x98, 0x95, 0x7b, 0x38, 0x39, 0xeb, 0xe9, 0xf1, 0x24,
+	0xf7, 0x79, 0xa8, 0xf0, 0x7c, 0x5b, 0x78, 0xe8, 0x77, 0xf6, 0xc2, 0xc0, 0x0f, 0x46, 0x91, 0x8c,
+	0x82, 0x48, 0xb6, 0x85, 0x09, 0x0a, 0x4d, 0x3a, 0xe2, 0x88, 0x62, 0xb2, 0x83, 0x4c, 0x19, 0x12,
+	0x71, 0x5e, 0x89, 0x50, 0xfd, 0xcc, 0xe4, 0x69, 0xff, 0x8d, 0x32, 0xc0, 0x7a, 0x57, 0x87, 0x89,
+	0xbc, 0x07, 0x8d, 0x78, 0x2f, 0xa4, 0xd1, 0x5e, 0xe0, 0x75, 0xa7, 0x0c, 0x89, 0xe0, 0xbe, 0xa0,
+	0x6d, 0xc5, 0x04, 0x13, 0x7e, 0x2c, 0xc4, 0x23, 0x8a, 0xe9, 0x70, 0xbd, 0x58, 0x10, 0xcd, 0x05,
+	0x61, 0xc9, 0x49, 0xf8, 0x60, 0x8a, 0x2b, 0x6b, 0x34, 0xd7, 0xef, 0x88, 0x95, 0xb3, 0x75, 0x68,
+	0x55, 0xa6, 0x6f, 0xb4, 0xf5, 0x84, 0x0d, 0x9a, 0x3c, 0xed, 0xdf, 0x2d, 0xc3, 0xf3, 0x5c, 0x1e,
+	0xab, 0x46, 0xea, 0x9c, 0x04, 0xf9, 0xc9, 0xb1, 0xa4, 0x04, 0x7f, 0xe0, 0xc9, 0x44, 0x8b, 0x33,
+	0xed, 0x2c, 0xf3, 0x40, 0xb2, 0x19, 0x4a, 0x60, 0x46, 0x26, 0x82, 0x11, 0x54, 0xa3, 0x21, 0xed,
+	0xc8, 0xd6, 0x6b, 0x4f, 0xdd, 0xb9, 0xf3, 0x5f, 0x80, 0xe9, 0x7e, 0x49, 0xe8, 0x07, 0x7b, 0x42,
+	0x2e, 0x8e, 0xfc, 0x34, 0xcc, 0x44, 0xb1, 0x13, 0x8f, 0xd4, 0x9a, 0xbd, 0x73, 0xda, 0x82, 0x39,
+	0xf3, 0x64, 0x31, 0x14, 0xcf, 0x28, 0x85, 0xda, 0xbf, 0x5b, 0x82, 0xcb, 0xf9, 0x05, 0x37, 0xdc,
+	0x28, 0x26, 0x7f, 0x78, 0xac, 0xd9, 0x9f, 0xf0, 0x8b, 0xb3, 0xd2, 0xbc, 0xd1, 0xf5, 0x41, 0x29,
+	0x05, 0x31, 0x9a, 0x3c, 0x86, 0x9a, 0x1b, 0xd3, 0x81, 0x32, 0xce, 0xdc, 0x39, 0xe5, 0x57, 0x37,
+	0xf4, 0x62, 0x26, 0x05, 0x85, 0x30, 0xfb, 0x9b, 0xe5, 0x49, 0xaf, 0xcc, 0x3e, 0x0b, 0xf1, 0xd2,
+	0x67, 0x71, 0x6e, 0x15, 0x3b, 0x8b, 0x93, 0xae, 0xd0, 0xf8, 0x91, 0x9c, 0x9f, 0x1a, 0x3f, 0x92,
+	0x73, 0xa7, 0xf8, 0x91, 0x9c, 0x4c, 0x33, 0x4c, 0x3c, 0x99, 0xf3, 0x27, 0x2b, 0xf0, 0xe2, 0xa3,
+	0xba, 0x0d, 0x53, 0x74, 0x65, 0xef, 0x2c, 0xaa, 0xe8, 0x3e, 0xba, 0x1f, 0x92, 0xeb, 0x50, 0x1b,
+	0xee, 0x39, 0x91, 0xda, 0xd1, 0xa8, 0xdd, 0x7e, 0x6d, 0x8b, 0x01, 0x1f, 0xb2, 0x49, 0x83, 0xef,
+	0x84, 0xf8, 0x23, 0x0a, 0x52, 0xb6, 0x50, 0x0c, 0x84, 0xa7, 0x56, 0xee, 0x6e, 0xf4, 0x42, 0x21,
+	0x1d, 0xb8, 0xa8, 0xf0, 0x24, 0x86, 0x19, 0x61, 0x14, 0xb7, 0xaa, 0x05, 0x03, 0xac, 0x73, 0x8e,
+	0x6f, 0x25, 0x2f, 0x25, 0x9e, 0x51, 0xca, 0x22, 0x8b, 0x32, 0x60, 0xa5, 0x96, 0xb2, 0x91, 0x55,
+	0x73, 0x36, 0x77, 0x9c, 0xce, 0xfe, 0x17, 0x75, 0x78, 0x3e, 0xff, 0x1b, 0xb2, 0x77, 0x3d, 0xa0,
+	0x21, 0x8f, 0x27, 0x2d, 0xa5, 0xdf, 0xf5, 0xae, 0x00, 0xa3, 0xc2, 0x7f, 0xaa, 0x83, 0xb7, 0xff,
+	0x6a, 0x89, 0xd9, 0xdd, 0x84, 0x27, 0xea, 0x93, 0x08, 0xe0, 0x7e, 0x49, 0xd8, 0xef, 0x26, 0x08,
+	0xc4, 0xc9, 0x75, 0x21, 0x7f, 0xb9, 0x04, 0xd6, 0x20, 0x63, 0xd8, 0x3b, 0xc3, 0xc3, 0xd7, 0xfc,
+	0x84, 0xd9, 0xe6, 0x04, 0x79, 0x38, 0xb1, 0x26, 0xe4, 0x67, 0xa0, 0x39, 0x64, 0xfd, 0x22, 0x8a,
+	0xa9, 0xdf, 0x51, 0xe7, 0xaf, 0xa7, 0xef, 0xfd, 0x5b, 0x09, 0x2f, 0x15, 0xd6, 0x2d, 0xd6, 0x74,
+	0x03, 0x81, 0xa6, 0xc4, 0xa7, 0xfc, 0xb4, 0xf5, 0x35, 0xa8, 0x47, 0x34, 0x66, 0x51, 0xea, 0x91,
+	0x19, 0x46, 0xd3, 0x96, 0x30, 0xd4, 0x58, 0x16, 0x9d, 0xc3, 0x1d, 0x5b, 0x2c, 0x44, 0xd2, 0x6a,
+	0xf0, 0x38, 0xcd, 0x79, 0x11, 0x79, 0x2a, 0x81, 0x98, 0xe0, 0xc9, 0x17, 0x60, 0x6e, 0x97, 0x0f,
+	0x5f, 0x99, 0x0f, 0x45, 0x18, 0x75, 0xb9, 0x86, 0xd5, 0x32, 0xe0, 0x98, 0xa2, 0x62, 0x06, 0x5c,
+	0xaa, 0xbd, 0x7f, 0x59, 0x03, 0x6e, 0xe2, 0x17, 0x44, 0x83, 0x8a, 0xbc, 0x24, 0xf6, 0x60, 0x73,
+	0x9c, 0x58, 0xef, 0x89, 0xd5, 0x4e, 0xca, 0xfe, 0xfd, 0x12, 0x9c, 0xcf, 0x1c, 0xd4, 0x7c, 0xdc,
+	0x36, 0xfa, 0x7d, 0xa9, 0xe4, 0x97, 0x4f, 0x21, 0xa3, 0x45, 0xee, 0xb6, 0x91, 0x3b, 0x13, 0x93,
+	0xfa, 0x58, 0x95, 0xac, 0x33, 0x31, 0xc1, 0x61, 0x8a, 0x32, 0x63, 0xe1, 0xae, 0x3e, 0x89, 0x85,
+	0xdb, 0xfe, 0xe5, 0x0a, 0xcc, 0xa7, 0xd2, 0x5e, 0x3c, 0xee, 0xfd, 0xd9, 0xf6, 0x63, 0xc4, 0x15,
+	0x44, 0xab, 0x9c, 0x9e, 0x69, 0xdb, 0x02, 0x8c, 0x0a, 0xcf, 0x2c, 0x0e, 0x72, 0xe5, 0xce, 0x9c,
+	0x38, 0x90, 0x2b, 0xad, 0xc4, 0xaa, 0x8d, 0x72, 0xf5, 0xd4, 0x37, 0xca, 0xef, 0xa7, 0xb6, 0xf9,
+	0x67, 0xf0, 0xad, 0xbe, 0x0e, 0xe0, 0x74, 0xf6, 0xd5, 0x46, 0x69, 0xba, 0x58, 0x37, 0x7e, 0x96,
+	0x64, 0x59, 0x73, 0x41, 0x83, 0xa3, 0xfd, 0x1b, 0x35, 0xa3, 0x7f, 0xca, 0xbd, 0xd2, 0xe3, 0xcd,
+	0x3c, 0x86, 0xba, 0xf4, 0xd8, 0x46, 0xaf, 0x9c, 0x59, 0xa3, 0x57, 0xcf, 0xaa, 0xd1, 0x77, 0x60,
+	0xbe, 0x4b, 0x3d, 0xf7, 0x80, 0x86, 0xc2, 0xe8, 0x2a, 0xf5, 0x87, 0x25, 0xe5, 0xf7, 0x5b, 0x35,
+	0x91, 0x0f, 0x8f, 0x16, 0x12, 0x9d, 0x21, 0x85, 0xc1, 0x34, 0x17, 0x72, 0x4f, 0xce, 0x60, 0xac,
+	0xed, 0xe5, 0xa7, 0xfc, 0xe1, 0x27, 0xfb, 0x94, 0xac, 0x84, 0x31, 0xdb, 0xb1, 0x47, 0x4c, 0x78,
+	0x71, 0x3f, 0x25, 0x7b, 0x68, 0xd3, 0x0f, 0x47, 0x7c, 0x95, 0x99, 0xe5, 0x51, 0x93, 0x89, 0x9f,
+	0xd2, 0x44, 0x62, 0x9a, 0x96, 0x7c, 0x19, 0xce, 0xf5, 0x5c, 0x8f, 0xa9, 0xa0, 0x62, 0x50, 0x89,
+	0xb4, 0x17, 0x0d, 0x11, 0xff, 0xbc, 0x96, 0xc2, 0x60, 0x86, 0x92, 0x0d, 0x55, 0x16, 0x03, 0xba,
+	0xeb, 0xa9, 0x6c, 0x5d, 0x7a, 0xa8, 0xae, 0x0a, 0x30, 0x2a, 0x3c, 0x33, 0x29, 0x39, 0x9d, 0xfd,
+	0x7b, 0x8e, 0x1b, 0x5b, 0x30, 0x55, 0x2f, 0xe6, 0x06, 0x92, 0x65, 0xc1, 0x02, 0x15, 0x2f, 0xfb,
+	0x1f, 0x57, 0xa0, 0xf9, 0x4e, 0xb0, 0xfb, 0x29, 0x39, 0xd6, 0x97, 0xaf, 0xef, 0x95, 0xbf, 0x87,
+	0xfa, 0xde, 0x0e, 0x7c, 0x26, 0x8e, 0x99, 0x13, 0x33, 0xf0, 0xbb, 0xd1, 0x72, 0x2f, 0xa6, 0xe1,
+	0x9a, 0xeb, 0xbb, 0xd1, 0x1e, 0xed, 0xca, 0x40, 0x84, 0xcf, 0x1e, 0x1f, 0x2d, 0x7c, 0x66, 0x7b,
+	0x7b, 0x23, 0x8f, 0x04, 0x27, 0x95, 0xe5, 0xeb, 0xaf, 0xd3, 0xd9, 0x0f, 0x7a, 0x3d, 0x7e, 0x7c,
+	0x5b, 0x86, 0xc8, 0x89, 0xf5, 0xd7, 0x80, 0x63, 0x8a, 0xca, 0xfe, 0x00, 0x9a, 0x22, 0xe7, 0x16,
+	0x65, 0xd6, 0x77, 0x6e, 0xb3, 0x71, 0x07, 0x34, 0x8a, 0x9d, 0xc1, 0xd0, 0x2a, 0x9d, 0x78, 0xbc,
+	0xe8, 0x08, 0xb0, 0x6d, 0xc5, 0x04, 0x13, 0x7e, 0xf6, 0xaf, 0xce, 0x40, 0x43, 0xe7, 0xfb, 0x62,
+	0xe6, 0xb8, 0xdd, 0x30, 0xd8, 0xa7, 0xa1, 0x88, 0x2f, 0x91, 0x07, 0xcb, 0x5a, 0x02, 0x84, 0x0a,
+	0xc7, 0xbc, 0x01, 0x71, 0x30, 0x74, 0x3b, 0x59, 0xb7, 0xcf, 0x36, 0x03, 0xa2, 0xc0, 0x9d, 0xdd,
+	0xbc, 0xf7, 0x4a, 0x6a, 0x0f, 0xd5, 0x98, 0xb8, 0xeb, 0x61, 0x59, 0x94, 0x9c, 0xc8, 0xb3, 0x6a,
+	0x05, 0xb3, 0x3b, 0xb4, 0x97, 0xdb, 0x1b, 0x32, 0x8b, 0xd2, 0x72, 0x7b, 0x03, 0x39, 0x53, 0xf2,
+	0x93, 0xc2, 0x66, 0x3b, 0x53, 0xd0, 0xae, 0xad, 0x9b, 0xfe, 0x16, 0x3d, 0x14, 0xaf, 0x79, 0x8b,
+	0x1e, 0x0a, 0x1b, 0xf0, 0x57, 0xe0, 0x5c, 0x4f, 0x9c, 0x1a, 0x92, 0xc6, 0x62, 0x3e, 0x9d, 0xd5,
+	0x93, 0x54, 0x32, 0x6b, 0x29, 0x2c, 0x66, 0xa8, 0xc9, 0x3a, 0x34, 0x75, 0x6e, 0x17, 0x1a, 0x4a,
+	0xad, 0xf2, 0x07, 0x65, 0xe1, 0xe6, 0x56, 0x82, 0x7a, 0x78, 0xb4, 0x70, 0x81, 0xd7, 0xc3, 0x80,
+	0xa1, 0x59, 0x96, 0x79, 0xe7, 0xf9, 0x37, 0xbd, 0xf1, 0x40, 0x1f, 0x26, 0x6c, 0xa4, 0xbd, 0xf3,
+	0xdb, 0x69, 0x34, 0x66, 0xe9, 0xc9, 0xeb, 0x30, 0x2f, 0xfd, 0x37, 0x9c, 0x34, 0xb2, 0x80, 0xf7,
+	0xaf, 0x8b, 0x6c, 0x5e, 0x5e, 0x36, 0x11, 0x98, 0xa6, 0x23, 0xdf, 0x28, 0x41, 0x33, 0x0e, 0x1d,
+	0x3f, 0x72, 0x3a, 0x5a, 0x1d, 0x2d, 0x72, 0xf4, 0x48, 0xb7, 0xf8, 0x76, 0xc2, 0x54, 0x6c, 0x1d,
+	0x0c, 0x00, 0x9a, 0x22, 0xed, 0x00, 0xe6, 0xcc, 0xef, 0xc4, 0xf5, 0xe3, 0xa4, 0x25, 0x4a, 0xe9,
+	0x18, 0x1a, 0xa3, 0x11, 0x0c, 0x2a, 0xae, 0xb6, 0xd3, 0xa1, 0xc3, 0x23, 0xc4, 0xd5, 0xb0, 0xe1,
+	0x0b, 0x99, 0x02, 0x62, 0x82, 0xb7, 0xff, 0x67, 0x09, 0x9e, 0xcd, 0xab, 0x27, 0xfb, 0x10, 0x9d,
+	0x3d, 0xda, 0xd9, 0x1f, 0x06, 0x2e, 0x4b, 0xa0, 0x38, 0x94, 0x66, 0x7f, 0xe3, 0x43, 0xac, 0xa4,
+	0xd1, 0x98, 0xa5, 0x67, 0x51, 0x2a, 0xc6, 0xbb, 0x39, 0xde, 0xfa, 0xaa, 0x38, 0x96, 0x2a, 0x2b,
+	0xa5, 0xa3, 0x54, 0xb6, 0xf3, 0x88, 0x30, 0xbf, 0x2c, 0x59, 0x87, 0x4b, 0x5d, 0xda, 0x1d, 0xf1,
+	0xed, 0x3c, 0x43, 0xdd, 0x4b, 0x4e, 0x3b, 0xce, 0xb7, 0x3e, 0xc3, 0xd6, 0x86, 0xd5, 0x71, 0x34,
+	0xe6, 0x95, 0xb1, 0xff, 0xe1, 0x8c, 0x9c, 0xfd, 0xa4, 0x16, 0x76, 0x9a, 0x53, 0xd2, 0x9b, 0x3c,
+	0x7c, 0x31, 0x1a, 0x0d, 0x68, 0xc8, 0x3d, 0xa8, 0x56, 0x65, 0x2c, 0x3c, 0x24, 0x41, 0xea, 0x10,
+	0xc6, 0x04, 0x74, 0x76, 0x0a, 0x74, 0x32, 0xa7, 0xd5, 0x9e, 0x68, 0x4e, 0x9b, 0x39, 0x8b, 0x39,
+	0xed, 0x8f, 0x96, 0xa4, 0x02, 0xb5, 0x15, 0x44, 0xae, 0x71, 0xce, 0xf7, 0x56, 0xc1, 0xc1, 0x66,
+	0xb2, 0x14, 0x23, 0x3e, 0x05, 0xc2, 0xb4, 0x50, 0xb2, 0x07, 0x33, 0x21, 0x5f, 0xf9, 0xac, 0x7a,
+	0xc1, 0x2c, 0x44, 0xc6, 0x2a, 0x2a, 0x4e, 0xba, 0x88, 0xff, 0x28, 0xf9, 0xb3, 0x73, 0xab, 0xb1,
+	0x98, 0x8d, 0xc4, 0x46, 0x9a, 0xd3, 0xc8, 0x69, 0x48, 0x62, 0xd8, 0x2e, 0x91, 0xff, 0xdb, 0x72,
+	0xe2, 0x98, 0x86, 0xbe, 0x4a, 0xa2, 0x9a, 0x24, 0xa4, 0x49, 0x70, 0x98, 0xa2, 0x24, 0x3f, 0x05,
+	0xcf, 0xf2, 0x67, 0xa4, 0x3d, 0xe6, 0x21, 0xd1, 0x6e, 0x91, 0xe6, 0x54, 0x7a, 0x1f, 0x3f, 0xc1,
+	0xb6, 0x9d, 0xc3, 0x0f, 0x73, 0xa5, 0xd8, 0xbf, 0x5d, 0x06, 0x32, 0xde, 0xfc, 0xe4, 0xcb, 0xa9,
+	0xb3, 0x6b, 0xaf, 0x64, 0x4c, 0x81, 0xcf, 0x8f, 0x97, 0x30, 0x4e, 0xad, 0xdd, 0x33, 0x15, 0x91,
+	0xf2, 0x74, 0x8a, 0x7b, 0x9e, 0x12, 0xc2, 0xc2, 0x5a, 0x67, 0x83, 0x5e, 0x2f, 0xa2, 0xb1, 0x72,
+	0x85, 0x7f, 0xf5, 0x14, 0xbb, 0xdc, 0xe2, 0x1d, 0xc1, 0x3a, 0xe3, 0x14, 0x97, 0x50, 0x54, 0x92,
+	0x99, 0x5b, 0xd6, 0xa4, 0x7c, 0x9c, 0x5b, 0xb6, 0x62, 0xba, 0x65, 0x3f, 0x2e, 0x43, 0x63, 0xc3,
+	0xed, 0xd1, 0xce, 0x61, 0xc7, 0xe3, 0x89, 0x9a, 0xba, 0xd4, 0xa3, 0x31, 0xbd, 0x19, 0x3a, 0x1d,
+	0xba, 0x45, 0x43, 0x37, 0xe8, 0x4a, 0xfd, 0x90, 0xb3, 0x93, 0x89, 0x9a, 0x56, 0x27, 0xd0, 0xe0,
+	0xc4, 0xd2, 0x64, 0x1d, 0xe6, 0xba, 0x34, 0x72, 0x43, 0xda, 0xdd, 0x32, 0xac, 0xd4, 0x9f, 0x53,
+	0xbd, 0x71, 0xd5, 0xc0, 0x3d, 0x3c, 0x5a, 0x98, 0xdf, 0x72, 0x87, 0xd4, 0x73, 0x7d, 0xca, 0x01,
+	0x98, 0x2a, 0xca, 0x54, 0xde, 0xa1, 0x33, 0x8a, 0xf2, 0xea, 0x68, 0xa8, 0xbc, 0x5b, 0xf9, 0x24,
+	0x38, 0xa9, 0xac, 0x5d, 0x03, 0x96, 0x91, 0xd6, 0xfe, 0x66, 0x05, 0x74, 0x9a, 0x6d, 0xf2, 0xc7,
+	0x4b, 0xd0, 0x74, 0x7c, 0x5f, 0x9e, 0xdf, 0x57, 0xb1, 0xcb, 0x58, 0x38, 0x9b, 0xf7, 0xe2, 0x72,
+	0xc2, 0x54, 0x7c, 0x5d, 0xed, 0x73, 0x35, 0x30, 0x68, 0xca, 0x66, 0x87, 0x98, 0x53, 0x91, 0xb8,
+	0x9b, 0xc5, 0x6b, 0xf1, 0x04, 0x71, 0xb7, 0x97, 0xbf, 0x02, 0x17, 0xb2, 0x95, 0x3d, 0x89, 0xdf,
+	0xbf, 0x48, 0x0c, 0xde, 0xcf, 0x37, 0xa0, 0x79, 0xdb, 0x89, 0xdd, 0x03, 0xca, 0x3d, 0x3e, 0x67,
+	0x63, 0xc2, 0xff, 0x0b, 0x25, 0x78, 0x3e, 0x1d, 0x13, 0x7b, 0x86, 0x76, 0x7c, 0x9e, 0xbc, 0x0b,
+	0x73, 0xa5, 0xe1, 0x84, 0x5a, 0x70, 0x8b, 0xfe, 0x58, 0x88, 0xed, 0x59, 0x5b, 0xf4, 0xdb, 0x93,
+	0x04, 0xe2, 0xe4, 0xba, 0x7c, 0x5a, 0x2c, 0xfa, 0x4f, 0x77, 0x72, 0xd5, 0x8c, 0xbf, 0x61, 0xf6,
+	0xa9, 0xf1, 0x37, 0xd4, 0x9f, 0x0a, 0x0b, 0xcc, 0xd0, 0xf0, 0x37, 0x34, 0x0a, 0x46, 0xd1, 0xc8,
+	0x63, 0x24, 0x82, 0xdb, 0x24, 0xbf, 0x05, 0xcf, 0x44, 0xa1, 0x2c, 0x8d, 0x9f, 0xaa, 0xa0, 0x25,
+	0x96, 0xcf, 0xd3, 0x67, 0x93, 0x6d, 0xe5, 0xc4, 0xf9, 0x3c, 0x6f, 0xb3, 0xcd, 0x3c, 0x2f, 0x6c,
+	0xff, 0xe9, 0xb2, 0x78, 0xfd, 0x53, 0xb6, 0xfc, 0x7f, 0x6a, 0x8d, 0xcb, 0xf6, 0xaf, 0x97, 0x01,
+	0x78, 0x83, 0x3c, 0x91, 0xb1, 0xfd, 0x04, 0x4d, 0xf2, 0x32, 0xd4, 0x3e, 0x1c, 0xd1, 0x91, 0xf2,
+	0xc5, 0xeb, 0x9d, 0xe0, 0xbb, 0x0c, 0x88, 0x02, 0xf7, 0xe9, 0xf5, 0x84, 0xd8, 0x0d, 0x98, 0xbd,
+	0x1d, 0xf0, 0xe8, 0x63, 0xfb, 0x3f, 0x95, 0x01, 0x92, 0xc8, 0x55, 0xf2, 0xe7, 0x4b, 0xf0, 0x9c,
+	0x9e, 0x81, 0x62, 0x91, 0xae, 0x67, 0xc5, 0x73, 0xdc, 0x41, 0x61, 0xab, 0x6f, 0xde, 0xec, 0xc7,
+	0xa7, 0xe4, 0xad, 0x3c, 0x71, 0x98, 0x5f, 0x0b, 0x82, 0x50, 0xa7, 0x83, 0x61, 0x7c, 0xb8, 0xea,
+	0x86, 0x56, 0x79, 0x72, 0x80, 0xf4, 0x0d, 0x49, 0x23, 0x8a, 0xca, 0x9c, 0x76, 0x7c, 0x56, 0x51,
+	0x18, 0xd4, 0x7c, 0xc8, 0x1e, 0xd4, 0xfd, 0xe0, 0xfd, 0x88, 0x35, 0x87, 0x55, 0x29, 0x98, 0x25,
+	0x5e, 0x36, 0xab, 0x30, 0x30, 0xc8, 0x07, 0x9c, 0xf5, 0x65, 0x63, 0xff, 0x52, 0x19, 0x2e, 0xe5,
+	0xb4, 0x03, 0xcb, 0xae, 0x21, 0x83, 0x84, 0x93, 0x8b, 0x36, 0x4a, 0xc9, 0x45, 0x1b, 0xed, 0x0c,
+	0x0e, 0xc7, 0xa8, 0xc9, 0xfb, 0xcc, 0xb7, 0xd5, 0xa1, 0x51, 0xb4, 0x19, 0x74, 0x95, 0x46, 0xff,
+	0xa6, 0xf0, 0x55, 0x29, 0xe8, 0xc3, 0xa3, 0x85, 0x1f, 0xc9, 0x8b, 0x8d, 0xcf, 0xb4, 0x73, 0x52,
+	0x00, 0x0d, 0x96, 0xcc, 0x79, 0x26, 0xd2, 0x35, 0xe9, 0xcc, 0x14, 0x27, 0x4f, 0x99, 0xc5, 0x9d,
+	0x67, 0x77, 0x35, 0x17, 0x34, 0x38, 0xda, 0xbf, 0x59, 0x86, 0xba, 0xda, 0x69, 0x7c, 0x02, 0x01,
+	0x72, 0xfd, 0x54, 0x80, 0xdc, 0xf4, 0xb6, 0x57, 0x55, 0xe5, 0x89, 0x21, 0x71, 0x41, 0x26, 0x24,
+	0xee, 0x66, 0x71, 0x51, 0x8f, 0x0e, 0x82, 0xfb, 0xb5, 0x32, 0x9c, 0x53, 0xa4, 0x32, 0x1f, 0xee,
+	0xeb, 0x30, 0x1f, 0x52, 0xa7, 0xcb, 0xa3, 0x65, 0x75, 0x98, 0x6c, 0x55, 0x58, 0x51, 0xd0, 0x44,
+	0x60, 0x9a, 0x8e, 0xfc, 0x18, 0x9c, 0x17, 0x4e, 0xfd, 0x4d, 0xe7, 0x81, 0x48, 0x60, 0xc5, 0x1b,
+	0xac, 0x2a, 0x82, 0xeb, 0x5b, 0x69, 0x14, 0x66, 0x69, 0x59, 0xb7, 0x16, 0xa0, 0x9d, 0xc8, 0xe9,
+	0x8b, 0xca, 0x48, 0x73, 0x1e, 0xef, 0xd6, 0xad, 0x0c, 0x0e, 0xc7, 0xa8, 0x59, 0x9c, 0x26, 0xab,
+	0xd1, 0x29, 0x04, 0xb7, 0x62, 0xc2, 0x06, 0x4d, 0x9e, 0xf6, 0xbf, 0x2c, 0xc1, 0x5c, 0xd2, 0x5e,
+	0x67, 0x1e, 0x26, 0xd8, 0x4b, 0x87, 0x09, 0x2e, 0x17, 0xee, 0x0e, 0x13, 0x02, 0x03, 0xff, 0xd4,
+	0x6c, 0xf2, 0x5a, 0x3c, 0x14, 0x70, 0x17, 0x2e, 0xbb, 0xb9, 0xd1, 0x71, 0xc6, 0x6c, 0xa3, 0x8f,
+	0x76, 0xaf, 0x4f, 0xa4, 0xc4, 0x47, 0x70, 0x21, 0x23, 0xa8, 0x1f, 0xd0, 0x30, 0x76, 0x3b, 0x54,
+	0xbd, 0xdf, 0xcd, 0xc2, 0x3a, 0xaa, 0x38, 0x71, 0x94, 0xb4, 0xe9, 0x5d, 0x29, 0x00, 0xb5, 0x28,
+	0xb2, 0x0b, 0x35, 0x96, 0x29, 0x5b, 0xd9, 0x7d, 0x0a, 0xe6, 0xe0, 0xd6, 0xed, 0xc9, 0x9e, 0x22,
+	0x14, 0xac, 0xd9, 0xd5, 0x25, 0x9e, 0xb2, 0xcd, 0x58, 0xd5, 0x82, 0x1a, 0xa7, 0xb6, 0xf2, 0x24,
+	0x8e, 0x35, 0x0d, 0xc2, 0x44, 0x0e, 0xd9, 0xd7, 0x17, 0x1f, 0xd4, 0x4e, 0x69, 0xf2, 0x78, 0xc4,
+	0xd5, 0x07, 0x11, 0x34, 0xee, 0x3b, 0x31, 0x0d, 0x07, 0x4e, 0xb8, 0x6f, 0xcd, 0x14, 0x7c, 0xc3,
+	0x7b, 0x8a, 0x53, 0xf2, 0x86, 0x1a, 0x84, 0x89, 0x1c, 0x76, 0x15, 0x90, 0x4a, 0xee, 0xa3, 0xd2,
+	0x25, 0x4f, 0x2f, 0x54, 0xed, 0x4c, 0x22, 0x69, 0x26, 0x54, 0x8f, 0x98, 0xc8, 0x20, 0x07, 0xa9,
+	0xfb, 0x09, 0xc4, 0xad, 0x14, 0xad, 0x02, 0x97, 0xa3, 0x48, 0x56, 0xc9, 0x72, 0x93, 0x7f, 0xcf,
+	0x81, 0xfd, 0x9b, 0xd5, 0x64, 0x5a, 0xfe, 0xa4, 0xe3, 0x51, 0xbf, 0x90, 0x8e, 0x47, 0xbd, 0x92,
+	0x8d, 0x47, 0xcd, 0x98, 0xf8, 0x4e, 0x1e, 0x91, 0xea, 0x40, 0xd3, 0x73, 0xa2, 0x78, 0x67, 0xd8,
+	0x75, 0x62, 0x19, 0xcc, 0x74, 0x32, 0xb3, 0xae, 0x36, 0xb9, 0x6d, 0x24, 0x6c, 0xd0, 0xe4, 0xc9,
+	0x12, 0x1e, 0x1d, 0xf0, 0x99, 0x40, 0xa4, 0xbc, 0xaa, 0xf1, 0x65, 0x84, 0xcf, 0xec, 0x77, 0x13,
+	0x30, 0x9a, 0x34, 0xac, 0x88, 0xd0, 0x40, 0x92, 0x9c, 0xed, 0xb2, 0x48, 0x3b, 0x01, 0xa3, 0x49,
+	0xc3, 0x3d, 0x6c, 0x3c, 0x7f, 0x29, 0x2b, 0x30, 0xcb, 0x0b, 0x08, 0x0f, 0x9b, 0x02, 0x62, 0x82,
+	0x67, 0x86, 0xad, 0x51, 0xb7, 0x27, 0x68, 0xeb, 0x9c, 0x96, 0x6b, 0x98, 0x3b, 0xab, 0x6b, 0x82,
+	0x54, 0x63, 0xd9, 0x09, 0xf1, 0x60, 0x97, 0xa7, 0xc4, 0xe8, 0xca, 0x14, 0x4f, 0xca, 0xfd, 0x59,
+	0x49, 0x4e, 0x88, 0xdf, 0x19, 0xa3, 0xc0, 0x9c, 0x52, 0xf6, 0xb7, 0x4a, 0xd0, 0x40, 0x27, 0x96,
+	0x0b, 0xe4, 0x4d, 0xb8, 0x28, 0x3f, 0x42, 0xb4, 0x45, 0x43, 0x61, 0x3f, 0x95, 0xcb, 0xbb, 0xf6,
+	0x48, 0x6d, 0x66, 0x09, 0x70, 0xbc, 0x0c, 0x0b, 0x5d, 0xd9, 0x3d, 0x8c, 0x4d, 0x2e, 0x62, 0xa5,
+	0xe7, 0xa1, 0x2b, 0xad, 0x14, 0x06, 0x33, 0x94, 0xf6, 0xef, 0x94, 0x80, 0x8c, 0x07, 0x88, 0x33,
+	0x1f, 0x8c, 0xcf, 0x4d, 0x86, 0x85, 0x6f, 0x82, 0x30, 0x2c, 0x8f, 0x62, 0xea, 0x92, 0x00, 0xc9,
+	0x9f, 0xf8, 0x50, 0xa7, 0x0f, 0x62, 0x1a, 0xfa, 0xfa, 0xc0, 0xc8, 0xe9, 0xdc, 0x3a, 0x21, 0x76,
+	0x0c, 0x92, 0x33, 0x6a, 0x19, 0xf6, 0xef, 0x95, 0xa1, 0x69, 0xd0, 0x3d, 0x6e, 0xe3, 0xc9, 0x13,
+	0x3e, 0x08, 0x4b, 0xdd, 0x4e, 0xe8, 0xc9, 0x51, 0x68, 0x24, 0x7c, 0x90, 0x28, 0xdc, 0x40, 0x93,
+	0x8e, 0xb9, 0x88, 0x07, 0x4e, 0x14, 0xd3, 0x90, 0xaf, 0xd0, 0x99, 0x34, 0x0b, 0x9b, 0x1a, 0x83,
+	0x06, 0x15, 0x4b, 0xcf, 0xc9, 0xef, 0x0d, 0xa9, 0xa6, 0xd3, 0x73, 0x4e, 0xb8, 0x14, 0xa4, 0x76,
+	0x0a, 0x97, 0x82, 0x90, 0x3e, 0x5c, 0x50, 0xb5, 0x56, 0xd8, 0x93, 0x25, 0x6f, 0x14, 0x7b, 0x9c,
+	0x0c, 0x0b, 0x1c, 0x63, 0x6a, 0xff, 0x7a, 0x09, 0xe6, 0x53, 0x76, 0x22, 0xf2, 0xb2, 0x79, 0xbc,
+	0x21, 0x95, 0x58, 0xd3, 0x38, 0x95, 0xc0, 0x8e, 0x52, 0xf2, 0x06, 0x1a, 0x3b, 0x4a, 0xc9, 0xa1,
+	0x28, 0xb1, 0x6c, 0xbe, 0x93, 0x96, 0xe8, 0xec, 0x7c, 0x27, 0x4d, 0xd5, 0xa8, 0xf0, 0xe4, 0xf3,
+	0x50, 0x57, 0xb5, 0x93, 0x2d, 0x9d, 0x5c, 0xa1, 0x23, 0xe1, 0xa8, 0x29, 0xec, 0x3f, 0x51, 0x95,
+	0xc3, 0x43, 0xc4, 0xb5, 0x29, 0x6b, 0xc5, 0x1f, 0x61, 0xba, 0xad, 0xee, 0x43, 0xa7, 0x7a, 0x5b,
+	0x8a, 0xee, 0x5b, 0x06, 0x10, 0x4d, 0x69, 0x4f, 0x1c, 0x78, 0xf8, 0xa3, 0xf9, 0xde, 0x6e, 0x33,
+	0x59, 0x4f, 0x82, 0xcc, 0x7a, 0xba, 0x6f, 0xc2, 0x45, 0xa6, 0x69, 0xb3, 0x34, 0xe0, 0x2d, 0xda,
+	0x77, 0x7d, 0x9f, 0x25, 0xbb, 0x15, 0x91, 0xae, 0x7a, 0x72, 0xc2, 0x2c, 0x01, 0x8e, 0x97, 0x51,
+	0x96, 0x96, 0xda, 0xa9, 0x5b, 0x5a, 0x86, 0x70, 0xa1, 0xc3, 0xec, 0x0a, 0x9b, 0xae, 0xcf, 0x0e,
+	0xb8, 0x19, 0xd1, 0x84, 0x27, 0xdd, 0x64, 0xf0, 0x4e, 0xbc, 0x92, 0xe1, 0x85, 0x63, 0xdc, 0xed,
+	0xff, 0x5e, 0x01, 0xee, 0x2e, 0x27, 0xaf, 0x43, 0x63, 0x40, 0x3b, 0x7b, 0x8e, 0xef, 0x46, 0x2a,
+	0x71, 0x3a, 0x33, 0x87, 0x34, 0x36, 0x15, 0xf0, 0x21, 0xeb, 0x4d, 0xcb, 0xed, 0x0d, 0xee, 0x3c,
+	0x4d, 0x68, 0xd9, 0xed, 0x71, 0xfd, 0x28, 0x72, 0x86, 0x6e, 0xe1, 0xdb, 0xe3, 0x44, 0x56, 0x5b,
+	0x31, 0xa3, 0x8a, 0xff, 0x28, 0x59, 0x33, 0xe3, 0xea, 0xd0, 0x73, 0x5c, 0xbf, 0xf0, 0xf5, 0x8c,
+	0xec, 0x0d, 0xb6, 0x18, 0x27, 0x61, 0x14, 0xe5, 0x7f, 0x51, 0xf0, 0x26, 0x23, 0x68, 0x46, 0x9d,
+	0xd0, 0x19, 0x44, 0x7b, 0xce, 0xf5, 0xd7, 0xbe, 0x68, 0x55, 0x4f, 0x4d, 0x94, 0x58, 0xe4, 0x57,
+	0x70, 0x79, 0xb3, 0xfd, 0xf6, 0xf2, 0xf5, 0xd7, 0xbe, 0x88, 0xa6, 0x1c, 0x53, 0xec, 0x6b, 0xaf,
+	0x5e, 0xb7, 0x6a, 0x67, 0x23, 0xf6, 0xb5, 0x57, 0xaf, 0xa3, 0x29, 0xc7, 0xfe, 0x6f, 0x25, 0x68,
+	0x68, 0x5a, 0xb2, 0x03, 0xc0, 0xa6, 0x63, 0x99, 0x87, 0xf6, 0x44, 0xd7, 0x3c, 0x71, 0x33, 0xca,
+	0x8e, 0x2e, 0x8c, 0x06, 0xa3, 0x9c, 0x44, 0xbd, 0xe5, 0xd3, 0x4e, 0xd4, 0xbb, 0x04, 0x8d, 0x3d,
+	0xc7, 0xef, 0x46, 0x7b, 0xce, 0x3e, 0x95, 0xa7, 0x54, 0xb5, 0x8e, 0xff, 0xb6, 0x42, 0x60, 0x42,
+	0x63, 0xff, 0xb5, 0x0a, 0xcc, 0xca, 0xdb, 0x13, 0xc9, 0xeb, 0x30, 0xd3, 0x0d, 0x59, 0x1c, 0xaf,
+	0xec, 0xf2, 0x0b, 0x6a, 0xde, 0x59, 0xe5, 0x50, 0xa6, 0x8c, 0x4a, 0x52, 0x01, 0x40, 0x49, 0x4e,
+	0xde, 0x82, 0x4a, 0x37, 0x3a, 0xa1, 0x19, 0x9e, 0x8f, 0xf5, 0xd5, 0xf6, 0x6d, 0x64, 0x45, 0x79,
+	0x74, 0x0f, 0x0f, 0xaf, 0xcd, 0xd8, 0x74, 0xb7, 0x19, 0x10, 0x05, 0x8e, 0x7c, 0x98, 0x64, 0xc5,
+	0x17, 0x69, 0x21, 0xd6, 0x8a, 0x5e, 0x18, 0x29, 0x32, 0xea, 0x27, 0x8b, 0xc9, 0x58, 0x86, 0xfd,
+	0x45, 0x00, 0x9e, 0xab, 0xd5, 0xcc, 0xc5, 0xcf, 0x3f, 0xf1, 0x2d, 0x0d, 0x45, 0x83, 0x82, 0x2c,
+	0x41, 0x75, 0x10, 0x74, 0xc5, 0x3c, 0x95, 0xe4, 0x9a, 0xa8, 0x4a, 0x13, 0x5f, 0x53, 0x8a, 0x65,
+	0x8f, 0xc8, 0x09, 0x99, 0x52, 0xbb, 0xab, 0x4d, 0x3f, 0x86, 0x52, 0x9b, 0x98, 0x7d, 0x12, 0xbc,
+	0x7d, 0x17, 0xe6, 0x53, 0x15, 0x7f, 0x82, 0x84, 0xdf, 0x2f, 0x43, 0xad, 0xe7, 0x52, 0xaf, 0x9b,
+	0x0d, 0x9b, 0x5a, 0x63, 0x40, 0x14, 0x38, 0xfb, 0xb7, 0x6b, 0x20, 0x2e, 0xc1, 0x63, 0x8b, 0x67,
+	0xd7, 0x8d, 0xc4, 0xb1, 0x87, 0x12, 0xef, 0x3e, 0x7a, 0xf1, 0x5c, 0x95, 0x70, 0xd4, 0x14, 0x2c,
+	0x07, 0xef, 0xc0, 0xf5, 0xa5, 0xe3, 0x98, 0x7f, 0xd0, 0x4d, 0xd7, 0x47, 0x06, 0xe3, 0x28, 0xe7,
+	0x81, 0x55, 0x31, 0x50, 0xce, 0x03, 0x64, 0x30, 0x66, 0xb8, 0xf2, 0x82, 0x60, 0x9f, 0x45, 0xc4,
+	0xaa, 0x78, 0x04, 0x91, 0xad, 0x98, 0x1b, 0xae, 0x36, 0xd2, 0x28, 0xcc, 0xd2, 0x92, 0x9b, 0x70,
+	0xbe, 0x13, 0x04, 0x5e, 0x37, 0xb8, 0xef, 0xab, 0xe2, 0x62, 0xc3, 0xc1, 0x1d, 0xb2, 0xab, 0x74,
+	0x18, 0xd2, 0x0e, 0xdb, 0x95, 0xac, 0xa4, 0x89, 0x30, 0x5b, 0x8a, 0xc5, 0x47, 0x7c, 0x44, 0xc3,
+	0x40, 0x2a, 0x10, 0x6d, 0x8f, 0xd2, 0xa1, 0x62, 0x28, 0xb6, 0x23, 0x3c, 0x3e, 0xe2, 0x6b, 0xf9,
+	0x24, 0x38, 0xa9, 0x2c, 0x63, 0x1b, 0x3b, 0x61, 0x9f, 0xc6, 0x5b, 0x61, 0xc0, 0x2c, 0xb4, 0xec,
+	0x62, 0x0a, 0xc9, 0x76, 0x36, 0x61, 0xbb, 0x9d, 0x4f, 0x82, 0x93, 0xca, 0xb2, 0x90, 0x13, 0x81,
+	0x12, 0x7a, 0xfc, 0xf2, 0x81, 0xe3, 0x7a, 0xce, 0xae, 0xeb, 0xa9, 0x3b, 0xa9, 0xe7, 0x85, 0x9f,
+	0x77, 0x7b, 0x02, 0x0d, 0x4e, 0x2c, 0xcd, 0x6f, 0x92, 0x16, 0xef, 0xc1, 0xb7, 0x0d, 0xfc, 0x86,
+	0xc5, 0x46, 0x62, 0x09, 0xc4, 0x0c, 0x0e, 0xc7, 0xa8, 0xd9, 0x5d, 0x5c, 0xfc, 0xf2, 0xc4, 0x9d,
+	0x61, 0xa6, 0xd1, 0x79, 0x30, 0xd5, 0xbc, 0x70, 0xe7, 0xb7, 0x73, 0x29, 0x70, 0x42, 0x49, 0xf6,
+	0xbe, 0x1c, 0xb3, 0x1a, 0xdc, 0xf7, 0xb3, 0x5c, 0x9b, 0xc9, 0xfb, 0xb6, 0x27, 0xd0, 0xe0, 0xc4,
+	0xd2, 0x76, 0x0f, 0xe6, 0xdb, 0x22, 0x68, 0x53, 0xde, 0x21, 0x60, 0x64, 0x81, 0x28, 0x9d, 0x5e,
+	0x16, 0x08, 0xfb, 0x5f, 0x95, 0xa1, 0xa1, 0x0d, 0x0e, 0x4f, 0x30, 0x54, 0x03, 0x68, 0xe8, 0xb8,
+	0xf5, 0xc2, 0x57, 0x3c, 0x27, 0x17, 0x48, 0xf2, 0xe9, 0x44, 0x3f, 0x62, 0x22, 0xc3, 0xbc, 0x01,
+	0xb4, 0x52, 0xe0, 0x06, 0xd0, 0x21, 0xcc, 0xc6, 0xa1, 0xdb, 0xef, 0xcb, 0x9d, 0x4d, 0x91, 0x6b,
+	0x43, 0x74, 0x73, 0x6d, 0x0b, 0x86, 0xb2, 0x65, 0xc5, 0x03, 0x2a, 0x31, 0xf6, 0x07, 0x70, 0x21,
+	0x4b, 0xc9, 0xd5, 0xfe, 0xce, 0x1e, 0xed, 0x8e, 0x3c, 0xd5, 0xc6, 0x89, 0xda, 0x2f, 0xe1, 0xa8,
+	0x29, 0x78, 0x56, 0x6b, 0x77, 0x40, 0x3f, 0x0a, 0x7c, 0x65, 0x78, 0x11, 0x59, 0xad, 0x25, 0x0c,
+	0x35, 0xd6, 0xfe, 0x8f, 0x15, 0x78, 0x41, 0x0b, 0x8b, 0x36, 0x1d, 0xdf, 0xe9, 0x3f, 0xc1, 0x15,
+	0xaf, 0xdf, 0x3f, 0x86, 0x71, 0xd2, 0x3b, 0x93, 0x2a, 0x4f, 0xc1, 0x9d, 0x49, 0xff, 0xbb, 0x0c,
+	0xfc, 0x22, 0x65, 0xf2, 0x33, 0x30, 0xe7, 0x18, 0x57, 0xba, 0x5b, 0xa5, 0x82, 0x9e, 0x28, 0xf3,
+	0x7e, 0xf8, 0x24, 0xb4, 0xd4, 0x84, 0x62, 0x4a, 0x20, 0x09, 0xa0, 0xde, 0x73, 0x3c, 0x8f, 0xad,
+	0x7b, 0x85, 0xdd, 0x60, 0x29, 0xe1, 0xbc, 0x9b, 0xaf, 0x49, 0xd6, 0xa8, 0x85, 0xb0, 0x08, 0xcd,
+	0xf9, 0x90, 0xc6, 0xe1, 0xa1, 0xf2, 0x7b, 0x16, 0xfe, 0x20, 0xfc, 0xad, 0x4c, 0x8e, 0xca, 0xa7,
+	0x65, 0x80, 0x30, 0x2d, 0xd3, 0xfe, 0xdb, 0x65, 0xb8, 0xa0, 0xcb, 0xc9, 0x03, 0x34, 0xa9, 0x6b,
+	0x7b, 0x4a, 0xa7, 0x7a, 0x6d, 0x8f, 0xc3, 0x13, 0xb5, 0x17, 0x4c, 0x67, 0xa2, 0x12, 0xbb, 0x6b,
+	0xfe, 0x26, 0x4f, 0xb6, 0x97, 0xef, 0x39, 0x4c, 0xe1, 0xcd, 0x9e, 0xdc, 0x5c, 0xe3, 0x50, 0x94,
+	0x58, 0xb6, 0x08, 0x8b, 0xec, 0xfc, 0xf9, 0x77, 0x38, 0xbc, 0x93, 0xc1, 0xe1, 0x18, 0x35, 0xbb,
+	0xd1, 0xe2, 0xe2, 0x58, 0x9b, 0xcb, 0x5c, 0xf4, 0xcb, 0x71, 0x4c, 0x07, 0xc3, 0x38, 0x92, 0x39,
+	0x78, 0x54, 0x95, 0x15, 0x18, 0x4d, 0x1a, 0x36, 0x9f, 0xcb, 0xd3, 0x4a, 0x56, 0xb9, 0xf0, 0x7c,
+	0x9e, 0xfe, 0x96, 0x32, 0xb6, 0x5f, 0x3c, 0xa0, 0x12, 0x43, 0xde, 0x85, 0x66, 0xe0, 0xdf, 0x78,
+	0xb0, 0xe7, 0x8c, 0xa2, 0x58, 0x1e, 0xc8, 0x4a, 0x4e, 0x21, 0x36, 0xef, 0x24, 0xa8, 0x87, 0x6c,
+	0xc5, 0x57, 0x3c, 0x35, 0x74, 0x59, 0x1e, 0xe9, 0x30, 0x78, 0xd8, 0xff, 0xa1, 0x04, 0xf3, 0x6d,
+	0xcf, 0xed, 0xba, 0x7e, 0xff, 0x0c, 0x6f, 0x0a, 0xba, 0x03, 0xb5, 0xc8, 0x73, 0xbb, 0x74, 0xca,
+	0xae, 0xc3, 0x77, 0xdd, 0xac, 0x96, 0xec, 0xf6, 0x69, 0xf6, 0x93, 0xbe, 0x7a, 0xa8, 0xf2, 0x04,
+	0x57, 0x0f, 0xfd, 0xd7, 0x59, 0x90, 0x97, 0xcb, 0xb3, 0x4b, 0x77, 0xfb, 0xea, 0x8e, 0x02, 0xab,
+	0x54, 0xf0, 0xd2, 0xdd, 0xcc, 0x75, 0x1b, 0x42, 0x8b, 0xd0, 0x40, 0x4c, 0x24, 0xb1, 0x2b, 0x85,
+	0xf7, 0x59, 0x48, 0x76, 0x61, 0xe3, 0xae, 0x71, 0x28, 0x44, 0xb4, 0x0c, 0x07, 0xa0, 0xe0, 0x4e,
+	0x1c, 0xa8, 0xee, 0xc5, 0xf1, 0xd0, 0xaa, 0x14, 0xcc, 0x04, 0x97, 0x64, 0x99, 0x12, 0x91, 0x37,
+	0xec, 0x19, 0x39, 0x6b, 0x26, 0xc2, 0x77, 0xf4, 0xbd, 0xb8, 0x2b, 0x85, 0x42, 0x7b, 0x4c, 0x11,
+	0xec, 0x19, 0x39, 0x6b, 0x66, 0x57, 0xe4, 0x07, 0x6c, 0xd8, 0x3d, 0x6f, 0x34, 0x94, 0xe6, 0x8d,
+	0xb5, 0x02, 0x77, 0xf4, 0x6f, 0x27, 0xdc, 0xc4, 0xfc, 0x9a, 0x02, 0xa1, 0x29, 0x8d, 0xec, 0x33,
+	0x9f, 0x88, 0xa8, 0x98, 0x34, 0xa4, 0x2d, 0x17, 0x90, 0x6c, 0x06, 0xee, 0xa8, 0x27, 0xd4, 0x02,
+	0xd2, 0x57, 0x40, 0xcf, 0x9e, 0xd6, 0x15, 0xd0, 0x66, 0x6f, 0xcc, 0x4b, 0x34, 0xc3, 0xbe, 0x61,
+	0xcf, 0xf5, 0x54, 0x14, 0xe6, 0x4a, 0xb1, 0x0b, 0xec, 0x8c, 0x6f, 0xc8, 0x9e, 0x91, 0xb3, 0x66,
+	0xb7, 0x04, 0xcf, 0x85, 0x86, 0xc9, 0xd8, 0x6a, 0x14, 0x3c, 0x44, 0x33, 0x6e, 0x7f, 0x16, 0x27,
+	0x48, 0x4d, 0x38, 0xa6, 0x44, 0xda, 0x03, 0x90, 0x7e, 0x44, 0xd2, 0x49, 0x5d, 0xd0, 0x28, 0x02,
+	0xef, 0x97, 0x9e, 0x6c, 0x1e, 0xd2, 0xd7, 0x8a, 0x19, 0xa9, 0xf3, 0x73, 0x6f, 0x62, 0xb4, 0xff,
+	0x75, 0x19, 0x98, 0x5d, 0x56, 0x64, 0x66, 0xe6, 0xb7, 0x9f, 0xd2, 0xf6, 0xbe, 0x3b, 0xbc, 0x4b,
+	0x43, 0xb7, 0x77, 0x28, 0x0d, 0x05, 0x46, 0x66, 0xe6, 0x2c, 0x05, 0xe6, 0x94, 0x62, 0x77, 0x4a,
+	0x75, 0x9c, 0x15, 0x1a, 0xc6, 0xd3, 0xd8, 0xc2, 0x78, 0xfb, 0xac, 0x2c, 0x27, 0xc5, 0x31, 0xc5,
+	0x8c, 0x59, 0xf0, 0x3a, 0x09, 0xeb, 0xca, 0x89, 0x2d, 0x78, 0x06, 0x63, 0x83, 0x11, 0x41, 0x68,
+	0xec, 0xd3, 0x43, 0xf1, 0x60, 0x55, 0x4f, 0xc2, 0x95, 0xf7, 0xd8, 0x5b, 0xaa, 0x2c, 0x26, 0x6c,
+	0x6c, 0x1f, 0xe6, 0x53, 0x57, 0xbc, 0x91, 0x2f, 0x41, 0x3d, 0x18, 0x1a, 0xd3, 0x78, 0x83, 0x5b,
+	0x36, 0xea, 0x77, 0x24, 0x8c, 0x99, 0xe1, 0x36, 0x82, 0xbe, 0xdb, 0x51, 0x00, 0xd4, 0xe4, 0xec,
+	0xbc, 0x13, 0x3f, 0x16, 0xa0, 0x2e, 0x78, 0xe3, 0x6b, 0x16, 0xbf, 0xd6, 0x27, 0x42, 0x89, 0xb1,
+	0xbf, 0x51, 0x85, 0xc4, 0xfb, 0x4e, 0x22, 0x98, 0xe9, 0xf2, 0x4b, 0x67, 0xac, 0x52, 0xc1, 0x28,
+	0x86, 0xf4, 0xbd, 0xb3, 0xc2, 0x5a, 0x99, 0x86, 0xa1, 0x14, 0x45, 0xfa, 0x50, 0xf9, 0x20, 0xd8,
+	0x2d, 0xbc, 0x60, 0x18, 0xe7, 0xe1, 0x85, 0x4a, 0x63, 0x00, 0x90, 0x49, 0x20, 0x7f, 0xb1, 0x04,
+	0x17, 0xa3, 0xec, 0xe6, 0x4d, 0x76, 0x07, 0x2c, 0xbe, 0x4b, 0xcd, 0x6e, 0x07, 0xe5, 0x99, 0x80,
+	0x49, 0x68, 0x1c, 0xaf, 0x0b, 0x6b, 0x7f, 0xe1, 0x16, 0xb7, 0xaa, 0x05, 0xdb, 0x5f, 0xde, 0x8d,
+	0x9e, 0x6a, 0xff, 0x34, 0x0c, 0xa5, 0x28, 0xfb, 0xe7, 0xca, 0xd0, 0x34, 0x56, 0x89, 0xc2, 0xf7,
+	0x06, 0x3e, 0xc8, 0xdc, 0x1b, 0xb8, 0x35, 0xbd, 0x17, 0x28, 0xa9, 0xd5, 0x59, 0x5f, 0x1d, 0xf8,
+	0x8f, 0xca, 0x50, 0xd9, 0x59, 0x5d, 0x4b, 0x9b, 0x5d, 0x4a, 0x9f, 0x80, 0xd9, 0x65, 0x0f, 0x66,
+	0x77, 0x47, 0xae, 0x17, 0xbb, 0x7e, 0xe1, 0xd4, 0x37, 0xea, 0x9a, 0x45, 0xa9, 0x57, 0x0b, 0xae,
+	0xa8, 0xd8, 0x93, 0x3e, 0xcc, 0xf6, 0x45, 0x22, 0xe1, 0xc2, 0xb1, 0xb3, 0x32, 0x21, 0xb1, 0x10,
+	0x24, 0x1f, 0x50, 0x71, 0xb7, 0x7f, 0x1a, 0x66, 0x76, 0x56, 0xf9, 0xc6, 0x35, 0x3a, 0x9b, 0xd6,
+	0xd4, 0x4a, 0x70, 0x5e, 0x8b, 0xda, 0x7f, 0xa5, 0x04, 0x5a, 0x05, 0xf9, 0xe4, 0xbf, 0xe7, 0x0f,
+	0xc1, 0xec, 0x6e, 0x30, 0xf2, 0xbb, 0x3a, 0x79, 0xb2, 0x36, 0x95, 0xb5, 0x04, 0x18, 0x15, 0xde,
+	0xfe, 0xcf, 0x25, 0x48, 0x2b, 0x68, 0x9f, 0x7c, 0x6d, 0xf7, 0xb3, 0xbd, 0x6f, 0xf5, 0x34, 0x06,
+	0x6b, 0x7e, 0x07, 0xb4, 0xff, 0x7e, 0x19, 0x66, 0xc4, 0x1c, 0xf4, 0x09, 0x84, 0x0d, 0xd3, 0x54,
+	0xd8, 0xf0, 0x4a, 0xc1, 0x89, 0x74, 0x62, 0xd0, 0xf0, 0x20, 0x13, 0x34, 0x7c, 0xa3, 0xa8, 0xa0,
+	0x47, 0x87, 0x0c, 0xff, 0xf3, 0x12, 0xc8, 0x69, 0x7c, 0xdd, 0x8f, 0x62, 0x87, 0x9d, 0x3c, 0xea,
+	0xe8, 0x35, 0xa3, 0x68, 0x6c, 0x9a, 0x60, 0x2c, 0xd5, 0x04, 0xfe, 0x5f, 0xad, 0x11, 0xcc, 0x9e,
+	0xba, 0x17, 0x44, 0x31, 0x5f, 0x17, 0xca, 0x69, 0x7b, 0xea, 0xdb, 0x12, 0x8e, 0x9a, 0x22, 0x1b,
+	0x9f, 0x51, 0x9b, 0x1c, 0x9f, 0x61, 0xff, 0x4a, 0x05, 0xe6, 0x84, 0xac, 0xa2, 0x11, 0xd0, 0x99,
+	0x00, 0xe4, 0xf2, 0xe9, 0x07, 0x20, 0xe7, 0x05, 0x59, 0x57, 0x0a, 0x06, 0x59, 0x57, 0x4f, 0x14,
+	0x64, 0x1d, 0x40, 0x23, 0x54, 0x01, 0x65, 0x85, 0xbd, 0xe1, 0x3a, 0x34, 0x4d, 0xcc, 0x0f, 0xfa,
+	0x11, 0x13, 0x19, 0xf6, 0x77, 0x4a, 0x00, 0xea, 0xf3, 0x9c, 0x79, 0xc0, 0x75, 0x37, 0x1d, 0x70,
+	0x5d, 0xb8, 0x23, 0xe7, 0x87, 0x5b, 0xff, 0x46, 0x4d, 0xbd, 0x12, 0x0f, 0xb6, 0xfe, 0xb8, 0x04,
+	0xe7, 0x9c, 0x54, 0x00, 0x73, 0x61, 0xdd, 0x37, 0x13, 0x0f, 0xad, 0x33, 0xb8, 0xa4, 0xe1, 0x98,
+	0x11, 0xcb, 0x52, 0x0f, 0x0c, 0x65, 0x74, 0xe7, 0xed, 0x64, 0x9c, 0x69, 0xfb, 0xf0, 0x96, 0x81,
+	0xc3, 0x14, 0xe5, 0x63, 0x02, 0xc6, 0x2b, 0xa7, 0x12, 0x30, 0x6e, 0x9e, 0x0d, 0xae, 0x3e, 0xf2,
+	0x6c, 0xf0, 0x01, 0x34, 0x7a, 0x61, 0x30, 0xe0, 0x31, 0xd9, 0xdc, 0x49, 0x5e, 0x64, 0x56, 0x5c,
+	0x09, 0x06, 0xbb, 0xae, 0x4f, 0xbb, 0x8c, 0x5b, 0xb2, 0xee, 0xaf, 0x29, 0xfe, 0x98, 0x88, 0xe2,
+	0x9e, 0xa7, 0x40, 0x48, 0x9d, 0x39, 0x4d, 0xa9, 0x7a, 0xf2, 0xda, 0x16, 0xdc, 0x51, 0x89, 0x49,
+	0xc7, 0x61, 0xcf, 0x7e, 0x32, 0x71, 0xd8, 0xcc, 0x91, 0x38, 0x67, 0xae, 0x15, 0x49, 0x0e, 0xdb,
+	0xd2, 0x84, 0x1c, 0xb6, 0x82, 0x3a, 0x15, 0x31, 0xfc, 0x0a, 0x4b, 0xba, 0xe1, 0x44, 0x81, 0x2f,
+	0x63, 0x13, 0xf4, 0x7a, 0x83, 0x1c, 0x8a, 0x12, 0x6b, 0x46, 0x16, 0x97, 0x1f, 0x13, 0x59, 0xfc,
+	0x79, 0xa3, 0x83, 0x88, 0xa3, 0x23, 0x7a, 0xac, 0xe7, 0x74, 0x12, 0x1e, 0x97, 0x27, 0x76, 0xc3,
+	0x32, 0x07, 0x8a, 0x11, 0x97, 0x27, 0xe0, 0xa8, 0x29, 0x58, 0xaa, 0x71, 0xcf, 0x89, 0x62, 0xee,
+	0xde, 0xed, 0x2e, 0xc7, 0x53, 0x84, 0x2d, 0xeb, 0x61, 0xb4, 0x61, 0xf0, 0xc1, 0x14, 0x57, 0xfb,
+	0xa8, 0x02, 0x99, 0x3d, 0xd2, 0xf7, 0x3d, 0x7a, 0xff, 0x57, 0x79, 0xf4, 0xbe, 0x59, 0x86, 0x64,
+	0x4c, 0x9d, 0x30, 0xba, 0xe5, 0xab, 0x50, 0x1f, 0x38, 0x0f, 0x56, 0x0b, 0xdc, 0x66, 0xcd, 0xe7,
+	0xcb, 0x4d, 0xc9, 0x03, 0x35, 0x37, 0x12, 0x01, 0xb8, 0x3a, 0x65, 0x7f, 0x61, 0x8b, 0x76, 0x92,
+	0xfd, 0x5f, 0xd8, 0xae, 0x92, 0x67, 0x34, 0xc4, 0xd8, 0xff, 0xac, 0x0c, 0xf2, 0xd2, 0x17, 0x66,
+	0xb2, 0xef, 0xb9, 0x0f, 0x68, 0xb7, 0x70, 0x5c, 0xeb, 0x1a, 0xe3, 0x22, 0x98, 0x0a, 0x93, 0x3d,
+	0x07, 0xa0, 0xe0, 0x4e, 0x06, 0x30, 0x1b, 0x09, 0x17, 0x8c, 0x55, 0x2e, 0x68, 0xe8, 0x4e, 0xb9,
+	0x72, 0xe4, 0x15, 0x2e, 0x02, 0x84, 0x4a, 0x06, 0x17, 0x27, 0x53, 0x76, 0x55, 0x8a, 0x8a, 0x33,
+	0xe3, 0x43, 0xa4, 0x38, 0x01, 0x42, 0x25, 0xa3, 0xf5, 0x13, 0xdf, 0xfe, 0xee, 0x95, 0x67, 0xbe,
+	0xf3, 0xdd, 0x2b, 0xcf, 0xfc, 0xd6, 0x77, 0xaf, 0x3c, 0xf3, 0x8d, 0xe3, 0x2b, 0xa5, 0x6f, 0x1f,
+	0x5f, 0x29, 0x7d, 0xe7, 0xf8, 0x4a, 0xe9, 0xb7, 0x8e, 0xaf, 0x94, 0xfe, 0xdd, 0xf1, 0x95, 0xd2,
+	0xb7, 0xfe, 0xfd, 0x95, 0x67, 0xbe, 0xf6, 0x7a, 0x52, 0x85, 0x25, 0x55, 0x85, 0x25, 0x25, 0x70,
+	0x69, 0xb8, 0xdf, 0x67, 0xe7, 0x3d, 0xa3, 0x04, 0xa2, 0xaa, 0xf0, 0x7f, 0x06, 0x00, 0x5d, 0x09,
+	0x35, 0x16, 0xdf, 0xa2, 0x00, 0x00,
 }
 
 func (m *AbstractPodTemplate) Marshal() (dAtA []byte, err error) {
@@ -3063,6 +3763,66 @@ func (m *AbstractSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SQL != nil {
+		{
+			size, err := m.SQL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.JetStream != nil {
+		{
+			size, err := m.JetStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Nats != nil {
+		{
+			size, err := m.Nats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.UDSink != nil {
 		{
 			size, err := m.UDSink.MarshalToSizedBuffer(dAtA[:i])
@@ -3840,6 +4600,198 @@ func (m *Edge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FileSink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileSink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rotation != nil {
+		{
+			size, err := m.Rotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i -= len(m.Compression)
+	copy(dAtA[i:], m.Compression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Compression)))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Columns) > 0 {
+		for iNdEx := len(m.Columns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Columns[iNdEx])
+			copy(dAtA[i:], m.Columns[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Columns[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Prefix)
+	copy(dAtA[i:], m.Prefix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Prefix)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Volume)
+	copy(dAtA[i:], m.Volume)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Volume)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FileSinkRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileSinkRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileSinkRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != nil {
+		{
+			size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Interval != nil {
+		{
+			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxSize != nil {
+		{
+			size, err := m.MaxSize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FileSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PollInterval != nil {
+		{
+			size, err := m.PollInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Follow != nil {
+		i--
+		if *m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VolumeMounts) > 0 {
+		for iNdEx := len(m.VolumeMounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeMounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *FixedWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4067,7 +5019,7 @@ func (m *GSSAPI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GeneratorSource) Marshal() (dAtA []byte, err error) {
+func (m *GeneratorKeyDistribution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4077,69 +5029,184 @@ func (m *GeneratorSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GeneratorSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *GeneratorKeyDistribution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GeneratorSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GeneratorKeyDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ValueBlob != nil {
-		i -= len(*m.ValueBlob)
-		copy(dAtA[i:], *m.ValueBlob)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.ValueBlob)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Jitter != nil {
-		{
-			size, err := m.Jitter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Value != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Value))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.KeyCount != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.KeyCount))
+	if m.HotKeyPercentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.HotKeyPercentage))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.MsgSize != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.MsgSize))
+	if m.HotKeyCount != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.HotKeyCount))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.Duration != nil {
-		{
-			size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.RPU != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.RPU))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
+	i -= len(m.ZipfExponent)
+	copy(dAtA[i:], m.ZipfExponent)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ZipfExponent)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GeneratorLateData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneratorLateData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneratorLateData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Delay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Percentage))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *GeneratorSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneratorSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneratorSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMessages != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxMessages))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.LateData != nil {
+		{
+			size, err := m.LateData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.KeyDistribution != nil {
+		{
+			size, err := m.KeyDistribution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Template != nil {
+		i -= len(*m.Template)
+		copy(dAtA[i:], *m.Template)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Template)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ValueBlob != nil {
+		i -= len(*m.ValueBlob)
+		copy(dAtA[i:], *m.ValueBlob)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.ValueBlob)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Jitter != nil {
+		{
+			size, err := m.Jitter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Value != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Value))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.KeyCount != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.KeyCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MsgSize != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MsgSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Duration != nil {
+		{
+			size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RPU != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RPU))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
 
 func (m *GetDaemonDeploymentReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -4749,7 +5816,7 @@ func (m *GroupBy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HTTPSource) Marshal() (dAtA []byte, err error) {
+func (m *HTTPSink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4759,24 +5826,40 @@ func (m *HTTPSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HTTPSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPSink) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HTTPSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HTTPSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.Service {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	i--
-	dAtA[i] = 0x10
 	if m.Auth != nil {
 		{
 			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
@@ -4787,12 +5870,58 @@ func (m *HTTPSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
+	}
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *IdleSource) Marshal() (dAtA []byte, err error) {
+func (m *HTTPSinkAuth) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4802,31 +5931,19 @@ func (m *IdleSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IdleSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPSinkAuth) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IdleSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HTTPSinkAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IncrementBy != nil {
-		{
-			size, err := m.IncrementBy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.StepInterval != nil {
+	if m.Token != nil {
 		{
-			size, err := m.StepInterval.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4836,9 +5953,9 @@ func (m *IdleSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Threshold != nil {
+	if m.Basic != nil {
 		{
-			size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Basic.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4851,7 +5968,7 @@ func (m *IdleSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InterStepBufferService) Marshal() (dAtA []byte, err error) {
+func (m *HTTPSinkBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4861,39 +5978,189 @@ func (m *InterStepBufferService) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InterStepBufferService) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPSinkBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InterStepBufferService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HTTPSinkBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.MaxSize != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxSize))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *HTTPSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SyncTimeout != nil {
+		{
+			size, err := m.SyncTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i--
+	if m.Synchronous {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i--
+	if m.Service {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	if m.Auth != nil {
+		{
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdleSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdleSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdleSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncrementBy != nil {
+		{
+			size, err := m.IncrementBy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StepInterval != nil {
+		{
+			size, err := m.StepInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Threshold != nil {
+		{
+			size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterStepBufferService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterStepBufferService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterStepBufferService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
 		i -= size
@@ -5239,7 +6506,7 @@ func (m *JetStreamConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JetStreamSource) Marshal() (dAtA []byte, err error) {
+func (m *JetStreamSink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5249,16 +6516,28 @@ func (m *JetStreamSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JetStreamSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *JetStreamSink) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JetStreamSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JetStreamSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.AckTimeout != nil {
+		{
+			size, err := m.AckTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Auth != nil {
 		{
 			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
@@ -5269,7 +6548,7 @@ func (m *JetStreamSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
 	if m.TLS != nil {
 		{
@@ -5281,12 +6560,17 @@ func (m *JetStreamSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
 	i -= len(m.Stream)
 	copy(dAtA[i:], m.Stream)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stream)))
 	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
 	dAtA[i] = 0x12
 	i -= len(m.URL)
 	copy(dAtA[i:], m.URL)
@@ -5296,7 +6580,7 @@ func (m *JetStreamSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobTemplate) Marshal() (dAtA []byte, err error) {
+func (m *JetStreamSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5306,29 +6590,19 @@ func (m *JobTemplate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobTemplate) MarshalTo(dAtA []byte) (int, error) {
+func (m *JetStreamSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JetStreamSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BackoffLimit != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.BackoffLimit))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TTLSecondsAfterFinished != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.TTLSecondsAfterFinished))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ContainerTemplate != nil {
+	if m.AckWait != nil {
 		{
-			size, err := m.ContainerTemplate.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AckWait.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5336,44 +6610,28 @@ func (m *JobTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x52
 	}
-	{
-		size, err := m.AbstractPodTemplate.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	i -= len(m.Durable)
+	copy(dAtA[i:], m.Durable)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Durable)))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.FilterSubjects) > 0 {
+		for iNdEx := len(m.FilterSubjects) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FilterSubjects[iNdEx])
+			copy(dAtA[i:], m.FilterSubjects[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.FilterSubjects[iNdEx])))
+			i--
+			dAtA[i] = 0x42
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.StartSequence))
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *KafkaSink) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *KafkaSink) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *KafkaSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SASL != nil {
+	dAtA[i] = 0x38
+	if m.StartTime != nil {
 		{
-			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5381,16 +6639,16 @@ func (m *KafkaSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x32
 	}
-	i -= len(m.Config)
-	copy(dAtA[i:], m.Config)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Config)))
+	i -= len(m.DeliverPolicy)
+	copy(dAtA[i:], m.DeliverPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeliverPolicy)))
 	i--
-	dAtA[i] = 0x22
-	if m.TLS != nil {
+	dAtA[i] = 0x2a
+	if m.Auth != nil {
 		{
-			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5398,26 +6656,89 @@ func (m *KafkaSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	i -= len(m.Topic)
-	copy(dAtA[i:], m.Topic)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Stream)
+	copy(dAtA[i:], m.Stream)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stream)))
 	i--
 	dAtA[i] = 0x12
-	if len(m.Brokers) > 0 {
-		for iNdEx := len(m.Brokers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Brokers[iNdEx])
-			copy(dAtA[i:], m.Brokers[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Brokers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *JobTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BackoffLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.BackoffLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TTLSecondsAfterFinished != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TTLSecondsAfterFinished))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContainerTemplate != nil {
+		{
+			size, err := m.ContainerTemplate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.AbstractPodTemplate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *KafkaSource) Marshal() (dAtA []byte, err error) {
+func (m *KafkaRewind) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5427,16 +6748,100 @@ func (m *KafkaSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KafkaSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *KafkaRewind) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KafkaSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KafkaRewind) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *KafkaSink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaSink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Transaction != nil {
+		{
+			size, err := m.Transaction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.AllowedTopics) > 0 {
+		for iNdEx := len(m.AllowedTopics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedTopics[iNdEx])
+			copy(dAtA[i:], m.AllowedTopics[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AllowedTopics[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	i -= len(m.TopicExpression)
+	copy(dAtA[i:], m.TopicExpression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TopicExpression)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.Partitioner)
+	copy(dAtA[i:], m.Partitioner)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Partitioner)))
+	i--
+	dAtA[i] = 0x42
+	i--
+	if m.ForwardHeaders {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	if m.Key != nil {
+		{
+			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.SASL != nil {
 		{
 			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
@@ -5447,13 +6852,13 @@ func (m *KafkaSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x2a
 	}
 	i -= len(m.Config)
 	copy(dAtA[i:], m.Config)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Config)))
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x22
 	if m.TLS != nil {
 		{
 			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
@@ -5464,13 +6869,8 @@ func (m *KafkaSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
-	i -= len(m.ConsumerGroupName)
-	copy(dAtA[i:], m.ConsumerGroupName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConsumerGroupName)))
-	i--
-	dAtA[i] = 0x1a
 	i -= len(m.Topic)
 	copy(dAtA[i:], m.Topic)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
@@ -5488,7 +6888,7 @@ func (m *KafkaSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Lifecycle) Marshal() (dAtA []byte, err error) {
+func (m *KafkaSinkKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5498,35 +6898,32 @@ func (m *Lifecycle) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Lifecycle) MarshalTo(dAtA []byte) (int, error) {
+func (m *KafkaSinkKey) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Lifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KafkaSinkKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PauseGracePeriodSeconds != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.PauseGracePeriodSeconds))
+	if m.Separator != nil {
+		i -= len(*m.Separator)
+		copy(dAtA[i:], *m.Separator)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Separator)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
-	i -= len(m.DesiredPhase)
-	copy(dAtA[i:], m.DesiredPhase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DesiredPhase)))
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
 	i--
-	dAtA[i] = 0x12
-	if m.DeleteGracePeriodSeconds != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.DeleteGracePeriodSeconds))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Log) Marshal() (dAtA []byte, err error) {
+func (m *KafkaSinkTransaction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5536,20 +6933,35 @@ func (m *Log) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Log) MarshalTo(dAtA []byte) (int, error) {
+func (m *KafkaSinkTransaction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KafkaSinkTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.DeduplicationWindow != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.DeduplicationWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	i -= len(m.TransactionalIDPrefix)
+	copy(dAtA[i:], m.TransactionalIDPrefix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TransactionalIDPrefix)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.CheckpointTopic)
+	copy(dAtA[i:], m.CheckpointTopic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CheckpointTopic)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
+func (m *KafkaSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5559,71 +6971,315 @@ func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *KafkaSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KafkaSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		keysForLabels := make([]string, 0, len(m.Labels))
-		for k := range m.Labels {
-			keysForLabels = append(keysForLabels, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Labels[string(keysForLabels[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForLabels[iNdEx])
-			copy(dAtA[i:], keysForLabels[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+	if m.TopicRefreshInterval != nil {
+		{
+			size, err := m.TopicRefreshInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	if len(m.Annotations) > 0 {
-		keysForAnnotations := make([]string, 0, len(m.Annotations))
-		for k := range m.Annotations {
-			keysForAnnotations = append(keysForAnnotations, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Annotations[string(keysForAnnotations[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForAnnotations[iNdEx])
-			copy(dAtA[i:], keysForAnnotations[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+	i -= len(m.TopicPattern)
+	copy(dAtA[i:], m.TopicPattern)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TopicPattern)))
+	i--
+	dAtA[i] = 0x52
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topics[iNdEx])))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x4a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *NativeRedis) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if m.Rewind != nil {
+		{
+			size, err := m.Rewind.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StartPosition != nil {
+		{
+			size, err := m.StartPosition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SASL != nil {
+		{
+			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i -= len(m.Config)
+	copy(dAtA[i:], m.Config)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Config)))
+	i--
+	dAtA[i] = 0x2a
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.ConsumerGroupName)
+	copy(dAtA[i:], m.ConsumerGroupName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConsumerGroupName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Topic)
+	copy(dAtA[i:], m.Topic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Brokers) > 0 {
+		for iNdEx := len(m.Brokers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Brokers[iNdEx])
+			copy(dAtA[i:], m.Brokers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Brokers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KafkaStartPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaStartPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaStartPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Offsets) > 0 {
+		keysForOffsets := make([]string, 0, len(m.Offsets))
+		for k := range m.Offsets {
+			keysForOffsets = append(keysForOffsets, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForOffsets)
+		for iNdEx := len(keysForOffsets) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Offsets[string(keysForOffsets[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForOffsets[iNdEx])
+			copy(dAtA[i:], keysForOffsets[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForOffsets[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Lifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Lifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PauseGracePeriodSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.PauseGracePeriodSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	i -= len(m.DesiredPhase)
+	copy(dAtA[i:], m.DesiredPhase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DesiredPhase)))
+	i--
+	dAtA[i] = 0x12
+	if m.DeleteGracePeriodSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.DeleteGracePeriodSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Log) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Log) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NativeRedis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -5794,7 +7450,7 @@ func (m *NatsAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NatsSource) Marshal() (dAtA []byte, err error) {
+func (m *NatsSink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5804,12 +7460,12 @@ func (m *NatsSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NatsSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *NatsSink) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NatsSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NatsSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5824,7 +7480,64 @@ func (m *NatsSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NatsSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NatsSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NatsSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Auth != nil {
+		{
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.TLS != nil {
 		{
@@ -6271,6 +7984,9 @@ func (m *PipelineStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x48
 	if m.UDFCount != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.UDFCount))
 		i--
@@ -6324,6 +8040,37 @@ func (m *PipelineStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BytesPerSecond != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.BytesPerSecond))
+		i--
+		dAtA[i] = 0x10
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MessagesPerSecond))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *RedisBufferService) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6481,6 +8228,81 @@ func (m *RedisSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RedisStreamsSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedisStreamsSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedisStreamsSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimMinIdleTime != nil {
+		{
+			size, err := m.ClaimMinIdleTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i--
+	if m.ReadFromBeginning {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.ConsumerGroup)
+	copy(dAtA[i:], m.ConsumerGroup)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConsumerGroup)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Stream)
+	copy(dAtA[i:], m.Stream)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stream)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RedisConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SASL) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6614,6 +8436,117 @@ func (m *SASLPlain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SQLSink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLSink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchSize != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.BatchSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	i -= len(m.Mode)
+	copy(dAtA[i:], m.Mode)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mode)))
+	i--
+	dAtA[i] = 0x32
+	if len(m.KeyColumns) > 0 {
+		for iNdEx := len(m.KeyColumns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyColumns[iNdEx])
+			copy(dAtA[i:], m.KeyColumns[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyColumns[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Columns) > 0 {
+		for iNdEx := len(m.Columns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Columns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Table)
+	copy(dAtA[i:], m.Table)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Table)))
+	i--
+	dAtA[i] = 0x1a
+	if m.DSN != nil {
+		{
+			size, err := m.DSN.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Driver)
+	copy(dAtA[i:], m.Driver)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Driver)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SQLSinkColumn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLSinkColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLSinkColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Field)
+	copy(dAtA[i:], m.Field)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Field)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Scale) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6908,6 +8841,18 @@ func (m *Sink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryStrategy != nil {
+		{
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Fallback != nil {
 		{
 			size, err := m.Fallback.MarshalToSizedBuffer(dAtA[:i])
@@ -6933,7 +8878,7 @@ func (m *Sink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SlidingWindow) Marshal() (dAtA []byte, err error) {
+func (m *SinkRetryBackoff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6943,27 +8888,29 @@ func (m *SlidingWindow) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SlidingWindow) MarshalTo(dAtA []byte) (int, error) {
+func (m *SinkRetryBackoff) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SlidingWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SinkRetryBackoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.Streaming {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if m.JitterPercentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.JitterPercentage))
+		i--
+		dAtA[i] = 0x20
 	}
+	i -= len(m.Factor)
+	copy(dAtA[i:], m.Factor)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Factor)))
 	i--
-	dAtA[i] = 0x18
-	if m.Slide != nil {
+	dAtA[i] = 0x1a
+	if m.MaxInterval != nil {
 		{
-			size, err := m.Slide.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MaxInterval.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6973,9 +8920,109 @@ func (m *SlidingWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Length != nil {
+	if m.Interval != nil {
 		{
-			size, err := m.Length.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SinkRetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SinkRetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SinkRetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.OnExhausted)
+	copy(dAtA[i:], m.OnExhausted)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExhausted)))
+	i--
+	dAtA[i] = 0x1a
+	if m.Backoff != nil {
+		{
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxAttempts != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlidingWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlidingWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlidingWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Streaming {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if m.Slide != nil {
+		{
+			size, err := m.Slide.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Length != nil {
+		{
+			size, err := m.Length.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7008,6 +9055,30 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RedisStreams != nil {
+		{
+			size, err := m.RedisStreams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.JetStream != nil {
 		{
 			size, err := m.JetStream.MarshalToSizedBuffer(dAtA[:i])
@@ -7482,6 +9553,14 @@ func (m *UDSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Bounded {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
 	if m.Container != nil {
 		{
 			size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
@@ -7660,6 +9739,18 @@ func (m *VertexLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.BufferUsageLimit != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.BufferUsageLimit))
 		i--
@@ -8144,6 +10235,26 @@ func (m *AbstractSink) Size() (n int) {
 		l = m.UDSink.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Nats != nil {
+		l = m.Nats.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.JetStream != nil {
+		l = m.JetStream.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SQL != nil {
+		l = m.SQL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -8425,6 +10536,80 @@ func (m *Edge) Size() (n int) {
 	return n
 }
 
+func (m *FileSink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Volume)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Prefix)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Compression)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Rotation != nil {
+		l = m.Rotation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *FileSinkRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSize != nil {
+		l = m.MaxSize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Window != nil {
+		l = m.Window.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *FileSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.VolumeMounts) > 0 {
+		for _, e := range m.VolumeMounts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Follow != nil {
+		n += 2
+	}
+	if m.PollInterval != nil {
+		l = m.PollInterval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *FixedWindow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8510,6 +10695,37 @@ func (m *GSSAPI) Size() (n int) {
 	return n
 }
 
+func (m *GeneratorKeyDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ZipfExponent)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.HotKeyCount != nil {
+		n += 1 + sovGenerated(uint64(*m.HotKeyCount))
+	}
+	if m.HotKeyPercentage != nil {
+		n += 1 + sovGenerated(uint64(*m.HotKeyPercentage))
+	}
+	return n
+}
+
+func (m *GeneratorLateData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Percentage))
+	l = m.Delay.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *GeneratorSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8540,6 +10756,21 @@ func (m *GeneratorSource) Size() (n int) {
 		l = len(*m.ValueBlob)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Template != nil {
+		l = len(*m.Template)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.KeyDistribution != nil {
+		l = m.KeyDistribution.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LateData != nil {
+		l = m.LateData.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxMessages != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxMessages))
+	}
 	return n
 }
 
@@ -8758,36 +10989,107 @@ func (m *GroupBy) Size() (n int) {
 	return n
 }
 
-func (m *HTTPSource) Size() (n int) {
+func (m *HTTPSink) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	if m.Auth != nil {
 		l = m.Auth.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	n += 2
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *IdleSource) Size() (n int) {
+func (m *HTTPSinkAuth) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Threshold != nil {
-		l = m.Threshold.Size()
+	if m.Basic != nil {
+		l = m.Basic.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.StepInterval != nil {
-		l = m.StepInterval.Size()
+	if m.Token != nil {
+		l = m.Token.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.IncrementBy != nil {
-		l = m.IncrementBy.Size()
+	return n
+}
+
+func (m *HTTPSinkBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSize != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxSize))
+	}
+	return n
+}
+
+func (m *HTTPSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	n += 2
+	if m.SyncTimeout != nil {
+		l = m.SyncTimeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *IdleSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != nil {
+		l = m.Threshold.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.StepInterval != nil {
+		l = m.StepInterval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.IncrementBy != nil {
+		l = m.IncrementBy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
@@ -8927,6 +11229,33 @@ func (m *JetStreamConfig) Size() (n int) {
 	return n
 }
 
+func (m *JetStreamSink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Stream)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AckTimeout != nil {
+		l = m.AckTimeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *JetStreamSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8945,6 +11274,25 @@ func (m *JetStreamSource) Size() (n int) {
 		l = m.Auth.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.DeliverPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.StartSequence))
+	if len(m.FilterSubjects) > 0 {
+		for _, s := range m.FilterSubjects {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Durable)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AckWait != nil {
+		l = m.AckWait.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -8969,6 +11317,17 @@ func (m *JobTemplate) Size() (n int) {
 	return n
 }
 
+func (m *KafkaRewind) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Timestamp.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *KafkaSink) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8993,6 +11352,56 @@ func (m *KafkaSink) Size() (n int) {
 		l = m.SASL.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	l = len(m.Partitioner)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TopicExpression)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.AllowedTopics) > 0 {
+		for _, s := range m.AllowedTopics {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *KafkaSinkKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Separator != nil {
+		l = len(*m.Separator)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *KafkaSinkTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CheckpointTopic)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TransactionalIDPrefix)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DeduplicationWindow != nil {
+		n += 1 + sovGenerated(uint64(*m.DeduplicationWindow))
+	}
 	return n
 }
 
@@ -9022,6 +11431,49 @@ func (m *KafkaSource) Size() (n int) {
 		l = m.SASL.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.StartPosition != nil {
+		l = m.StartPosition.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Rewind != nil {
+		l = m.Rewind.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.TopicPattern)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TopicRefreshInterval != nil {
+		l = m.TopicRefreshInterval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *KafkaStartPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Offsets) > 0 {
+		for k, v := range m.Offsets {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -9137,6 +11589,27 @@ func (m *NatsAuth) Size() (n int) {
 	return n
 }
 
+func (m *NatsSink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *NatsSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9332,6 +11805,20 @@ func (m *PipelineStatus) Size() (n int) {
 	if m.UDFCount != nil {
 		n += 1 + sovGenerated(uint64(*m.UDFCount))
 	}
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MessagesPerSecond))
+	if m.BytesPerSecond != nil {
+		n += 1 + sovGenerated(uint64(*m.BytesPerSecond))
+	}
 	return n
 }
 
@@ -9394,6 +11881,30 @@ func (m *RedisSettings) Size() (n int) {
 	return n
 }
 
+func (m *RedisStreamsSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedisConfig.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Stream)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ConsumerGroup)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ClaimMinIdleTime != nil {
+		l = m.ClaimMinIdleTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *SASL) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9441,6 +11952,53 @@ func (m *SASLPlain) Size() (n int) {
 	return n
 }
 
+func (m *SQLSink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Driver)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DSN != nil {
+		l = m.DSN.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Table)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Columns) > 0 {
+		for _, e := range m.Columns {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.KeyColumns) > 0 {
+		for _, s := range m.KeyColumns {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Mode)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.BatchSize != nil {
+		n += 1 + sovGenerated(uint64(*m.BatchSize))
+	}
+	return n
+}
+
+func (m *SQLSinkColumn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Field)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Scale) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9565,44 +12123,88 @@ func (m *Sink) Size() (n int) {
 		l = m.Fallback.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *SlidingWindow) Size() (n int) {
+func (m *SinkRetryBackoff) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Length != nil {
-		l = m.Length.Size()
+	if m.Interval != nil {
+		l = m.Interval.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Slide != nil {
-		l = m.Slide.Size()
+	if m.MaxInterval != nil {
+		l = m.MaxInterval.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	n += 2
+	l = len(m.Factor)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.JitterPercentage != nil {
+		n += 1 + sovGenerated(uint64(*m.JitterPercentage))
+	}
 	return n
 }
 
-func (m *Source) Size() (n int) {
+func (m *SinkRetryStrategy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Generator != nil {
-		l = m.Generator.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.MaxAttempts != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxAttempts))
 	}
-	if m.Kafka != nil {
-		l = m.Kafka.Size()
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.HTTP != nil {
-		l = m.HTTP.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.OnExhausted)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SlidingWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != nil {
+		l = m.Length.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Slide != nil {
+		l = m.Slide.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
+func (m *Source) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Generator != nil {
+		l = m.Generator.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	if m.Nats != nil {
 		l = m.Nats.Size()
@@ -9620,6 +12222,14 @@ func (m *Source) Size() (n int) {
 		l = m.JetStream.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RedisStreams != nil {
+		l = m.RedisStreams.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9771,6 +12381,7 @@ func (m *UDSource) Size() (n int) {
 		l = m.Container.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 2
 	return n
 }
 
@@ -9841,6 +12452,10 @@ func (m *VertexLimits) Size() (n int) {
 	if m.BufferUsageLimit != nil {
 		n += 1 + sovGenerated(uint64(*m.BufferUsageLimit))
 	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10028,6 +12643,11 @@ func (this *AbstractSink) String() string {
 		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaSink", "KafkaSink", 1) + `,`,
 		`Blackhole:` + strings.Replace(this.Blackhole.String(), "Blackhole", "Blackhole", 1) + `,`,
 		`UDSink:` + strings.Replace(this.UDSink.String(), "UDSink", "UDSink", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPSink", "HTTPSink", 1) + `,`,
+		`File:` + strings.Replace(this.File.String(), "FileSink", "FileSink", 1) + `,`,
+		`Nats:` + strings.Replace(this.Nats.String(), "NatsSink", "NatsSink", 1) + `,`,
+		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamSink", "JetStreamSink", 1) + `,`,
+		`SQL:` + strings.Replace(this.SQL.String(), "SQLSink", "SQLSink", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10211,6 +12831,52 @@ func (this *Edge) String() string {
 	}, "")
 	return s
 }
+func (this *FileSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FileSink{`,
+		`Volume:` + fmt.Sprintf("%v", this.Volume) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`Columns:` + fmt.Sprintf("%v", this.Columns) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
+		`Rotation:` + strings.Replace(this.Rotation.String(), "FileSinkRotation", "FileSinkRotation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FileSinkRotation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FileSinkRotation{`,
+		`MaxSize:` + strings.Replace(fmt.Sprintf("%v", this.MaxSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`Interval:` + strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v11.Duration", 1) + `,`,
+		`Window:` + strings.Replace(fmt.Sprintf("%v", this.Window), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FileSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVolumeMounts := "[]VolumeMount{"
+	for _, f := range this.VolumeMounts {
+		repeatedStringForVolumeMounts += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumeMounts += "}"
+	s := strings.Join([]string{`&FileSource{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`VolumeMounts:` + repeatedStringForVolumeMounts + `,`,
+		`Follow:` + valueToStringGenerated(this.Follow) + `,`,
+		`PollInterval:` + strings.Replace(fmt.Sprintf("%v", this.PollInterval), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *FixedWindow) String() string {
 	if this == nil {
 		return "nil"
@@ -10270,6 +12936,30 @@ func (this *GSSAPI) String() string {
 	}, "")
 	return s
 }
+func (this *GeneratorKeyDistribution) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GeneratorKeyDistribution{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ZipfExponent:` + fmt.Sprintf("%v", this.ZipfExponent) + `,`,
+		`HotKeyCount:` + valueToStringGenerated(this.HotKeyCount) + `,`,
+		`HotKeyPercentage:` + valueToStringGenerated(this.HotKeyPercentage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GeneratorLateData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GeneratorLateData{`,
+		`Percentage:` + fmt.Sprintf("%v", this.Percentage) + `,`,
+		`Delay:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Delay), "Duration", "v11.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GeneratorSource) String() string {
 	if this == nil {
 		return "nil"
@@ -10282,6 +12972,10 @@ func (this *GeneratorSource) String() string {
 		`Value:` + valueToStringGenerated(this.Value) + `,`,
 		`Jitter:` + strings.Replace(fmt.Sprintf("%v", this.Jitter), "Duration", "v11.Duration", 1) + `,`,
 		`ValueBlob:` + valueToStringGenerated(this.ValueBlob) + `,`,
+		`Template:` + valueToStringGenerated(this.Template) + `,`,
+		`KeyDistribution:` + strings.Replace(this.KeyDistribution.String(), "GeneratorKeyDistribution", "GeneratorKeyDistribution", 1) + `,`,
+		`LateData:` + strings.Replace(this.LateData.String(), "GeneratorLateData", "GeneratorLateData", 1) + `,`,
+		`MaxMessages:` + valueToStringGenerated(this.MaxMessages) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10472,6 +13166,53 @@ func (this *GroupBy) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTPSink{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`Batch:` + strings.Replace(this.Batch.String(), "HTTPSinkBatch", "HTTPSinkBatch", 1) + `,`,
+		`Auth:` + strings.Replace(this.Auth.String(), "HTTPSinkAuth", "HTTPSinkAuth", 1) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPSinkAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPSinkAuth{`,
+		`Basic:` + strings.Replace(this.Basic.String(), "BasicAuth", "BasicAuth", 1) + `,`,
+		`Token:` + strings.Replace(fmt.Sprintf("%v", this.Token), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPSinkBatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPSinkBatch{`,
+		`MaxSize:` + valueToStringGenerated(this.MaxSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HTTPSource) String() string {
 	if this == nil {
 		return "nil"
@@ -10479,6 +13220,8 @@ func (this *HTTPSource) String() string {
 	s := strings.Join([]string{`&HTTPSource{`,
 		`Auth:` + strings.Replace(this.Auth.String(), "Authorization", "Authorization", 1) + `,`,
 		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`Synchronous:` + fmt.Sprintf("%v", this.Synchronous) + `,`,
+		`SyncTimeout:` + strings.Replace(fmt.Sprintf("%v", this.SyncTimeout), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10582,6 +13325,21 @@ func (this *JetStreamConfig) String() string {
 	}, "")
 	return s
 }
+func (this *JetStreamSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JetStreamSink{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Stream:` + fmt.Sprintf("%v", this.Stream) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`Auth:` + strings.Replace(this.Auth.String(), "NatsAuth", "NatsAuth", 1) + `,`,
+		`AckTimeout:` + strings.Replace(fmt.Sprintf("%v", this.AckTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *JetStreamSource) String() string {
 	if this == nil {
 		return "nil"
@@ -10591,6 +13349,12 @@ func (this *JetStreamSource) String() string {
 		`Stream:` + fmt.Sprintf("%v", this.Stream) + `,`,
 		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
 		`Auth:` + strings.Replace(this.Auth.String(), "NatsAuth", "NatsAuth", 1) + `,`,
+		`DeliverPolicy:` + fmt.Sprintf("%v", this.DeliverPolicy) + `,`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Time", "v11.Time", 1) + `,`,
+		`StartSequence:` + fmt.Sprintf("%v", this.StartSequence) + `,`,
+		`FilterSubjects:` + fmt.Sprintf("%v", this.FilterSubjects) + `,`,
+		`Durable:` + fmt.Sprintf("%v", this.Durable) + `,`,
+		`AckWait:` + strings.Replace(fmt.Sprintf("%v", this.AckWait), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10608,6 +13372,16 @@ func (this *JobTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *KafkaRewind) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaRewind{`,
+		`Timestamp:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Timestamp), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *KafkaSink) String() string {
 	if this == nil {
 		return "nil"
@@ -10618,6 +13392,35 @@ func (this *KafkaSink) String() string {
 		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
 		`Config:` + fmt.Sprintf("%v", this.Config) + `,`,
 		`SASL:` + strings.Replace(this.SASL.String(), "SASL", "SASL", 1) + `,`,
+		`Key:` + strings.Replace(this.Key.String(), "KafkaSinkKey", "KafkaSinkKey", 1) + `,`,
+		`ForwardHeaders:` + fmt.Sprintf("%v", this.ForwardHeaders) + `,`,
+		`Partitioner:` + fmt.Sprintf("%v", this.Partitioner) + `,`,
+		`TopicExpression:` + fmt.Sprintf("%v", this.TopicExpression) + `,`,
+		`AllowedTopics:` + fmt.Sprintf("%v", this.AllowedTopics) + `,`,
+		`Transaction:` + strings.Replace(this.Transaction.String(), "KafkaSinkTransaction", "KafkaSinkTransaction", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KafkaSinkKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaSinkKey{`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Separator:` + valueToStringGenerated(this.Separator) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KafkaSinkTransaction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaSinkTransaction{`,
+		`CheckpointTopic:` + fmt.Sprintf("%v", this.CheckpointTopic) + `,`,
+		`TransactionalIDPrefix:` + fmt.Sprintf("%v", this.TransactionalIDPrefix) + `,`,
+		`DeduplicationWindow:` + valueToStringGenerated(this.DeduplicationWindow) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10633,6 +13436,33 @@ func (this *KafkaSource) String() string {
 		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
 		`Config:` + fmt.Sprintf("%v", this.Config) + `,`,
 		`SASL:` + strings.Replace(this.SASL.String(), "SASL", "SASL", 1) + `,`,
+		`StartPosition:` + strings.Replace(this.StartPosition.String(), "KafkaStartPosition", "KafkaStartPosition", 1) + `,`,
+		`Rewind:` + strings.Replace(this.Rewind.String(), "KafkaRewind", "KafkaRewind", 1) + `,`,
+		`Topics:` + fmt.Sprintf("%v", this.Topics) + `,`,
+		`TopicPattern:` + fmt.Sprintf("%v", this.TopicPattern) + `,`,
+		`TopicRefreshInterval:` + strings.Replace(fmt.Sprintf("%v", this.TopicRefreshInterval), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KafkaStartPosition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForOffsets := make([]string, 0, len(this.Offsets))
+	for k := range this.Offsets {
+		keysForOffsets = append(keysForOffsets, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForOffsets)
+	mapStringForOffsets := "map[string]int64{"
+	for _, k := range keysForOffsets {
+		mapStringForOffsets += fmt.Sprintf("%v: %v,", k, this.Offsets[k])
+	}
+	mapStringForOffsets += "}"
+	s := strings.Join([]string{`&KafkaStartPosition{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Timestamp:` + strings.Replace(fmt.Sprintf("%v", this.Timestamp), "Time", "v11.Time", 1) + `,`,
+		`Offsets:` + mapStringForOffsets + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10719,6 +13549,19 @@ func (this *NatsAuth) String() string {
 	}, "")
 	return s
 }
+func (this *NatsSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NatsSink{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`Auth:` + strings.Replace(this.Auth.String(), "NatsAuth", "NatsAuth", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NatsSource) String() string {
 	if this == nil {
 		return "nil"
@@ -10852,6 +13695,18 @@ func (this *PipelineStatus) String() string {
 		`SourceCount:` + valueToStringGenerated(this.SourceCount) + `,`,
 		`SinkCount:` + valueToStringGenerated(this.SinkCount) + `,`,
 		`UDFCount:` + valueToStringGenerated(this.UDFCount) + `,`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RateLimit{`,
+		`MessagesPerSecond:` + fmt.Sprintf("%v", this.MessagesPerSecond) + `,`,
+		`BytesPerSecond:` + valueToStringGenerated(this.BytesPerSecond) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10895,6 +13750,21 @@ func (this *RedisSettings) String() string {
 	}, "")
 	return s
 }
+func (this *RedisStreamsSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RedisStreamsSource{`,
+		`RedisConfig:` + strings.Replace(strings.Replace(this.RedisConfig.String(), "RedisConfig", "RedisConfig", 1), `&`, ``, 1) + `,`,
+		`Stream:` + fmt.Sprintf("%v", this.Stream) + `,`,
+		`ConsumerGroup:` + fmt.Sprintf("%v", this.ConsumerGroup) + `,`,
+		`ReadFromBeginning:` + fmt.Sprintf("%v", this.ReadFromBeginning) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`ClaimMinIdleTime:` + strings.Replace(fmt.Sprintf("%v", this.ClaimMinIdleTime), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SASL) String() string {
 	if this == nil {
 		return "nil"
@@ -10921,6 +13791,38 @@ func (this *SASLPlain) String() string {
 	}, "")
 	return s
 }
+func (this *SQLSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForColumns := "[]SQLSinkColumn{"
+	for _, f := range this.Columns {
+		repeatedStringForColumns += strings.Replace(strings.Replace(f.String(), "SQLSinkColumn", "SQLSinkColumn", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForColumns += "}"
+	s := strings.Join([]string{`&SQLSink{`,
+		`Driver:` + fmt.Sprintf("%v", this.Driver) + `,`,
+		`DSN:` + strings.Replace(fmt.Sprintf("%v", this.DSN), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Table:` + fmt.Sprintf("%v", this.Table) + `,`,
+		`Columns:` + repeatedStringForColumns + `,`,
+		`KeyColumns:` + fmt.Sprintf("%v", this.KeyColumns) + `,`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`BatchSize:` + valueToStringGenerated(this.BatchSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SQLSinkColumn) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SQLSinkColumn{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Scale) String() string {
 	if this == nil {
 		return "nil"
@@ -10999,6 +13901,32 @@ func (this *Sink) String() string {
 	s := strings.Join([]string{`&Sink{`,
 		`AbstractSink:` + strings.Replace(strings.Replace(this.AbstractSink.String(), "AbstractSink", "AbstractSink", 1), `&`, ``, 1) + `,`,
 		`Fallback:` + strings.Replace(this.Fallback.String(), "AbstractSink", "AbstractSink", 1) + `,`,
+		`RetryStrategy:` + strings.Replace(this.RetryStrategy.String(), "SinkRetryStrategy", "SinkRetryStrategy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SinkRetryBackoff) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SinkRetryBackoff{`,
+		`Interval:` + strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v11.Duration", 1) + `,`,
+		`MaxInterval:` + strings.Replace(fmt.Sprintf("%v", this.MaxInterval), "Duration", "v11.Duration", 1) + `,`,
+		`Factor:` + fmt.Sprintf("%v", this.Factor) + `,`,
+		`JitterPercentage:` + valueToStringGenerated(this.JitterPercentage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SinkRetryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SinkRetryStrategy{`,
+		`MaxAttempts:` + valueToStringGenerated(this.MaxAttempts) + `,`,
+		`Backoff:` + strings.Replace(this.Backoff.String(), "SinkRetryBackoff", "SinkRetryBackoff", 1) + `,`,
+		`OnExhausted:` + fmt.Sprintf("%v", this.OnExhausted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11027,6 +13955,8 @@ func (this *Source) String() string {
 		`UDTransformer:` + strings.Replace(this.UDTransformer.String(), "UDTransformer", "UDTransformer", 1) + `,`,
 		`UDSource:` + strings.Replace(this.UDSource.String(), "UDSource", "UDSource", 1) + `,`,
 		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamSource", "JetStreamSource", 1) + `,`,
+		`File:` + strings.Replace(this.File.String(), "FileSource", "FileSource", 1) + `,`,
+		`RedisStreams:` + strings.Replace(this.RedisStreams.String(), "RedisStreamsSource", "RedisStreamsSource", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11133,6 +14063,7 @@ func (this *UDSource) String() string {
 	}
 	s := strings.Join([]string{`&UDSource{`,
 		`Container:` + strings.Replace(this.Container.String(), "Container", "Container", 1) + `,`,
+		`Bounded:` + fmt.Sprintf("%v", this.Bounded) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11181,6 +14112,7 @@ func (this *VertexLimits) String() string {
 		`ReadTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ReadTimeout), "Duration", "v11.Duration", 1) + `,`,
 		`BufferMaxLength:` + valueToStringGenerated(this.BufferMaxLength) + `,`,
 		`BufferUsageLimit:` + valueToStringGenerated(this.BufferUsageLimit) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "RateLimit", "RateLimit", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12018,35 +14950,215 @@ func (m *AbstractSink) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+			if m.HTTP == nil {
+				m.HTTP = &HTTPSink{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &FileSink{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nats == nil {
+				m.Nats = &NatsSink{}
+			}
+			if err := m.Nats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamSink{}
+			}
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SQL == nil {
+				m.SQL = &SQLSink{}
+			}
+			if err := m.SQL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
 			if iNdEx >= l {
@@ -14159,7 +17271,7 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FixedWindow) Unmarshal(dAtA []byte) error {
+func (m *FileSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14182,17 +17294,17 @@ func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FixedWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FixedWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14202,33 +17314,29 @@ func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Length == nil {
-				m.Length = &v11.Duration{}
-			}
-			if err := m.Length.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Volume = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Streaming", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14238,67 +17346,29 @@ func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Streaming = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ForwardConditions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForwardConditions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14308,81 +17378,59 @@ func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = &TagConditions{}
-			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Function) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Function: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Format = FileSinkFormat(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14410,11 +17458,11 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14442,11 +17490,11 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Compression = FileSinkCompression(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KWArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14473,103 +17521,12 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KWArgs == nil {
-				m.KWArgs = make(map[string]string)
+			if m.Rotation == nil {
+				m.Rotation = &FileSinkRotation{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Rotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.KWArgs[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14592,7 +17549,7 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GSSAPI) Unmarshal(dAtA []byte) error {
+func (m *FileSinkRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14615,17 +17572,17 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GSSAPI: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileSinkRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GSSAPI: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileSinkRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14635,29 +17592,33 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			if m.MaxSize == nil {
+				m.MaxSize = &resource.Quantity{}
+			}
+			if err := m.MaxSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14667,27 +17628,31 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Realm = string(dAtA[iNdEx:postIndex])
+			if m.Interval == nil {
+				m.Interval = &v11.Duration{}
+			}
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14714,51 +17679,68 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
+			if m.Window == nil {
+				m.Window = &v11.Duration{}
 			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := KRB5AuthType(dAtA[iNdEx:postIndex])
-			m.AuthType = &s
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14768,31 +17750,27 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeytabSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14819,16 +17797,35 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KeytabSecret == nil {
-				m.KeytabSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Follow = &b
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KerberosConfigSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14855,10 +17852,10 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KerberosConfigSecret == nil {
-				m.KerberosConfigSecret = &v1.SecretKeySelector{}
+			if m.PollInterval == nil {
+				m.PollInterval = &v11.Duration{}
 			}
-			if err := m.KerberosConfigSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PollInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14883,7 +17880,7 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
+func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14906,35 +17903,15 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeneratorSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: FixedWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeneratorSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FixedWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RPU", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RPU = &v
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14961,114 +17938,18 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Duration == nil {
-				m.Duration = &v11.Duration{}
+			if m.Length == nil {
+				m.Length = &v11.Duration{}
 			}
-			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Length.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSize", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.MsgSize = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.KeyCount = &v
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Value = &v
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Jitter == nil {
-				m.Jitter = &v11.Duration{}
-			}
-			if err := m.Jitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueBlob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streaming", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15078,25 +17959,12 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ValueBlob = &s
-			iNdEx = postIndex
+			m.Streaming = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15118,7 +17986,7 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
+func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15141,17 +18009,17 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDaemonDeploymentReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardConditions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDaemonDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardConditions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15161,59 +18029,81 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			if m.Tags == nil {
+				m.Tags = &TagConditions{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Function) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15241,47 +18131,13 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15291,78 +18147,27 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KWArgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15389,8 +18194,8 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.KWArgs == nil {
+				m.KWArgs = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -15485,13 +18290,63 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.KWArgs[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ClusterPort = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GSSAPI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GSSAPI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GSSAPI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15501,16 +18356,61 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Realm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
-			m.ClientPort = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15520,16 +18420,33 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientPort |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthType", wireType)
 			}
-			m.MonitorPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15539,16 +18456,30 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MonitorPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := KRB5AuthType(dAtA[iNdEx:postIndex])
+			m.AuthType = &s
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
-			m.MetricsPort = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15558,11 +18489,100 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MetricsPort |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeytabSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeytabSecret == nil {
+				m.KeytabSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KerberosConfigSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KerberosConfigSecret == nil {
+				m.KerberosConfigSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KerberosConfigSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15584,7 +18604,7 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
+func (m *GeneratorKeyDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15607,15 +18627,15 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GeneratorKeyDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GeneratorKeyDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15643,13 +18663,13 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			m.Type = GeneratorKeyDistributionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZipfExponent", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15659,124 +18679,49 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.ZipfExponent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotKeyCount", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NatsImage", wireType)
+			m.HotKeyCount = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotKeyPercentage", wireType)
 			}
-			var stringLen uint64
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15786,29 +18731,67 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.HotKeyPercentage = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NatsImage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneratorLateData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneratorLateData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneratorLateData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			m.Percentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15818,29 +18801,16 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Percentage |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigReloaderImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15850,29 +18820,80 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigReloaderImage = string(dAtA[iNdEx:postIndex])
+			if err := m.Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneratorSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneratorSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RPU", wireType)
 			}
-			m.ClusterPort = 0
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15882,16 +18903,17 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterPort |= int32(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
+			m.RPU = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			m.ClientPort = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15901,16 +18923,33 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientPort |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = &v11.Duration{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSize", wireType)
 			}
-			m.MonitorPort = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15920,16 +18959,17 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MonitorPort |= int32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			m.MsgSize = &v
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
 			}
-			m.MetricsPort = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15939,16 +18979,17 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MetricsPort |= int32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerAuthSecretName", wireType)
+			m.KeyCount = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15958,29 +18999,17 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServerAuthSecretName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			m.Value = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerEncryptionSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15990,27 +19019,31 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerEncryptionSecretName = string(dAtA[iNdEx:postIndex])
+			if m.Jitter == nil {
+				m.Jitter = &v11.Duration{}
+			}
+			if err := m.Jitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueBlob", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16038,11 +19071,12 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.ValueBlob = &s
 			iNdEx = postIndex
-		case 13:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16070,13 +19104,14 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Template = &s
 			iNdEx = postIndex
-		case 14:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartCommand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyDistribution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16086,27 +19121,31 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartCommand = string(dAtA[iNdEx:postIndex])
+			if m.KeyDistribution == nil {
+				m.KeyDistribution = &GeneratorKeyDistribution{}
+			}
+			if err := m.KeyDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LateData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16133,10 +19172,33 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LateData == nil {
+				m.LateData = &GeneratorLateData{}
+			}
+			if err := m.LateData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessages", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxMessages = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16158,7 +19220,7 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
+func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16181,17 +19243,17 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedisServiceSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDaemonDeploymentReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedisServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDaemonDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16201,124 +19263,29 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
+			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			m.RedisContainerPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16328,16 +19295,29 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedisContainerPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
 			}
-			m.SentinelContainerPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16347,33 +19327,113 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SentinelContainerPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
+func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16396,45 +19456,13 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
@@ -16561,11 +19589,11 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisImage", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
 			}
-			var stringLen uint64
+			m.ClusterPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16575,29 +19603,35 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ClusterPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ClientPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.RedisImage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelImage", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
 			}
-			var stringLen uint64
+			m.MonitorPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16607,29 +19641,16 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MonitorPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SentinelImage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
 			}
-			var stringLen uint64
+			m.MetricsPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16639,27 +19660,64 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MetricsPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16687,13 +19745,13 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitContainerImage = string(dAtA[iNdEx:postIndex])
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			m.RedisContainerPort = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16703,16 +19761,124 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedisContainerPort |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.SentinelContainerPort = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NatsImage", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16722,16 +19888,29 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SentinelContainerPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisMetricsContainerPort", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.RedisMetricsContainerPort = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NatsImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16741,14 +19920,27 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedisMetricsContainerPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigReloaderImage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16776,13 +19968,13 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialSecretName = string(dAtA[iNdEx:postIndex])
+			m.ConfigReloaderImage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
 			}
-			var v int
+			m.ClusterPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16792,17 +19984,16 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ClusterPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TLSEnabled = bool(v != 0)
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
 			}
-			var stringLen uint64
+			m.ClientPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16812,27 +20003,52 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ClientPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.MonitorPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonitorPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
 			}
-			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+			m.MetricsPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetricsPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerAuthSecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16860,11 +20076,11 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.ServerAuthSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScriptsConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerEncryptionSecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16892,11 +20108,11 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScriptsConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.ServerEncryptionSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16924,13 +20140,13 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16940,128 +20156,13 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetSideInputDeploymentReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSideInputDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			postIndex := iNdEx + intStringLen
@@ -17071,11 +20172,11 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartCommand", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17103,43 +20204,9 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StartCommand = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 15:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
 			}
@@ -17193,7 +20260,7 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
+func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17216,49 +20283,17 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetVertexPodSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRedisServiceSpecReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetVertexPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRedisServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17268,95 +20303,124 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SideInputsStoreName", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
 			}
-			var stringLen uint64
+			m.RedisContainerPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17366,29 +20430,16 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RedisContainerPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SideInputsStoreName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
 			}
-			var msglen int
+			m.SentinelContainerPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17398,25 +20449,11 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SentinelContainerPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17438,7 +20475,7 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupBy) Unmarshal(dAtA []byte) error {
+func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17461,17 +20498,17 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupBy: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupBy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17481,48 +20518,27 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keyed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Keyed = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLateness", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17549,49 +20565,4005 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllowedLateness == nil {
-				m.AllowedLateness = &v11.Duration{}
-			}
-			if err := m.AllowedLateness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Storage == nil {
-				m.Storage = &PBQStorage{}
-			}
-			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedisImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentinelImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitContainerImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
+			}
+			m.RedisContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedisContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
+			}
+			m.SentinelContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentinelContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisMetricsContainerPort", wireType)
+			}
+			m.RedisMetricsContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedisMetricsContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialSecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLSEnabled = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfConfigMapName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptsConfigMapName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptsConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthConfigMapName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSideInputDeploymentReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSideInputDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVertexPodSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVertexPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SideInputsStoreName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SideInputsStoreName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupBy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupBy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupBy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Keyed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLateness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllowedLateness == nil {
+				m.AllowedLateness = &v11.Duration{}
+			}
+			if err := m.AllowedLateness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Storage == nil {
+				m.Storage = &PBQStorage{}
+			}
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPSink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPSink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPSink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &HTTPSinkBatch{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &HTTPSinkAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &v11.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPSinkAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPSinkAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPSinkAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Basic == nil {
+				m.Basic = &BasicAuth{}
+			}
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &v1.SecretKeySelector{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPSinkBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPSinkBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPSinkBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxSize = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &Authorization{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Service = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchronous", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Synchronous = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncTimeout == nil {
+				m.SyncTimeout = &v11.Duration{}
+			}
+			if err := m.SyncTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdleSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdleSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdleSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Threshold == nil {
+				m.Threshold = &v11.Duration{}
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StepInterval == nil {
+				m.StepInterval = &v11.Duration{}
+			}
+			if err := m.StepInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncrementBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IncrementBy == nil {
+				m.IncrementBy = &v11.Duration{}
+			}
+			if err := m.IncrementBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterStepBufferService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterStepBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterStepBufferServiceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterStepBufferServiceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, InterStepBufferService{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterStepBufferServiceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterStepBufferServiceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Redis == nil {
+				m.Redis = &RedisBufferService{}
+			}
+			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamBufferService{}
+			}
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterStepBufferServiceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterStepBufferServiceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = ISBSvcPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = ISBSvcType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamBufferService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReloaderContainerTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReloaderContainerTemplate == nil {
+				m.ReloaderContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ReloaderContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MetricsContainerTemplate == nil {
+				m.MetricsContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Persistence == nil {
+				m.Persistence = &PersistenceStrategy{}
+			}
+			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Settings = &s
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartArgs = append(m.StartArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BufferConfig = &s
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Encryption = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLS = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &NatsAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLSEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JetStreamSink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamSink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamSink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &NatsAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AckTimeout == nil {
+				m.AckTimeout = &v11.Duration{}
+			}
+			if err := m.AckTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &NatsAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliverPolicy = JetStreamDeliverPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = &v11.Time{}
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSequence", wireType)
+			}
+			m.StartSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterSubjects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilterSubjects = append(m.FilterSubjects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Durable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckWait", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AckWait == nil {
+				m.AckWait = &v11.Duration{}
+			}
+			if err := m.AckWait.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLSecondsAfterFinished", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TTLSecondsAfterFinished = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BackoffLimit = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17613,7 +24585,7 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPSource) Unmarshal(dAtA []byte) error {
+func (m *KafkaRewind) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17636,15 +24608,15 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaRewind: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaRewind: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17671,33 +24643,10 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &Authorization{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Service = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17719,7 +24668,7 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IdleSource) Unmarshal(dAtA []byte) error {
+func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17742,17 +24691,17 @@ func (m *IdleSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdleSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdleSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17762,33 +24711,29 @@ func (m *IdleSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Threshold == nil {
-				m.Threshold = &v11.Duration{}
-			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StepInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17798,31 +24743,27 @@ func (m *IdleSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StepInterval == nil {
-				m.StepInterval = &v11.Duration{}
-			}
-			if err := m.StepInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncrementBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17849,66 +24790,48 @@ func (m *IdleSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IncrementBy == nil {
-				m.IncrementBy = &v11.Duration{}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
 			}
-			if err := m.IncrementBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferService: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17935,13 +24858,16 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SASL == nil {
+				m.SASL = &SASL{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17968,15 +24894,38 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Key == nil {
+				m.Key = &KafkaSinkKey{}
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardHeaders", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForwardHeaders = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitioner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17986,80 +24935,61 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Partitioner = KafkaPartitioner(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicExpression", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferServiceList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferServiceList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TopicExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedTopics", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18069,28 +24999,27 @@ func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedTopics = append(m.AllowedTopics, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18117,8 +25046,10 @@ func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, InterStepBufferService{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Transaction == nil {
+				m.Transaction = &KafkaSinkTransaction{}
+			}
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18143,7 +25074,7 @@ func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
+func (m *KafkaSinkKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18166,17 +25097,17 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferServiceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaSinkKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferServiceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaSinkKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18186,33 +25117,29 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Redis == nil {
-				m.Redis = &RedisBufferService{}
-			}
-			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Separator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18222,27 +25149,24 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JetStream == nil {
-				m.JetStream = &JetStreamBufferService{}
-			}
-			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Separator = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18265,7 +25189,7 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
+func (m *KafkaSinkTransaction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18288,48 +25212,15 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferServiceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaSinkTransaction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferServiceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaSinkTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTopic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18357,11 +25248,11 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = ISBSvcPhase(dAtA[iNdEx:postIndex])
+			m.CheckpointTopic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionalIDPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18389,46 +25280,13 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TransactionalIDPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeduplicationWindow", wireType)
 			}
-			var stringLen uint64
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18438,24 +25296,12 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = ISBSvcType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DeduplicationWindow = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18477,7 +25323,7 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
+func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18500,15 +25346,15 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamBufferService: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18536,13 +25382,13 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18552,17 +25398,29 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroupName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18572,31 +25430,27 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerGroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReloaderContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18623,18 +25477,18 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReloaderContainerTemplate == nil {
-				m.ReloaderContainerTemplate = &ContainerTemplate{}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
 			}
-			if err := m.ReloaderContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18644,31 +25498,27 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetricsContainerTemplate == nil {
-				m.MetricsContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Config = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18695,16 +25545,16 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Persistence == nil {
-				m.Persistence = &PersistenceStrategy{}
+			if m.SASL == nil {
+				m.SASL = &SASL{}
 			}
-			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPosition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18731,15 +25581,18 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StartPosition == nil {
+				m.StartPosition = &KafkaStartPosition{}
+			}
+			if err := m.StartPosition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewind", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18749,28 +25602,31 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Settings = &s
+			if m.Rewind == nil {
+				m.Rewind = &KafkaRewind{}
+			}
+			if err := m.Rewind.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18798,11 +25654,11 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartArgs = append(m.StartArgs, string(dAtA[iNdEx:postIndex]))
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicPattern", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18830,14 +25686,13 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BufferConfig = &s
+			m.TopicPattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRefreshInterval", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18847,32 +25702,28 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Encryption = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.TLS = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TopicRefreshInterval == nil {
+				m.TopicRefreshInterval = &v11.Duration{}
+			}
+			if err := m.TopicRefreshInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18894,7 +25745,7 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
+func (m *KafkaStartPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18917,15 +25768,15 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaStartPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaStartPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18953,11 +25804,11 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Type = KafkaStartPositionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18984,18 +25835,18 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &NatsAuth{}
+			if m.Timestamp == nil {
+				m.Timestamp = &v11.Time{}
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19005,44 +25856,105 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StreamConfig = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
+			if m.Offsets == nil {
+				m.Offsets = make(map[string]int64)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.TLSEnabled = bool(v != 0)
+			m.Offsets[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19064,7 +25976,7 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
+func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19087,17 +25999,17 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: Lifecycle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Lifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteGracePeriodSeconds", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19107,27 +26019,15 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DeleteGracePeriodSeconds = &v
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19155,13 +26055,13 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
+			m.DesiredPhase = PipelinePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseGracePeriodSeconds", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19171,64 +26071,62 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.PauseGracePeriodSeconds = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Log) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &NatsAuth{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Log: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19250,7 +26148,7 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobTemplate) Unmarshal(dAtA []byte) error {
+func (m *Metadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19273,15 +26171,15 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19298,23 +26196,117 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19341,53 +26333,104 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTLSecondsAfterFinished", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TTLSecondsAfterFinished = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.BackoffLimit = &v
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19409,7 +26452,7 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KafkaSink) Unmarshal(dAtA []byte) error {
+func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19432,15 +26475,15 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaSink: wiretype end group for non-group")
+			return fmt.Errorf("proto: NativeRedis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaSink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NativeRedis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19468,81 +26511,13 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19552,27 +26527,15 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.Replicas = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19599,68 +26562,18 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SASL == nil {
-				m.SASL = &SASL{}
+			if m.RedisContainerTemplate == nil {
+				m.RedisContainerTemplate = &ContainerTemplate{}
 			}
-			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedisContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KafkaSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19670,29 +26583,33 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			if m.SentinelContainerTemplate == nil {
+				m.SentinelContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.SentinelContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19702,29 +26619,33 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			if m.MetricsContainerTemplate == nil {
+				m.MetricsContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19734,27 +26655,31 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerGroupName = string(dAtA[iNdEx:postIndex])
+			if m.InitContainerTemplate == nil {
+				m.InitContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19781,18 +26706,18 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
+			if m.Persistence == nil {
+				m.Persistence = &PersistenceStrategy{}
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19802,27 +26727,28 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19849,10 +26775,10 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SASL == nil {
-				m.SASL = &SASL{}
+			if m.Settings == nil {
+				m.Settings = &RedisSettings{}
 			}
-			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Settings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19877,7 +26803,7 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Lifecycle) Unmarshal(dAtA []byte) error {
+func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19900,17 +26826,17 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Lifecycle: wiretype end group for non-group")
+			return fmt.Errorf("proto: NatsAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Lifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NatsAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteGracePeriodSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19920,17 +26846,33 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeleteGracePeriodSeconds = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Basic == nil {
+				m.Basic = &BasicAuth{}
+			}
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19940,29 +26882,33 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DesiredPhase = PipelinePhase(dAtA[iNdEx:postIndex])
+			if m.Token == nil {
+				m.Token = &v1.SecretKeySelector{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PauseGracePeriodSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NKey", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19972,62 +26918,28 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PauseGracePeriodSeconds = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Log) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.NKey == nil {
+				m.NKey = &v1.SecretKeySelector{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.NKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Log: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20049,7 +26961,7 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *NatsSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20072,17 +26984,17 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: NatsSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NatsSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20092,122 +27004,59 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20234,103 +27083,48 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &NatsAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20353,7 +27147,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NativeRedis) Unmarshal(dAtA []byte) error {
+func (m *NatsSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20376,15 +27170,15 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NativeRedis: wiretype end group for non-group")
+			return fmt.Errorf("proto: NatsSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NativeRedis: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NatsSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20412,13 +27206,13 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20428,15 +27222,59 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20463,16 +27301,16 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RedisContainerTemplate == nil {
-				m.RedisContainerTemplate = &ContainerTemplate{}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
 			}
-			if err := m.RedisContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20499,16 +27337,152 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SentinelContainerTemplate == nil {
-				m.SentinelContainerTemplate = &ContainerTemplate{}
+			if m.Auth == nil {
+				m.Auth = &NatsAuth{}
 			}
-			if err := m.SentinelContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoStore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoStore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoStore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PBQStorage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PBQStorage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PBQStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PersistentVolumeClaim == nil {
+				m.PersistentVolumeClaim = &PersistenceStrategy{}
+			}
+			if err := m.PersistentVolumeClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyDir", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20535,16 +27509,16 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetricsContainerTemplate == nil {
-				m.MetricsContainerTemplate = &ContainerTemplate{}
+			if m.EmptyDir == nil {
+				m.EmptyDir = &v1.EmptyDirVolumeSource{}
 			}
-			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EmptyDir.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoStore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20571,18 +27545,68 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitContainerTemplate == nil {
-				m.InitContainerTemplate = &ContainerTemplate{}
+			if m.NoStore == nil {
+				m.NoStore = &NoStore{}
 			}
-			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NoStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PersistenceStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PersistenceStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageClassName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20592,33 +27616,30 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Persistence == nil {
-				m.Persistence = &PersistenceStrategy{}
-			}
-			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.StorageClassName = &s
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessMode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20628,28 +27649,28 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := k8s_io_api_core_v1.PersistentVolumeAccessMode(dAtA[iNdEx:postIndex])
+			m.AccessMode = &s
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeSize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20676,10 +27697,10 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Settings == nil {
-				m.Settings = &RedisSettings{}
+			if m.VolumeSize == nil {
+				m.VolumeSize = &resource.Quantity{}
 			}
-			if err := m.Settings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VolumeSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20704,7 +27725,7 @@ func (m *NativeRedis) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NatsAuth) Unmarshal(dAtA []byte) error {
+func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20727,15 +27748,15 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NatsAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NatsAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20762,16 +27783,13 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Basic == nil {
-				m.Basic = &BasicAuth{}
-			}
-			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20798,16 +27816,13 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &v1.SecretKeySelector{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20834,10 +27849,7 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NKey == nil {
-				m.NKey = &v1.SecretKeySelector{}
-			}
-			if err := m.NKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20862,7 +27874,7 @@ func (m *NatsAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NatsSource) Unmarshal(dAtA []byte) error {
+func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20885,17 +27897,17 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NatsSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NatsSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBatchSize", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20905,29 +27917,17 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ReadBatchSize = &v
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferMaxLength", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20937,61 +27937,17 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.BufferMaxLength = &v
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferUsageLimit", wireType)
 			}
-			var msglen int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21001,31 +27957,15 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+			m.BufferUsageLimit = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21052,10 +27992,10 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &NatsAuth{}
+			if m.ReadTimeout == nil {
+				m.ReadTimeout = &v11.Duration{}
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReadTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21080,7 +28020,7 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NoStore) Unmarshal(dAtA []byte) error {
+func (m *PipelineList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21103,12 +28043,79 @@ func (m *NoStore) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NoStore: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoStore: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Pipeline{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21130,7 +28137,7 @@ func (m *NoStore) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PBQStorage) Unmarshal(dAtA []byte) error {
+func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21153,15 +28160,47 @@ func (m *PBQStorage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PBQStorage: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PBQStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterStepBufferServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterStepBufferServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21188,16 +28227,14 @@ func (m *PBQStorage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PersistentVolumeClaim == nil {
-				m.PersistentVolumeClaim = &PersistenceStrategy{}
-			}
-			if err := m.PersistentVolumeClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vertices = append(m.Vertices, AbstractVertex{})
+			if err := m.Vertices[len(m.Vertices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmptyDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21224,16 +28261,14 @@ func (m *PBQStorage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EmptyDir == nil {
-				m.EmptyDir = &v1.EmptyDirVolumeSource{}
-			}
-			if err := m.EmptyDir.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Edges = append(m.Edges, Edge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoStore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21260,68 +28295,51 @@ func (m *PBQStorage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NoStore == nil {
-				m.NoStore = &NoStore{}
-			}
-			if err := m.NoStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Limits == nil {
+				m.Limits = &PipelineLimits{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PersistenceStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PersistenceStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Watermark", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21331,30 +28349,30 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.StorageClassName = &s
+			if err := m.Watermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessMode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21364,28 +28382,31 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := k8s_io_api_core_v1.PersistentVolumeAccessMode(dAtA[iNdEx:postIndex])
-			m.AccessMode = &s
+			if m.Templates == nil {
+				m.Templates = &Templates{}
+			}
+			if err := m.Templates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SideInputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21412,10 +28433,8 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VolumeSize == nil {
-				m.VolumeSize = &resource.Quantity{}
-			}
-			if err := m.VolumeSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SideInputs = append(m.SideInputs, SideInput{})
+			if err := m.SideInputs[len(m.SideInputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21440,7 +28459,7 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pipeline) Unmarshal(dAtA []byte) error {
+func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21463,15 +28482,15 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21498,15 +28517,15 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21516,28 +28535,59 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = PipelinePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21564,10 +28614,109 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexCount", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VertexCount = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCount", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SourceCount = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinkCount", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SinkCount = &v
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UDFCount", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UDFCount = &v
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21589,7 +28738,7 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21612,17 +28761,17 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineLimits: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadBatchSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesPerSecond", wireType)
 			}
-			var v uint64
+			m.MessagesPerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21632,15 +28781,14 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				m.MessagesPerSecond |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadBatchSize = &v
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferMaxLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerSecond", wireType)
 			}
 			var v uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21657,63 +28805,7 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.BufferMaxLength = &v
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferUsageLimit", wireType)
-			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BufferUsageLimit = &v
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ReadTimeout == nil {
-				m.ReadTimeout = &v11.Duration{}
-			}
-			if err := m.ReadTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.BytesPerSecond = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21735,7 +28827,7 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineList) Unmarshal(dAtA []byte) error {
+func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21758,15 +28850,15 @@ func (m *PipelineList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineList: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisBufferService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Native", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21793,13 +28885,16 @@ func (m *PipelineList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Native == nil {
+				m.Native = &NativeRedis{}
+			}
+			if err := m.Native.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field External", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21826,8 +28921,10 @@ func (m *PipelineList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Pipeline{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.External == nil {
+				m.External = &RedisConfig{}
+			}
+			if err := m.External.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21852,7 +28949,7 @@ func (m *PipelineList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
+func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21875,15 +28972,15 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterStepBufferServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21911,13 +29008,13 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterStepBufferServiceName = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vertices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21927,31 +29024,29 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vertices = append(m.Vertices, AbstractVertex{})
-			if err := m.Vertices[len(m.Vertices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SentinelURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21961,31 +29056,29 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Edges = append(m.Edges, Edge{})
-			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MasterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21995,28 +29088,27 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22043,16 +29135,16 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limits == nil {
-				m.Limits = &PipelineLimits{}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
 			}
-			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Watermark", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelPassword", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22079,15 +29171,68 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Watermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SentinelPassword == nil {
+				m.SentinelPassword = &v1.SecretKeySelector{}
+			}
+			if err := m.SentinelPassword.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedisSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedisSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedisSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22097,33 +29242,61 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Templates == nil {
-				m.Templates = &Templates{}
+			m.Redis = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
-			if err := m.Templates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Master = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SideInputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22133,25 +29306,55 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SideInputs = append(m.SideInputs, SideInput{})
-			if err := m.SideInputs[len(m.SideInputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Replica = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sentinel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Sentinel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22174,7 +29377,7 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
+func (m *RedisStreamsSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22197,50 +29400,17 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisStreamsSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			return fmt.Errorf("proto: RedisStreamsSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22250,27 +29420,28 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = PipelinePhase(dAtA[iNdEx:postIndex])
+			if err := m.RedisConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22298,13 +29469,13 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Stream = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22314,90 +29485,29 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerGroup = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VertexCount", wireType)
-			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.VertexCount = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCount", wireType)
-			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SourceCount = &v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinkCount", wireType)
-			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SinkCount = &v
-		case 8:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UDFCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadFromBeginning", wireType)
 			}
-			var v uint32
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22407,65 +29517,15 @@ func (m *PipelineStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UDFCount = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RedisBufferService: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ReadFromBeginning = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Native", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22492,16 +29552,16 @@ func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Native == nil {
-				m.Native = &NativeRedis{}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
 			}
-			if err := m.Native.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field External", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimMinIdleTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22528,10 +29588,10 @@ func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.External == nil {
-				m.External = &RedisConfig{}
+			if m.ClaimMinIdleTime == nil {
+				m.ClaimMinIdleTime = &v11.Duration{}
 			}
-			if err := m.External.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClaimMinIdleTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22556,7 +29616,7 @@ func (m *RedisBufferService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedisConfig) Unmarshal(dAtA []byte) error {
+func (m *SASL) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22579,15 +29639,15 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedisConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: SASL: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SASL: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22615,13 +29675,14 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			s := SASLType(dAtA[iNdEx:postIndex])
+			m.Mechanism = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GSSAPI", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22631,29 +29692,33 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SentinelURL = string(dAtA[iNdEx:postIndex])
+			if m.GSSAPI == nil {
+				m.GSSAPI = &GSSAPI{}
+			}
+			if err := m.GSSAPI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plain", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22663,59 +29728,31 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Plain == nil {
+				m.Plain = &SASLPlain{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Plain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SCRAMSHA256", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22742,16 +29779,16 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
+			if m.SCRAMSHA256 == nil {
+				m.SCRAMSHA256 = &SASLPlain{}
 			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SCRAMSHA256.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SCRAMSHA512", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22778,10 +29815,10 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SentinelPassword == nil {
-				m.SentinelPassword = &v1.SecretKeySelector{}
+			if m.SCRAMSHA512 == nil {
+				m.SCRAMSHA512 = &SASLPlain{}
 			}
-			if err := m.SentinelPassword.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SCRAMSHA512.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22806,7 +29843,7 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedisSettings) Unmarshal(dAtA []byte) error {
+func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22829,17 +29866,17 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedisSettings: wiretype end group for non-group")
+			return fmt.Errorf("proto: SASLPlain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SASLPlain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22849,29 +29886,33 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Redis = string(dAtA[iNdEx:postIndex])
+			if m.UserSecret == nil {
+				m.UserSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UserSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22881,61 +29922,33 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Master = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Replica = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sentinel", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handshake", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22945,24 +29958,12 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sentinel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Handshake = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22984,7 +29985,7 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SASL) Unmarshal(dAtA []byte) error {
+func (m *SQLSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23007,15 +30008,15 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SASL: wiretype end group for non-group")
+			return fmt.Errorf("proto: SQLSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SASL: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SQLSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23043,12 +30044,11 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := SASLType(dAtA[iNdEx:postIndex])
-			m.Mechanism = &s
+			m.Driver = SQLSinkDriver(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GSSAPI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DSN", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23075,18 +30075,18 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GSSAPI == nil {
-				m.GSSAPI = &GSSAPI{}
+			if m.DSN == nil {
+				m.DSN = &v1.SecretKeySelector{}
 			}
-			if err := m.GSSAPI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DSN.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23096,31 +30096,27 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plain == nil {
-				m.Plain = &SASLPlain{}
-			}
-			if err := m.Plain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SCRAMSHA256", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23147,18 +30143,16 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SCRAMSHA256 == nil {
-				m.SCRAMSHA256 = &SASLPlain{}
-			}
-			if err := m.SCRAMSHA256.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Columns = append(m.Columns, SQLSinkColumn{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SCRAMSHA512", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyColumns", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23168,28 +30162,76 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SCRAMSHA512 == nil {
-				m.SCRAMSHA512 = &SASLPlain{}
+			m.KeyColumns = append(m.KeyColumns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			if err := m.SCRAMSHA512.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Mode = SQLSinkMode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchSize = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23211,7 +30253,7 @@ func (m *SASL) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SASLPlain) Unmarshal(dAtA []byte) error {
+func (m *SQLSinkColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23234,17 +30276,17 @@ func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SASLPlain: wiretype end group for non-group")
+			return fmt.Errorf("proto: SQLSinkColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SASLPlain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SQLSinkColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23254,33 +30296,29 @@ func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserSecret == nil {
-				m.UserSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UserSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23290,48 +30328,24 @@ func (m *SASLPlain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Handshake", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Handshake = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23526,7 +30540,255 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TargetBufferAvailability", wireType)
 			}
-			var v uint32
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TargetBufferAvailability = &v
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicasPerScale", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReplicasPerScale = &v
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleUpCooldownSeconds", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScaleUpCooldownSeconds = &v
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownCooldownSeconds", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScaleDownCooldownSeconds = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &v11.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SideInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SideInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SideInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23536,37 +30798,33 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TargetBufferAvailability = &v
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicasPerScale", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ReplicasPerScale = &v
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleUpCooldownSeconds", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v uint32
+			if m.Container == nil {
+				m.Container = &Container{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23576,17 +30834,31 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ScaleUpCooldownSeconds = &v
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownCooldownSeconds", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v uint32
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23596,12 +30868,28 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ScaleDownCooldownSeconds = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trigger == nil {
+				m.Trigger = &SideInputTrigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23623,7 +30911,7 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionWindow) Unmarshal(dAtA []byte) error {
+func (m *SideInputTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23646,17 +30934,17 @@ func (m *SessionWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: SideInputTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SideInputTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23666,27 +30954,56 @@ func (m *SessionWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timeout == nil {
-				m.Timeout = &v11.Duration{}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
 			}
-			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Timezone = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23709,7 +31026,7 @@ func (m *SessionWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SideInput) Unmarshal(dAtA []byte) error {
+func (m *SideInputsManagerTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23732,47 +31049,15 @@ func (m *SideInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SideInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: SideInputsManagerTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SideInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SideInputsManagerTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23799,16 +31084,13 @@ func (m *SideInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &Container{}
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23835,14 +31117,16 @@ func (m *SideInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23869,10 +31153,10 @@ func (m *SideInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Trigger == nil {
-				m.Trigger = &SideInputTrigger{}
+			if m.InitContainerTemplate == nil {
+				m.InitContainerTemplate = &ContainerTemplate{}
 			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23897,7 +31181,7 @@ func (m *SideInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SideInputTrigger) Unmarshal(dAtA []byte) error {
+func (m *Sink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23907,30 +31191,63 @@ func (m *SideInputTrigger) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractSink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AbstractSink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SideInputTrigger: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SideInputTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23940,29 +31257,33 @@ func (m *SideInputTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
+			if m.Fallback == nil {
+				m.Fallback = &AbstractSink{}
+			}
+			if err := m.Fallback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23972,24 +31293,27 @@ func (m *SideInputTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Timezone = &s
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &SinkRetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24012,7 +31336,7 @@ func (m *SideInputTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SideInputsManagerTemplate) Unmarshal(dAtA []byte) error {
+func (m *SinkRetryBackoff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24035,15 +31359,15 @@ func (m *SideInputsManagerTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SideInputsManagerTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: SinkRetryBackoff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SideInputsManagerTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SinkRetryBackoff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24070,13 +31394,16 @@ func (m *SideInputsManagerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Interval == nil {
+				m.Interval = &v11.Duration{}
+			}
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24103,18 +31430,18 @@ func (m *SideInputsManagerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
+			if m.MaxInterval == nil {
+				m.MaxInterval = &v11.Duration{}
 			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24124,28 +31451,44 @@ func (m *SideInputsManagerTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitContainerTemplate == nil {
-				m.InitContainerTemplate = &ContainerTemplate{}
+			m.Factor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JitterPercentage", wireType)
 			}
-			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.JitterPercentage = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24167,7 +31510,7 @@ func (m *SideInputsManagerTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sink) Unmarshal(dAtA []byte) error {
+func (m *SinkRetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24190,15 +31533,35 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sink: wiretype end group for non-group")
+			return fmt.Errorf("proto: SinkRetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SinkRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxAttempts = &v
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractSink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24225,15 +31588,18 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractSink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Backoff == nil {
+				m.Backoff = &SinkRetryBackoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnExhausted", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24243,27 +31609,23 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fallback == nil {
-				m.Fallback = &AbstractSink{}
-			}
-			if err := m.Fallback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OnExhausted = SinkRetryExhaustedAction(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24709,6 +32071,78 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &FileSource{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RedisStreams == nil {
+				m.RedisStreams = &RedisStreamsSource{}
+			}
+			if err := m.RedisStreams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25848,6 +33282,26 @@ func (m *UDSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Bounded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26402,6 +33856,42 @@ func (m *VertexLimits) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.BufferUsageLimit = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17,6 +17,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"strconv"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -50,4 +53,90 @@ type GeneratorSource struct {
 	// if present, the Value and MsgSize fields will be ignored.
 	// +optional
 	ValueBlob *string `json:"valueBlob,omitempty" protobuf:"bytes,7,opt,name=valueBlob"`
+	// Template is a JSON template of the payload, the string values such as "{{int 1 100}}" or "{{uuid}}" are
+	// replaced by the random values of the types, check the documentation for the supported types.
+	// If present, the Value, MsgSize and ValueBlob fields will be ignored.
+	// +optional
+	Template *string `json:"template,omitempty" protobuf:"bytes,8,opt,name=template"`
+	// KeyDistribution is how the keys of the messages are picked out of the KeyCount keys, defaults to round robin.
+	// +optional
+	KeyDistribution *GeneratorKeyDistribution `json:"keyDistribution,omitempty" protobuf:"bytes,9,opt,name=keyDistribution"`
+	// LateData sets the event time of a percentage of the messages back by a delay, used to simulate late messages.
+	// +optional
+	LateData *GeneratorLateData `json:"lateData,omitempty" protobuf:"bytes,10,opt,name=lateData"`
+	// MaxMessages is the number of messages each replica generates before its input is exhausted, which makes the
+	// generator a bounded source. It generates messages forever if not set.
+	// +optional
+	MaxMessages *int64 `json:"maxMessages,omitempty" protobuf:"varint,11,opt,name=maxMessages"`
+}
+
+// +kubebuilder:validation:Enum="";roundRobin;uniform;zipf;hotKey
+type GeneratorKeyDistributionType string
+
+const (
+	GeneratorKeyDistributionRoundRobin GeneratorKeyDistributionType = "roundRobin"
+	GeneratorKeyDistributionUniform    GeneratorKeyDistributionType = "uniform"
+	GeneratorKeyDistributionZipf       GeneratorKeyDistributionType = "zipf"
+	GeneratorKeyDistributionHotKey     GeneratorKeyDistributionType = "hotKey"
+)
+
+// GeneratorKeyDistribution describes how the keys of the generated messages are distributed.
+type GeneratorKeyDistribution struct {
+	// Type of the distribution, one of roundRobin, uniform, zipf and hotKey, defaults to roundRobin.
+	// +optional
+	Type GeneratorKeyDistributionType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,casttype=GeneratorKeyDistributionType"`
+	// ZipfExponent is the exponent of the zipf distribution, which must be greater than 1, defaults to "1.1".
+	// The larger it is, the more the messages are skewed to the first keys.
+	// +optional
+	ZipfExponent string `json:"zipfExponent,omitempty" protobuf:"bytes,2,opt,name=zipfExponent"`
+	// HotKeyCount is the number of the hot keys of the hotKey distribution, defaults to 1.
+	// +optional
+	HotKeyCount *int32 `json:"hotKeyCount,omitempty" protobuf:"varint,3,opt,name=hotKeyCount"`
+	// HotKeyPercentage is the percentage of the messages with the hot keys of the hotKey distribution, defaults to 80.
+	// +optional
+	HotKeyPercentage *uint32 `json:"hotKeyPercentage,omitempty" protobuf:"varint,4,opt,name=hotKeyPercentage"`
+}
+
+func (kd GeneratorKeyDistribution) GetType() GeneratorKeyDistributionType {
+	if kd.Type == "" {
+		return GeneratorKeyDistributionRoundRobin
+	}
+	return kd.Type
+}
+
+func (kd GeneratorKeyDistribution) GetZipfExponent() (float64, error) {
+	if kd.ZipfExponent == "" {
+		return 1.1, nil
+	}
+	s, err := strconv.ParseFloat(kd.ZipfExponent, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid zipf exponent %q, %w", kd.ZipfExponent, err)
+	}
+	if s <= 1 {
+		return 0, fmt.Errorf("invalid zipf exponent %q, it must be greater than 1", kd.ZipfExponent)
+	}
+	return s, nil
+}
+
+func (kd GeneratorKeyDistribution) GetHotKeyCount() int32 {
+	if kd.HotKeyCount != nil {
+		return *kd.HotKeyCount
+	}
+	return 1
+}
+
+func (kd GeneratorKeyDistribution) GetHotKeyPercentage() uint32 {
+	if kd.HotKeyPercentage != nil {
+		return *kd.HotKeyPercentage
+	}
+	return 80
+}
+
+// GeneratorLateData describes the late messages of the generator.
+type GeneratorLateData struct {
+	// Percentage of the messages which are late, from 0 to 100.
+	Percentage uint32 `json:"percentage" protobuf:"varint,1,opt,name=percentage"`
+	// Delay is how far the event time of the late messages is set back. To have the late messages dropped by a
+	// reduce vertex, it should be longer than the allowed lateness of the vertex.
+	Delay metav1.Duration `json:"delay" protobuf:"bytes,2,opt,name=delay"`
 }
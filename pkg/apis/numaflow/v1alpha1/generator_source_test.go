@@ -0,0 +1,47 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+)
+
+func TestGeneratorKeyDistribution(t *testing.T) {
+	kd := GeneratorKeyDistribution{}
+	assert.Equal(t, GeneratorKeyDistributionRoundRobin, kd.GetType())
+	s, err := kd.GetZipfExponent()
+	assert.NoError(t, err)
+	assert.Equal(t, 1.1, s)
+	assert.Equal(t, int32(1), kd.GetHotKeyCount())
+	assert.Equal(t, uint32(80), kd.GetHotKeyPercentage())
+
+	kd = GeneratorKeyDistribution{Type: GeneratorKeyDistributionHotKey, ZipfExponent: "2", HotKeyCount: ptr.To[int32](3), HotKeyPercentage: ptr.To[uint32](95)}
+	assert.Equal(t, GeneratorKeyDistributionHotKey, kd.GetType())
+	s, err = kd.GetZipfExponent()
+	assert.NoError(t, err)
+	assert.Equal(t, 2.0, s)
+	assert.Equal(t, int32(3), kd.GetHotKeyCount())
+	assert.Equal(t, uint32(95), kd.GetHotKeyPercentage())
+
+	for _, v := range []string{"1", "0.5", "abc"} {
+		_, err = GeneratorKeyDistribution{ZipfExponent: v}.GetZipfExponent()
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type HTTPSink struct {
+	// URL of the endpoint to send the messages to. The expressions enclosed in "{{" and "}}" are evaluated against
+	// each message, the message payload, keys and headers can be accessed as "payload", "keys" and "headers",
+	// e.g. "https://example.com/tenants/{{json(payload).tenant}}/events".
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Method of the requests, defaults to POST.
+	// +kubebuilder:validation:Enum="";POST;PUT;PATCH
+	// +optional
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// Headers added to the requests.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+	// Batch sends the messages with the same URL in one request, as a JSON array of their payloads.
+	// If it's not specified, each message is sent in its own request.
+	// +optional
+	Batch *HTTPSinkBatch `json:"batch,omitempty" protobuf:"bytes,4,opt,name=batch"`
+	// Auth information
+	// +optional
+	Auth *HTTPSinkAuth `json:"auth,omitempty" protobuf:"bytes,5,opt,name=auth"`
+	// TLS configuration for the http client, the cert and the key are used for mTLS.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,6,opt,name=tls"`
+	// Timeout of the requests, defaults to 30s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,7,opt,name=timeout"`
+}
+
+func (hs HTTPSink) GetMethod() string {
+	if hs.Method == "" {
+		return http.MethodPost
+	}
+	return hs.Method
+}
+
+func (hs HTTPSink) GetTimeout() time.Duration {
+	if hs.Timeout != nil && hs.Timeout.Duration > 0 {
+		return hs.Timeout.Duration
+	}
+	return DefaultHTTPSinkTimeout
+}
+
+// HTTPSinkBatch defines how the messages are batched in the requests.
+type HTTPSinkBatch struct {
+	// MaxSize is the maximum number of the messages in a request, defaults to the read batch size.
+	// +optional
+	MaxSize *uint32 `json:"maxSize,omitempty" protobuf:"varint,1,opt,name=maxSize"`
+}
+
+// HTTPSinkAuth defines the authentication of the requests, only one of them can be specified.
+type HTTPSinkAuth struct {
+	// Basic auth which contains a user name and a password
+	// +optional
+	Basic *BasicAuth `json:"basic,omitempty" protobuf:"bytes,1,opt,name=basic"`
+	// A secret selector which contains the bearer token, it's sent with the header "Authorization: Bearer <token>"
+	// +optional
+	Token *corev1.SecretKeySelector `json:"token,omitempty" protobuf:"bytes,2,opt,name=token"`
+}
@@ -16,7 +16,12 @@ limitations under the License.
 
 package v1alpha1
 
-import corev1 "k8s.io/api/core/v1"
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 type HTTPSource struct {
 	// +optional
@@ -24,6 +29,21 @@ type HTTPSource struct {
 	// Whether to create a ClusterIP Service
 	// +optional
 	Service bool `json:"service" protobuf:"bytes,2,opt,name=service"`
+	// Whether to respond to the requests after the messages are written to the inter-step buffer.
+	// If it's enabled, a request returns 200 with the message ID when the message is written,
+	// 429 when the source is full, or 503 when the message is not written within the SyncTimeout.
+	// +optional
+	Synchronous bool `json:"synchronous,omitempty" protobuf:"varint,3,opt,name=synchronous"`
+	// How long a synchronous request waits for the message to be written, defaults to 30s.
+	// +optional
+	SyncTimeout *metav1.Duration `json:"syncTimeout,omitempty" protobuf:"bytes,4,opt,name=syncTimeout"`
+}
+
+func (hs HTTPSource) GetSyncTimeout() time.Duration {
+	if hs.SyncTimeout != nil && hs.SyncTimeout.Duration > 0 {
+		return hs.SyncTimeout.Duration
+	}
+	return DefaultHTTPSourceSyncTimeout
 }
 
 type Authorization struct {
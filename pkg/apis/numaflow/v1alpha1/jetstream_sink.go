@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type JetStreamSink struct {
+	// URL to connect to NATS cluster, multiple urls could be separated by comma.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Subject to publish the messages to, which should be bound to a stream. The expressions enclosed in "{{" and "}}"
+	// are evaluated against each message, the message payload, keys and headers can be accessed as "payload", "keys"
+	// and "headers", e.g. "orders.{{json(payload).region}}".
+	Subject string `json:"subject" protobuf:"bytes,2,opt,name=subject"`
+	// Stream is the name of the stream expected to store the messages, a message published to a subject of
+	// another stream is rejected.
+	// +optional
+	Stream string `json:"stream,omitempty" protobuf:"bytes,3,opt,name=stream"`
+	// TLS configuration for the nats client.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,4,opt,name=tls"`
+	// Auth information
+	// +optional
+	Auth *NatsAuth `json:"auth,omitempty" protobuf:"bytes,5,opt,name=auth"`
+	// AckTimeout is how long to wait for the acks of the published messages, defaults to 30s.
+	// +optional
+	AckTimeout *metav1.Duration `json:"ackTimeout,omitempty" protobuf:"bytes,6,opt,name=ackTimeout"`
+}
+
+func (js JetStreamSink) GetAckTimeout() time.Duration {
+	if js.AckTimeout != nil && js.AckTimeout.Duration > 0 {
+		return js.AckTimeout.Duration
+	}
+	return DefaultJetStreamSinkAckTimeout
+}
@@ -16,6 +16,12 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type JetStreamSource struct {
 	// URL to connect to NATS cluster, multiple urls could be separated by comma.
 	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
@@ -27,4 +33,41 @@ type JetStreamSource struct {
 	// Auth information
 	// +optional
 	Auth *NatsAuth `json:"auth,omitempty" protobuf:"bytes,4,opt,name=auth"`
+	// DeliverPolicy is where the consumer starts consuming the stream, one of all, new, byStartTime and byStartSequence,
+	// defaults to all. It only takes effect when the consumer is created, use a new durable name to change it.
+	// +optional
+	DeliverPolicy JetStreamDeliverPolicy `json:"deliverPolicy,omitempty" protobuf:"bytes,5,opt,name=deliverPolicy,casttype=JetStreamDeliverPolicy"`
+	// StartTime to consume from, required when the deliver policy is byStartTime.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty" protobuf:"bytes,6,opt,name=startTime"`
+	// StartSequence is the stream sequence to consume from, required when the deliver policy is byStartSequence.
+	// +optional
+	StartSequence uint64 `json:"startSequence,omitempty" protobuf:"varint,7,opt,name=startSequence"`
+	// FilterSubjects only consumes the messages of the subjects, wildcards are supported.
+	// +optional
+	FilterSubjects []string `json:"filterSubjects,omitempty" protobuf:"bytes,8,rep,name=filterSubjects"`
+	// Durable is the name of the durable consumer, defaults to "numaflow-{pipeline}-{vertex}-{stream}".
+	// +optional
+	Durable string `json:"durable,omitempty" protobuf:"bytes,9,opt,name=durable"`
+	// AckWait is how long the server waits for a message to be acknowledged before redelivering it, defaults to 30s.
+	// +optional
+	AckWait *metav1.Duration `json:"ackWait,omitempty" protobuf:"bytes,10,opt,name=ackWait"`
+}
+
+// +kubebuilder:validation:Enum="";all;new;byStartTime;byStartSequence
+type JetStreamDeliverPolicy string
+
+const (
+	JetStreamDeliverAll             JetStreamDeliverPolicy = "all"
+	JetStreamDeliverNew             JetStreamDeliverPolicy = "new"
+	JetStreamDeliverByStartTime     JetStreamDeliverPolicy = "byStartTime"
+	JetStreamDeliverByStartSequence JetStreamDeliverPolicy = "byStartSequence"
+)
+
+// GetDurable returns the name of the durable consumer.
+func (js JetStreamSource) GetDurable(pipelineName, vertexName string) string {
+	if js.Durable != "" {
+		return js.Durable
+	}
+	return fmt.Sprintf("numaflow-%s-%s-%s", pipelineName, vertexName, js.Stream)
 }
@@ -18,7 +18,9 @@ package v1alpha1
 
 type KafkaSink struct {
 	Brokers []string `json:"brokers,omitempty" protobuf:"bytes,1,rep,name=brokers"`
-	Topic   string   `json:"topic" protobuf:"bytes,2,opt,name=topic"`
+	// Topic is the topic to write to. When TopicExpression is specified, it is the default topic
+	// for the messages which can not be routed by the expression.
+	Topic string `json:"topic" protobuf:"bytes,2,opt,name=topic"`
 	// TLS user to configure TLS connection for kafka broker
 	// TLS.enable=true default for TLS.
 	// +optional
@@ -29,4 +31,95 @@ type KafkaSink struct {
 	// SASL.enable=true default for SASL.
 	// +optional
 	SASL *SASL `json:"sasl" protobuf:"bytes,5,opt,name=sasl"`
+	// Key defines how to generate the key of the Kafka records.
+	// If it's not specified, the records are written without keys.
+	// +optional
+	Key *KafkaSinkKey `json:"key,omitempty" protobuf:"bytes,6,opt,name=key"`
+	// ForwardHeaders indicates whether to forward the message headers as the Kafka record headers.
+	// +optional
+	ForwardHeaders bool `json:"forwardHeaders,omitempty" protobuf:"varint,7,opt,name=forwardHeaders"`
+	// Partitioner is the strategy to choose the partition of the Kafka records.
+	// "hash" chooses the partition by the hash of the record key, or randomly if the key is not set;
+	// "crc32" chooses the partition by the CRC32 hash of the record key, which is compatible with librdkafka;
+	// "random" and "roundRobin" ignore the record key.
+	// If not provided, the default value is set to "hash".
+	// +kubebuilder:validation:Enum="";hash;crc32;random;roundRobin
+	// +optional
+	Partitioner KafkaPartitioner `json:"partitioner,omitempty" protobuf:"bytes,8,opt,name=partitioner"`
+	// TopicExpression is evaluated against each message to get the topic to write to.
+	// The message payload, keys and headers can be accessed as "payload", "keys" and "headers",
+	// e.g. "'tenant-' + json(payload).tenant" or "headers['x-topic']".
+	// The message is written to the default topic if the expression fails to evaluate, returns an empty string,
+	// or returns a topic not in AllowedTopics.
+	// +optional
+	TopicExpression string `json:"topicExpression,omitempty" protobuf:"bytes,9,opt,name=topicExpression"`
+	// AllowedTopics is the list of the topics that the TopicExpression is allowed to route the messages to.
+	// If it's empty, any topic returned by the expression is allowed.
+	// +optional
+	AllowedTopics []string `json:"allowedTopics,omitempty" protobuf:"bytes,10,rep,name=allowedTopics"`
+	// Transaction enables the transactional producer, which writes each batch of the messages atomically,
+	// and skips the messages redelivered after they have been committed, to achieve exactly-once delivery.
+	// The vertex must have 1 partition and set scale.max to 1, the checkpoint is kept per replica.
+	// +optional
+	Transaction *KafkaSinkTransaction `json:"transaction,omitempty" protobuf:"bytes,11,opt,name=transaction"`
+}
+
+// KafkaSinkTransaction defines the transactional delivery of a Kafka sink.
+type KafkaSinkTransaction struct {
+	// CheckpointTopic is a compacted topic to store the IDs of the most recently committed messages of each replica,
+	// it is written in the same transaction as the records, and read at startup to skip the redelivered messages.
+	CheckpointTopic string `json:"checkpointTopic" protobuf:"bytes,1,opt,name=checkpointTopic"`
+	// TransactionalIDPrefix is the prefix of the transactional IDs of the producers, the replica index is appended to it.
+	// If not provided, the default value is set to "{namespace}-{pipeline}-{vertex}".
+	// +optional
+	TransactionalIDPrefix string `json:"transactionalIDPrefix,omitempty" protobuf:"bytes,2,opt,name=transactionalIDPrefix"`
+	// DeduplicationWindow is the number of the most recently committed message IDs kept in the checkpoint,
+	// it should not be less than the number of the messages which could be read but not acknowledged.
+	// If not provided, the default value is set to twice of the read batch size.
+	// +optional
+	DeduplicationWindow *uint32 `json:"deduplicationWindow,omitempty" protobuf:"varint,3,opt,name=deduplicationWindow"`
+}
+
+func (kst KafkaSinkTransaction) GetDeduplicationWindow(readBatchSize uint64) int {
+	if kst.DeduplicationWindow != nil && *kst.DeduplicationWindow > 0 {
+		return int(*kst.DeduplicationWindow)
+	}
+	return int(2 * readBatchSize)
+}
+
+// KafkaSinkKey defines how to generate the key of the Kafka records.
+type KafkaSinkKey struct {
+	// Expression is evaluated against each message to generate the key of the Kafka record.
+	// The message payload, keys and headers can be accessed as "payload", "keys" and "headers",
+	// e.g. "json(payload).id", "keys[0]" or "headers['x-id']".
+	// If it's not specified, the message keys joined by the separator are used as the record key.
+	// +optional
+	Expression string `json:"expression,omitempty" protobuf:"bytes,1,opt,name=expression"`
+	// Separator is used to join the message keys when the expression is not specified.
+	// If not provided, the default value is set to ":".
+	// +optional
+	Separator *string `json:"separator,omitempty" protobuf:"bytes,2,opt,name=separator"`
+}
+
+func (k KafkaSinkKey) GetSeparator() string {
+	if k.Separator == nil {
+		return KeysDelimitter
+	}
+	return *k.Separator
+}
+
+type KafkaPartitioner string
+
+const (
+	KafkaPartitionerHash       KafkaPartitioner = "hash"
+	KafkaPartitionerCRC32      KafkaPartitioner = "crc32"
+	KafkaPartitionerRandom     KafkaPartitioner = "random"
+	KafkaPartitionerRoundRobin KafkaPartitioner = "roundRobin"
+)
+
+func (ks KafkaSink) GetPartitioner() KafkaPartitioner {
+	if ks.Partitioner == "" {
+		return KafkaPartitionerHash
+	}
+	return ks.Partitioner
 }
@@ -16,10 +16,18 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type KafkaSource struct {
-	Brokers           []string `json:"brokers,omitempty" protobuf:"bytes,1,rep,name=brokers"`
-	Topic             string   `json:"topic" protobuf:"bytes,2,opt,name=topic"`
-	ConsumerGroupName string   `json:"consumerGroup,omitempty" protobuf:"bytes,3,opt,name=consumerGroup"`
+	Brokers []string `json:"brokers,omitempty" protobuf:"bytes,1,rep,name=brokers"`
+	// Topic to consume messages from, it can be used together with Topics.
+	// +optional
+	Topic             string `json:"topic,omitempty" protobuf:"bytes,2,opt,name=topic"`
+	ConsumerGroupName string `json:"consumerGroup,omitempty" protobuf:"bytes,3,opt,name=consumerGroup"`
 	// TLS user to configure TLS connection for kafka broker
 	// TLS.enable=true default for TLS.
 	// +optional
@@ -30,4 +38,71 @@ type KafkaSource struct {
 	// SASL.enable=true default for SASL.
 	// +optional
 	SASL *SASL `json:"sasl" protobuf:"bytes,6,opt,name=sasl"`
+	// StartPosition is where the consumer group starts consuming the partitions without committed offsets,
+	// it overrides "consumer.offsets.initial" in the config.
+	// +optional
+	StartPosition *KafkaStartPosition `json:"startPosition,omitempty" protobuf:"bytes,7,opt,name=startPosition"`
+	// Rewind resets the committed offsets of the consumer group to a timestamp, it is applied once for each timestamp.
+	// +optional
+	Rewind *KafkaRewind `json:"rewind,omitempty" protobuf:"bytes,8,opt,name=rewind"`
+	// Topics to consume messages from, in addition to Topic.
+	// +optional
+	Topics []string `json:"topics,omitempty" protobuf:"bytes,9,rep,name=topics"`
+	// TopicPattern is a regular expression, all the topics matching it are consumed, and the new matching topics are
+	// picked up automatically. It can not be used together with Topic or Topics.
+	// +optional
+	TopicPattern string `json:"topicPattern,omitempty" protobuf:"bytes,10,opt,name=topicPattern"`
+	// TopicRefreshInterval is how often to look for the topics matching TopicPattern, defaults to 1m.
+	// +optional
+	TopicRefreshInterval *metav1.Duration `json:"topicRefreshInterval,omitempty" protobuf:"bytes,11,opt,name=topicRefreshInterval"`
+}
+
+// GetTopics returns the deduplicated topics of Topic and Topics.
+func (ks KafkaSource) GetTopics() []string {
+	var topics []string
+	seen := make(map[string]struct{})
+	for _, t := range append([]string{ks.Topic}, ks.Topics...) {
+		if _, ok := seen[t]; ok || t == "" {
+			continue
+		}
+		seen[t] = struct{}{}
+		topics = append(topics, t)
+	}
+	return topics
+}
+
+func (ks KafkaSource) GetTopicRefreshInterval() time.Duration {
+	if ks.TopicRefreshInterval != nil {
+		return ks.TopicRefreshInterval.Duration
+	}
+	return time.Minute
+}
+
+// +kubebuilder:validation:Enum=earliest;latest;timestamp;offsets
+type KafkaStartPositionType string
+
+const (
+	KafkaStartPositionEarliest  KafkaStartPositionType = "earliest"
+	KafkaStartPositionLatest    KafkaStartPositionType = "latest"
+	KafkaStartPositionTimestamp KafkaStartPositionType = "timestamp"
+	KafkaStartPositionOffsets   KafkaStartPositionType = "offsets"
+)
+
+type KafkaStartPosition struct {
+	// Type of the start position, one of earliest, latest, timestamp and offsets.
+	Type KafkaStartPositionType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=KafkaStartPositionType"`
+	// Timestamp to start from, the first message with a timestamp greater than or equal to it is consumed first.
+	// Required when the type is timestamp.
+	// +optional
+	Timestamp *metav1.Time `json:"timestamp,omitempty" protobuf:"bytes,2,opt,name=timestamp"`
+	// Offsets to start from, keyed by the partition number. Required when the type is offsets,
+	// the partitions not specified start from the earliest offset.
+	// +optional
+	Offsets map[string]int64 `json:"offsets,omitempty" protobuf:"bytes,3,rep,name=offsets"`
+}
+
+type KafkaRewind struct {
+	// Timestamp to rewind to, the consumer group resumes from the first message with a timestamp greater than or equal
+	// to it. Changing the timestamp triggers a new rewind.
+	Timestamp metav1.Time `json:"timestamp" protobuf:"bytes,1,opt,name=timestamp"`
 }
@@ -0,0 +1,37 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKafkaSource_GetTopics(t *testing.T) {
+	assert.Nil(t, KafkaSource{}.GetTopics())
+	assert.Equal(t, []string{"a"}, KafkaSource{Topic: "a"}.GetTopics())
+	assert.Equal(t, []string{"a", "b", "c"}, KafkaSource{Topic: "a", Topics: []string{"b", "a", "c"}}.GetTopics())
+	assert.Equal(t, []string{"b"}, KafkaSource{Topics: []string{"b"}}.GetTopics())
+}
+
+func TestKafkaSource_GetTopicRefreshInterval(t *testing.T) {
+	assert.Equal(t, time.Minute, KafkaSource{}.GetTopicRefreshInterval())
+	assert.Equal(t, 5*time.Second, KafkaSource{TopicRefreshInterval: &metav1.Duration{Duration: 5 * time.Second}}.GetTopicRefreshInterval())
+}
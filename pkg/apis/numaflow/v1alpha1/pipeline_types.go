@@ -185,6 +185,16 @@ func (p Pipeline) HasSideInputs() bool {
 	return len(p.Spec.SideInputs) > 0
 }
 
+// HasBoundedSource returns if the pipeline has a source which may signal the end of its input.
+func (p Pipeline) HasBoundedSource() bool {
+	for _, v := range p.Spec.Vertices {
+		if v.Source != nil && v.Source.IsBounded() {
+			return true
+		}
+	}
+	return false
+}
+
 func (p Pipeline) GetDaemonServiceName() string {
 	return fmt.Sprintf("%s-daemon-svc", p.Name)
 }
@@ -601,6 +611,10 @@ type PipelineStatus struct {
 	SourceCount *uint32       `json:"sourceCount,omitempty" protobuf:"varint,6,opt,name=sourceCount"`
 	SinkCount   *uint32       `json:"sinkCount,omitempty" protobuf:"varint,7,opt,name=sinkCount"`
 	UDFCount    *uint32       `json:"udfCount,omitempty" protobuf:"varint,8,opt,name=udfCount"`
+	// ObservedGeneration is the generation of the pipeline spec observed by the controller, it's not updated after
+	// the pipeline succeeds, the spec changes of a succeeded pipeline are ignored.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,9,opt,name=observedGeneration"`
 }
 
 // SetVertexCounts sets the counts of vertices.
@@ -674,6 +688,11 @@ func (pls *PipelineStatus) MarkPhasePausing() {
 	pls.SetPhase(PipelinePhasePausing, "Pausing in progress")
 }
 
+// MarkPhaseSucceeded set the Pipeline has succeeded.
+func (pls *PipelineStatus) MarkPhaseSucceeded() {
+	pls.SetPhase(PipelinePhaseSucceeded, "Pipeline succeeded")
+}
+
 // MarkPhaseDeleting set the Pipeline is deleting.
 func (pls *PipelineStatus) MarkPhaseDeleting() {
 	pls.SetPhase(PipelinePhaseDeleting, "Deleting in progress")
@@ -282,6 +282,15 @@ func Test_PipelineMarkPhases(t *testing.T) {
 	assert.Equal(t, PipelinePhasePausing, s.Phase)
 	s.MarkPhaseRunning()
 	assert.Equal(t, PipelinePhaseRunning, s.Phase)
+	s.MarkPhaseSucceeded()
+	assert.Equal(t, PipelinePhaseSucceeded, s.Phase)
+}
+
+func Test_HasBoundedSource(t *testing.T) {
+	assert.False(t, testPipeline.HasBoundedSource())
+	p := testPipeline.DeepCopy()
+	p.Spec.Vertices[0].Source = &Source{Generator: &GeneratorSource{MaxMessages: ptr.To[int64](100)}}
+	assert.True(t, p.HasBoundedSource())
 }
 
 func Test_GetDownstreamEdges(t *testing.T) {
@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RedisStreamsSource reads the entries of a Redis stream with a consumer group.
+type RedisStreamsSource struct {
+	// RedisConfig contains the connection information of the Redis server.
+	RedisConfig `json:",inline" protobuf:"bytes,1,opt,name=redisConfig"`
+	// Stream is the name of the Redis stream to read.
+	Stream string `json:"stream" protobuf:"bytes,2,opt,name=stream"`
+	// ConsumerGroup is the name of the consumer group, which is created if it doesn't exist,
+	// defaults to "numaflow-{pipeline}-{vertex}".
+	// +optional
+	ConsumerGroup string `json:"consumerGroup,omitempty" protobuf:"bytes,3,opt,name=consumerGroup"`
+	// ReadFromBeginning reads the entries already in the stream when the consumer group is created,
+	// otherwise only the entries added afterwards are read.
+	// +optional
+	ReadFromBeginning bool `json:"readFromBeginning,omitempty" protobuf:"varint,4,opt,name=readFromBeginning"`
+	// TLS configuration for the Redis client.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,5,opt,name=tls"`
+	// ClaimMinIdleTime is how long an entry delivered to a consumer stays unacknowledged before it is claimed by another
+	// consumer of the vertex, e.g. an entry delivered to a replica which has been scaled down. Defaults to 5m, and 0
+	// disables claiming the idle entries. It requires Redis 6.2 or later.
+	// +optional
+	ClaimMinIdleTime *metav1.Duration `json:"claimMinIdleTime,omitempty" protobuf:"bytes,6,opt,name=claimMinIdleTime"`
+}
+
+// GetConsumerGroup returns the consumer group name, or the default one of the vertex if it's not specified.
+func (rs RedisStreamsSource) GetConsumerGroup(pipelineName, vertexName string) string {
+	if rs.ConsumerGroup != "" {
+		return rs.ConsumerGroup
+	}
+	return fmt.Sprintf("numaflow-%s-%s", pipelineName, vertexName)
+}
+
+// GetClaimMinIdleTime returns how long an entry stays unacknowledged before it's claimed by another consumer.
+func (rs RedisStreamsSource) GetClaimMinIdleTime() time.Duration {
+	if rs.ClaimMinIdleTime != nil {
+		return rs.ClaimMinIdleTime.Duration
+	}
+	return 5 * time.Minute
+}
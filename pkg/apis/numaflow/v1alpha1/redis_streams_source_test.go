@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRedisStreamsSource_GetConsumerGroup(t *testing.T) {
+	rs := RedisStreamsSource{Stream: "s"}
+	assert.Equal(t, "numaflow-pl-in", rs.GetConsumerGroup("pl", "in"))
+	rs.ConsumerGroup = "my-group"
+	assert.Equal(t, "my-group", rs.GetConsumerGroup("pl", "in"))
+}
+
+func TestRedisStreamsSource_GetClaimMinIdleTime(t *testing.T) {
+	rs := RedisStreamsSource{Stream: "s"}
+	assert.Equal(t, 5*time.Minute, rs.GetClaimMinIdleTime())
+	rs.ClaimMinIdleTime = &metav1.Duration{Duration: 0}
+	assert.Equal(t, time.Duration(0), rs.GetClaimMinIdleTime())
+}
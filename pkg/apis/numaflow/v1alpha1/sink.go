@@ -27,6 +27,10 @@ type Sink struct {
 	// initiated if the ud-sink response field sets it.
 	// +optional
 	Fallback *AbstractSink `json:"fallback,omitempty" protobuf:"bytes,2,opt,name=fallback"`
+	// RetryStrategy defines how the messages failed to be written to the sink are retried, they are retried
+	// forever if it's not specified.
+	// +optional
+	RetryStrategy *SinkRetryStrategy `json:"retryStrategy,omitempty" protobuf:"bytes,3,opt,name=retryStrategy"`
 }
 
 type AbstractSink struct {
@@ -43,6 +47,21 @@ type AbstractSink struct {
 	// UDSink sink is used to write the data to the user-defined sink.
 	// +optional
 	UDSink *UDSink `json:"udsink,omitempty" protobuf:"bytes,4,opt,name=udsink"`
+	// HTTP sink is used to send the data to an HTTP endpoint.
+	// +optional
+	HTTP *HTTPSink `json:"http,omitempty" protobuf:"bytes,5,opt,name=http"`
+	// File sink is used to write the data to rolling files in a volume.
+	// +optional
+	File *FileSink `json:"file,omitempty" protobuf:"bytes,6,opt,name=file"`
+	// Nats sink is used to publish the data to NATS.
+	// +optional
+	Nats *NatsSink `json:"nats,omitempty" protobuf:"bytes,7,opt,name=nats"`
+	// JetStream sink is used to publish the data to NATS JetStream.
+	// +optional
+	JetStream *JetStreamSink `json:"jetstream,omitempty" protobuf:"bytes,8,opt,name=jetstream"`
+	// SQL sink is used to write the data to a table of a relational database.
+	// +optional
+	SQL *SQLSink `json:"sql,omitempty" protobuf:"bytes,9,opt,name=sql"`
 }
 
 func (s Sink) getContainers(req getContainerReq) ([]corev1.Container, error) {
@@ -59,7 +78,15 @@ func (s Sink) getContainers(req getContainerReq) ([]corev1.Container, error) {
 }
 
 func (s Sink) getMainContainer(req getContainerReq) corev1.Container {
-	return containerBuilder{}.init(req).args("processor", "--type="+string(VertexTypeSink), "--isbsvc-type="+string(req.isbSvcType)).build()
+	c := containerBuilder{}.init(req).args("processor", "--type="+string(VertexTypeSink), "--isbsvc-type="+string(req.isbSvcType))
+	// The volumes of the file sinks are mounted to the main container, where the files are written.
+	if s.File != nil {
+		c = c.appendVolumeMounts(corev1.VolumeMount{Name: s.File.Volume, MountPath: PathFileSinkMount})
+	}
+	if s.Fallback != nil && s.Fallback.File != nil {
+		c = c.appendVolumeMounts(corev1.VolumeMount{Name: s.Fallback.File.Volume, MountPath: PathFallbackFileSinkMount})
+	}
+	return c.build()
 }
 
 func (s Sink) getUDSinkContainer(mainContainerReq getContainerReq) corev1.Container {
@@ -132,5 +159,5 @@ func (s Sink) getFallbackUDSinkContainer(mainContainerReq getContainerReq) corev
 
 // IsAnySinkSpecified returns true if any sink is specified.
 func (a *AbstractSink) IsAnySinkSpecified() bool {
-	return a.Log != nil || a.Kafka != nil || a.Blackhole != nil || a.UDSink != nil
+	return a.Log != nil || a.Kafka != nil || a.Blackhole != nil || a.UDSink != nil || a.HTTP != nil || a.File != nil || a.Nats != nil || a.JetStream != nil || a.SQL != nil
 }
@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum="";fallback;drop;retryForever
+type SinkRetryExhaustedAction string
+
+const (
+	SinkRetryExhaustedFallback     SinkRetryExhaustedAction = "fallback"
+	SinkRetryExhaustedDrop         SinkRetryExhaustedAction = "drop"
+	SinkRetryExhaustedRetryForever SinkRetryExhaustedAction = "retryForever"
+)
+
+// SinkRetryStrategy defines how the messages failed to be written to the sink are retried.
+type SinkRetryStrategy struct {
+	// MaxAttempts is the maximum number of the attempts to write a message, including the first one, defaults to 3.
+	// It doesn't take effect when the onExhausted action is retryForever.
+	// +optional
+	MaxAttempts *uint32 `json:"maxAttempts,omitempty" protobuf:"varint,1,opt,name=maxAttempts"`
+	// Backoff defines the intervals between the attempts.
+	// +optional
+	Backoff *SinkRetryBackoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+	// OnExhausted is the action taken on the messages still failing after the max attempts, one of fallback, drop
+	// and retryForever, defaults to retryForever. With fallback, the messages are written to the fallback sink,
+	// and the messages failing in the fallback sink are retried forever. With drop, the messages are dropped.
+	// +optional
+	OnExhausted SinkRetryExhaustedAction `json:"onExhausted,omitempty" protobuf:"bytes,3,opt,name=onExhausted,casttype=SinkRetryExhaustedAction"`
+}
+
+// SinkRetryBackoff defines the exponential backoff between the attempts.
+type SinkRetryBackoff struct {
+	// Interval before the first retry, defaults to 100ms.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty" protobuf:"bytes,1,opt,name=interval"`
+	// MaxInterval is the maximum interval between the retries, defaults to 30s.
+	// +optional
+	MaxInterval *metav1.Duration `json:"maxInterval,omitempty" protobuf:"bytes,2,opt,name=maxInterval"`
+	// Factor multiplies the interval after each retry, which must be at least 1, defaults to "2".
+	// +optional
+	Factor string `json:"factor,omitempty" protobuf:"bytes,3,opt,name=factor"`
+	// JitterPercentage randomly increases each interval by up to the percentage of it, defaults to 0.
+	// +optional
+	JitterPercentage *uint32 `json:"jitterPercentage,omitempty" protobuf:"varint,4,opt,name=jitterPercentage"`
+}
+
+func (rs SinkRetryStrategy) GetMaxAttempts() int {
+	if rs.MaxAttempts != nil && *rs.MaxAttempts > 0 {
+		return int(*rs.MaxAttempts)
+	}
+	return DefaultSinkRetryMaxAttempts
+}
+
+func (rs SinkRetryStrategy) GetOnExhausted() SinkRetryExhaustedAction {
+	if rs.OnExhausted == "" {
+		return SinkRetryExhaustedRetryForever
+	}
+	return rs.OnExhausted
+}
+
+func (rs SinkRetryStrategy) GetInterval() time.Duration {
+	if b := rs.Backoff; b != nil && b.Interval != nil && b.Interval.Duration > 0 {
+		return b.Interval.Duration
+	}
+	return DefaultSinkRetryInterval
+}
+
+func (rs SinkRetryStrategy) GetMaxInterval() time.Duration {
+	if b := rs.Backoff; b != nil && b.MaxInterval != nil && b.MaxInterval.Duration > 0 {
+		return b.MaxInterval.Duration
+	}
+	return DefaultSinkRetryMaxInterval
+}
+
+func (rs SinkRetryStrategy) GetFactor() (float64, error) {
+	if rs.Backoff == nil || rs.Backoff.Factor == "" {
+		return 2, nil
+	}
+	f, err := strconv.ParseFloat(rs.Backoff.Factor, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid backoff factor %q, %w", rs.Backoff.Factor, err)
+	}
+	if f < 1 {
+		return 0, fmt.Errorf("invalid backoff factor %q, it must be at least 1", rs.Backoff.Factor)
+	}
+	return f, nil
+}
+
+func (rs SinkRetryStrategy) GetJitterPercentage() uint32 {
+	if b := rs.Backoff; b != nil && b.JitterPercentage != nil {
+		return *b.JitterPercentage
+	}
+	return 0
+}
@@ -41,6 +41,24 @@ func Test_Sink_getContainers(t *testing.T) {
 	assert.Equal(t, corev1.ResourceRequirements{Requests: map[corev1.ResourceName]resource.Quantity{"cpu": resource.MustParse("2")}}, c[0].Resources)
 }
 
+func Test_Sink_getContainers_FileSink(t *testing.T) {
+	s := Sink{
+		AbstractSink: AbstractSink{File: &FileSink{Volume: "data"}},
+		Fallback:     &AbstractSink{File: &FileSink{Volume: "dlq"}},
+	}
+	c, err := s.getContainers(getContainerReq{
+		image:        testFlowImage,
+		volumeMounts: []corev1.VolumeMount{{Name: "var-run-numaflow", MountPath: PathVarRun}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(c))
+	assert.Equal(t, []corev1.VolumeMount{
+		{Name: "var-run-numaflow", MountPath: PathVarRun},
+		{Name: "data", MountPath: PathFileSinkMount},
+		{Name: "dlq", MountPath: PathFallbackFileSinkMount},
+	}, c[0].VolumeMounts)
+}
+
 func Test_Sink_getUDSinkContainer(t *testing.T) {
 	x := Sink{
 		AbstractSink: AbstractSink{
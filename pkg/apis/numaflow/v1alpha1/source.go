@@ -41,6 +41,24 @@ type Source struct {
 	UDSource *UDSource `json:"udsource,omitempty" protobuf:"bytes,6,opt,name=udSource"`
 	// +optional
 	JetStream *JetStreamSource `json:"jetstream,omitempty" protobuf:"bytes,7,opt,name=jetstream"`
+	// +optional
+	File *FileSource `json:"file,omitempty" protobuf:"bytes,8,opt,name=file"`
+	// +optional
+	RedisStreams *RedisStreamsSource `json:"redisStreams,omitempty" protobuf:"bytes,9,opt,name=redisStreams"`
+}
+
+// IsBounded returns true if the source may signal the end of its input, which is a generator with a message limit,
+// a file source which does not follow the files, or a user-defined source declared bounded.
+func (s Source) IsBounded() bool {
+	switch {
+	case s.Generator != nil:
+		return s.Generator.MaxMessages != nil
+	case s.File != nil:
+		return !s.File.GetFollow()
+	case s.UDSource != nil:
+		return s.UDSource.Bounded
+	}
+	return false
 }
 
 func (s Source) getContainers(req getContainerReq) ([]corev1.Container, error) {
@@ -57,7 +75,11 @@ func (s Source) getContainers(req getContainerReq) ([]corev1.Container, error) {
 }
 
 func (s Source) getMainContainer(req getContainerReq) corev1.Container {
-	return containerBuilder{}.init(req).args("processor", "--type="+string(VertexTypeSource), "--isbsvc-type="+string(req.isbSvcType)).build()
+	c := containerBuilder{}.init(req).args("processor", "--type="+string(VertexTypeSource), "--isbsvc-type="+string(req.isbSvcType))
+	if s.File != nil {
+		c = c.appendVolumeMounts(s.File.VolumeMounts...)
+	}
+	return c.build()
 }
 
 func (s Source) getUDTransformerContainer(mainContainerReq getContainerReq) corev1.Container {
@@ -23,10 +23,21 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
 )
 
 var testImagePullPolicy = corev1.PullNever
 
+func TestSource_IsBounded(t *testing.T) {
+	assert.False(t, Source{Generator: &GeneratorSource{}}.IsBounded())
+	assert.True(t, Source{Generator: &GeneratorSource{MaxMessages: ptr.To[int64](10)}}.IsBounded())
+	assert.False(t, Source{File: &FileSource{}}.IsBounded())
+	assert.True(t, Source{File: &FileSource{Follow: ptr.To[bool](false)}}.IsBounded())
+	assert.False(t, Source{UDSource: &UDSource{}}.IsBounded())
+	assert.True(t, Source{UDSource: &UDSource{Bounded: true}}.IsBounded())
+	assert.False(t, Source{Kafka: &KafkaSource{}}.IsBounded())
+}
+
 func TestSource_getContainers(t *testing.T) {
 	x := Source{
 		UDTransformer: &UDTransformer{
@@ -73,6 +84,19 @@ func TestSource_getContainers(t *testing.T) {
 	assert.Equal(t, testImagePullPolicy, c[1].ImagePullPolicy)
 }
 
+func TestSource_getMainContainer_File(t *testing.T) {
+	x := Source{
+		File: &FileSource{
+			Path:         "/data/*.log",
+			VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: "/data"}},
+		},
+	}
+	c := x.getMainContainer(getContainerReq{
+		image: "main-image",
+	})
+	assert.Contains(t, c.VolumeMounts, corev1.VolumeMount{Name: "data", MountPath: "/data"})
+}
+
 func Test_getTransformerContainer(t *testing.T) {
 	t.Run("with customized image", func(t *testing.T) {
 		x := Source{
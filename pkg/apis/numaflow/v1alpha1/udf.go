@@ -28,7 +28,7 @@ import (
 )
 
 type Function struct {
-	// +kubebuilder:validation:Enum=cat;filter
+	// +kubebuilder:validation:Enum=cat;filter;keyBy;redact
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 	// +optional
 	Args []string `json:"args,omitempty" protobuf:"bytes,2,rep,name=args"`
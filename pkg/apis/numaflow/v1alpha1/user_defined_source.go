@@ -18,4 +18,8 @@ package v1alpha1
 
 type UDSource struct {
 	Container *Container `json:"container" protobuf:"bytes,1,opt,name=container"`
+	// Bounded tells the source has a finite input, whose end is signaled by an end marker, so that the pipeline
+	// completes once the input is exhausted and processed.
+	// +optional
+	Bounded bool `json:"bounded,omitempty" protobuf:"varint,2,opt,name=bounded"`
 }
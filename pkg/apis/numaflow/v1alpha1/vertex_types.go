@@ -109,7 +109,21 @@ func (v Vertex) GetVertexType() VertexType {
 }
 
 func (v Vertex) Scalable() bool {
-	if v.Spec.Scale.Disabled || v.IsReduceUDF() {
+	if v.Spec.Scale.Disabled {
+		return false
+	}
+	if v.IsReduceUDF() {
+		// A reduce vertex is only scalable when the PBQ state is persisted, each replica owns a partition
+		// and its WAL, which has to be replayed by the same replica after it's scaled back up. Since the
+		// state is not handed off to other replicas, scaling a reduce vertex to 0 is opt-in, with scale.min
+		// explicitly set to 0.
+		if x := v.Spec.UDF.GroupBy.Storage; x == nil || x.PersistentVolumeClaim == nil {
+			return false
+		}
+		return v.Spec.Scale.Min != nil && *v.Spec.Scale.Min == 0
+	}
+	if v.IsASource() && v.Spec.Source.File != nil {
+		// A file source is not scalable, the files are read by a single replica.
 		return false
 	}
 	if v.IsASink() || v.IsMapUDF() || v.IsASource() {
@@ -401,13 +415,21 @@ func (v Vertex) GetToBuffers() []string {
 
 func (v Vertex) GetReplicas() int {
 	if v.IsReduceUDF() {
-		// Replicas will be 0 only when pausing a pipeline
+		// Replicas will be 0 when pausing a pipeline, or when the vertex is scaled to 0 by the autoscaler if it's
+		// opted in, see Scalable().
 		if v.Spec.Replicas != nil && int(*v.Spec.Replicas) == 0 {
 			return 0
 		}
 		// Replica of a reduce vertex is determined by the partitions.
 		return v.GetPartitionCount()
 	}
+	if v.IsASource() && v.Spec.Source.File != nil {
+		// A file source runs with at most 1 replica, and 0 only when pausing a pipeline.
+		if v.Spec.Replicas != nil && int(*v.Spec.Replicas) == 0 {
+			return 0
+		}
+		return 1
+	}
 	if v.Spec.Replicas == nil {
 		return 1
 	}
@@ -678,6 +700,19 @@ type VertexLimits struct {
 	// It overrides the settings from pipeline limits.
 	// +optional
 	BufferUsageLimit *uint32 `json:"bufferUsageLimit,omitempty" protobuf:"varint,4,opt,name=bufferUsageLimit"`
+	// RateLimit limits the total throughput of all the replicas of the vertex.
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty" protobuf:"bytes,5,opt,name=rateLimit"`
+}
+
+// RateLimit defines the maximum throughput of a vertex, it is shared by all the replicas of the vertex through a token
+// bucket stored in the ISB Service.
+type RateLimit struct {
+	// MessagesPerSecond is the maximum number of messages read by all the replicas of the vertex per second.
+	MessagesPerSecond uint64 `json:"messagesPerSecond" protobuf:"varint,1,opt,name=messagesPerSecond"`
+	// BytesPerSecond is the maximum size of the payloads read by all the replicas of the vertex per second.
+	// +optional
+	BytesPerSecond *uint64 `json:"bytesPerSecond,omitempty" protobuf:"varint,2,opt,name=bytesPerSecond"`
 }
 
 func (v VertexSpec) getType() containerSupplier {
@@ -149,6 +149,14 @@ func TestGetVertexReplicas(t *testing.T) {
 	assert.Equal(t, 1, v.GetReplicas())
 	v.Spec.UDF.GroupBy = nil
 	assert.Equal(t, 1000, v.GetReplicas())
+	v.Spec.UDF = nil
+	v.Spec.FromEdges = nil
+	v.Spec.Source = &Source{
+		File: &FileSource{Path: "/data/*.log"},
+	}
+	assert.Equal(t, 1, v.GetReplicas())
+	v.Spec.Replicas = ptr.To[int32](0)
+	assert.Equal(t, 0, v.GetReplicas())
 }
 
 func TestGetHeadlessSvcSpec(t *testing.T) {
@@ -550,6 +558,22 @@ func TestScalable(t *testing.T) {
 		GroupBy: &GroupBy{},
 	}
 	assert.False(t, v.Scalable())
+	v.Spec.UDF.GroupBy.Storage = &PBQStorage{
+		EmptyDir: &corev1.EmptyDirVolumeSource{},
+	}
+	assert.False(t, v.Scalable())
+	v.Spec.UDF.GroupBy.Storage = &PBQStorage{
+		PersistentVolumeClaim: &PersistenceStrategy{},
+	}
+	assert.False(t, v.Scalable())
+	v.Spec.Scale.Min = ptr.To[int32](1)
+	assert.False(t, v.Scalable())
+	v.Spec.Scale.Min = ptr.To[int32](0)
+	assert.True(t, v.Scalable())
+	v.Spec.Scale.Disabled = true
+	assert.False(t, v.Scalable())
+	v.Spec.Scale.Disabled = false
+	v.Spec.Scale.Min = nil
 	v.Spec.UDF = nil
 	v.Spec.Source = &Source{
 		HTTP: &HTTPSource{},
@@ -563,6 +587,10 @@ func TestScalable(t *testing.T) {
 		UDSource: &UDSource{},
 	}
 	assert.True(t, v.Scalable())
+	v.Spec.Source = &Source{
+		File: &FileSource{},
+	}
+	assert.False(t, v.Scalable())
 }
 
 func Test_Scale_Parameters(t *testing.T) {
@@ -120,6 +120,31 @@ func (in *AbstractSink) DeepCopyInto(out *AbstractSink) {
 		*out = new(UDSink)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Nats != nil {
+		in, out := &in.Nats, &out.Nats
+		*out = new(NatsSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JetStream != nil {
+		in, out := &in.JetStream, &out.JetStream
+		*out = new(JetStreamSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = new(SQLSink)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -495,6 +520,96 @@ func (in *Edge) DeepCopy() *Edge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSink) DeepCopyInto(out *FileSink) {
+	*out = *in
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(FileSinkRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSink.
+func (in *FileSink) DeepCopy() *FileSink {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSinkRotation) DeepCopyInto(out *FileSinkRotation) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSinkRotation.
+func (in *FileSinkRotation) DeepCopy() *FileSinkRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSinkRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSource) DeepCopyInto(out *FileSource) {
+	*out = *in
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Follow != nil {
+		in, out := &in.Follow, &out.Follow
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSource.
+func (in *FileSource) DeepCopy() *FileSource {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FixedWindow) DeepCopyInto(out *FixedWindow) {
 	*out = *in
@@ -606,6 +721,49 @@ func (in *GSSAPI) DeepCopy() *GSSAPI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorKeyDistribution) DeepCopyInto(out *GeneratorKeyDistribution) {
+	*out = *in
+	if in.HotKeyCount != nil {
+		in, out := &in.HotKeyCount, &out.HotKeyCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HotKeyPercentage != nil {
+		in, out := &in.HotKeyPercentage, &out.HotKeyPercentage
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorKeyDistribution.
+func (in *GeneratorKeyDistribution) DeepCopy() *GeneratorKeyDistribution {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorKeyDistribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorLateData) DeepCopyInto(out *GeneratorLateData) {
+	*out = *in
+	out.Delay = in.Delay
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorLateData.
+func (in *GeneratorLateData) DeepCopy() *GeneratorLateData {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorLateData)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GeneratorSource) DeepCopyInto(out *GeneratorSource) {
 	*out = *in
@@ -644,6 +802,26 @@ func (in *GeneratorSource) DeepCopyInto(out *GeneratorSource) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyDistribution != nil {
+		in, out := &in.KeyDistribution, &out.KeyDistribution
+		*out = new(GeneratorKeyDistribution)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LateData != nil {
+		in, out := &in.LateData, &out.LateData
+		*out = new(GeneratorLateData)
+		**out = **in
+	}
+	if in.MaxMessages != nil {
+		in, out := &in.MaxMessages, &out.MaxMessages
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -850,6 +1028,96 @@ func (in *GroupBy) DeepCopy() *GroupBy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSink) DeepCopyInto(out *HTTPSink) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(HTTPSinkBatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(HTTPSinkAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSink.
+func (in *HTTPSink) DeepCopy() *HTTPSink {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSinkAuth) DeepCopyInto(out *HTTPSinkAuth) {
+	*out = *in
+	if in.Basic != nil {
+		in, out := &in.Basic, &out.Basic
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSinkAuth.
+func (in *HTTPSinkAuth) DeepCopy() *HTTPSinkAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSinkAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSinkBatch) DeepCopyInto(out *HTTPSinkBatch) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSinkBatch.
+func (in *HTTPSinkBatch) DeepCopy() *HTTPSinkBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSinkBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPSource) DeepCopyInto(out *HTTPSource) {
 	*out = *in
@@ -858,6 +1126,11 @@ func (in *HTTPSource) DeepCopyInto(out *HTTPSource) {
 		*out = new(Authorization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SyncTimeout != nil {
+		in, out := &in.SyncTimeout, &out.SyncTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1085,6 +1358,37 @@ func (in *JetStreamConfig) DeepCopy() *JetStreamConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JetStreamSink) DeepCopyInto(out *JetStreamSink) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(NatsAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AckTimeout != nil {
+		in, out := &in.AckTimeout, &out.AckTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JetStreamSink.
+func (in *JetStreamSink) DeepCopy() *JetStreamSink {
+	if in == nil {
+		return nil
+	}
+	out := new(JetStreamSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JetStreamSource) DeepCopyInto(out *JetStreamSource) {
 	*out = *in
@@ -1098,6 +1402,20 @@ func (in *JetStreamSource) DeepCopyInto(out *JetStreamSource) {
 		*out = new(NatsAuth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FilterSubjects != nil {
+		in, out := &in.FilterSubjects, &out.FilterSubjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AckWait != nil {
+		in, out := &in.AckWait, &out.AckWait
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1143,6 +1461,23 @@ func (in *JobTemplate) DeepCopy() *JobTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaRewind) DeepCopyInto(out *KafkaRewind) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaRewind.
+func (in *KafkaRewind) DeepCopy() *KafkaRewind {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaRewind)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KafkaSink) DeepCopyInto(out *KafkaSink) {
 	*out = *in
@@ -1161,6 +1496,21 @@ func (in *KafkaSink) DeepCopyInto(out *KafkaSink) {
 		*out = new(SASL)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(KafkaSinkKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedTopics != nil {
+		in, out := &in.AllowedTopics, &out.AllowedTopics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Transaction != nil {
+		in, out := &in.Transaction, &out.Transaction
+		*out = new(KafkaSinkTransaction)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1174,6 +1524,48 @@ func (in *KafkaSink) DeepCopy() *KafkaSink {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSinkKey) DeepCopyInto(out *KafkaSinkKey) {
+	*out = *in
+	if in.Separator != nil {
+		in, out := &in.Separator, &out.Separator
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSinkKey.
+func (in *KafkaSinkKey) DeepCopy() *KafkaSinkKey {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSinkKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSinkTransaction) DeepCopyInto(out *KafkaSinkTransaction) {
+	*out = *in
+	if in.DeduplicationWindow != nil {
+		in, out := &in.DeduplicationWindow, &out.DeduplicationWindow
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSinkTransaction.
+func (in *KafkaSinkTransaction) DeepCopy() *KafkaSinkTransaction {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSinkTransaction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KafkaSource) DeepCopyInto(out *KafkaSource) {
 	*out = *in
@@ -1192,6 +1584,26 @@ func (in *KafkaSource) DeepCopyInto(out *KafkaSource) {
 		*out = new(SASL)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StartPosition != nil {
+		in, out := &in.StartPosition, &out.StartPosition
+		*out = new(KafkaStartPosition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rewind != nil {
+		in, out := &in.Rewind, &out.Rewind
+		*out = new(KafkaRewind)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TopicRefreshInterval != nil {
+		in, out := &in.TopicRefreshInterval, &out.TopicRefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1205,6 +1617,33 @@ func (in *KafkaSource) DeepCopy() *KafkaSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaStartPosition) DeepCopyInto(out *KafkaStartPosition) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Offsets != nil {
+		in, out := &in.Offsets, &out.Offsets
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaStartPosition.
+func (in *KafkaStartPosition) DeepCopy() *KafkaStartPosition {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaStartPosition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Lifecycle) DeepCopyInto(out *Lifecycle) {
 	*out = *in
@@ -1360,6 +1799,32 @@ func (in *NatsAuth) DeepCopy() *NatsAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatsSink) DeepCopyInto(out *NatsSink) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(NatsAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatsSink.
+func (in *NatsSink) DeepCopy() *NatsSink {
+	if in == nil {
+		return nil
+	}
+	out := new(NatsSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NatsSource) DeepCopyInto(out *NatsSource) {
 	*out = *in
@@ -1648,6 +2113,27 @@ func (in *PipelineStatus) DeepCopy() *PipelineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimit) DeepCopyInto(out *RateLimit) {
+	*out = *in
+	if in.BytesPerSecond != nil {
+		in, out := &in.BytesPerSecond, &out.BytesPerSecond
+		*out = new(uint64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
+func (in *RateLimit) DeepCopy() *RateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisBufferService) DeepCopyInto(out *RedisBufferService) {
 	*out = *in
@@ -1716,6 +2202,33 @@ func (in *RedisSettings) DeepCopy() *RedisSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisStreamsSource) DeepCopyInto(out *RedisStreamsSource) {
+	*out = *in
+	in.RedisConfig.DeepCopyInto(&out.RedisConfig)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClaimMinIdleTime != nil {
+		in, out := &in.ClaimMinIdleTime, &out.ClaimMinIdleTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisStreamsSource.
+func (in *RedisStreamsSource) DeepCopy() *RedisStreamsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisStreamsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SASL) DeepCopyInto(out *SASL) {
 	*out = *in
@@ -1783,6 +2296,58 @@ func (in *SASLPlain) DeepCopy() *SASLPlain {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLSink) DeepCopyInto(out *SQLSink) {
+	*out = *in
+	if in.DSN != nil {
+		in, out := &in.DSN, &out.DSN
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]SQLSinkColumn, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeyColumns != nil {
+		in, out := &in.KeyColumns, &out.KeyColumns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLSink.
+func (in *SQLSink) DeepCopy() *SQLSink {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLSinkColumn) DeepCopyInto(out *SQLSinkColumn) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLSinkColumn.
+func (in *SQLSinkColumn) DeepCopy() *SQLSinkColumn {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLSinkColumn)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Scale) DeepCopyInto(out *Scale) {
 	*out = *in
@@ -1960,6 +2525,11 @@ func (in *Sink) DeepCopyInto(out *Sink) {
 		*out = new(AbstractSink)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryStrategy != nil {
+		in, out := &in.RetryStrategy, &out.RetryStrategy
+		*out = new(SinkRetryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1973,6 +2543,63 @@ func (in *Sink) DeepCopy() *Sink {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SinkRetryBackoff) DeepCopyInto(out *SinkRetryBackoff) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.JitterPercentage != nil {
+		in, out := &in.JitterPercentage, &out.JitterPercentage
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SinkRetryBackoff.
+func (in *SinkRetryBackoff) DeepCopy() *SinkRetryBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(SinkRetryBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SinkRetryStrategy) DeepCopyInto(out *SinkRetryStrategy) {
+	*out = *in
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(SinkRetryBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SinkRetryStrategy.
+func (in *SinkRetryStrategy) DeepCopy() *SinkRetryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(SinkRetryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SlidingWindow) DeepCopyInto(out *SlidingWindow) {
 	*out = *in
@@ -2037,6 +2664,16 @@ func (in *Source) DeepCopyInto(out *Source) {
 		*out = new(JetStreamSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RedisStreams != nil {
+		in, out := &in.RedisStreams, &out.RedisStreams
+		*out = new(RedisStreamsSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2361,6 +2998,11 @@ func (in *VertexLimits) DeepCopyInto(out *VertexLimits) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RateLimit)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -116,6 +116,15 @@ func (m *Message) ToReadMessage(ot Offset, wm time.Time) *ReadMessage {
 	return &ReadMessage{Message: *m, ReadOffset: ot, Watermark: wm}
 }
 
+// PayloadSize returns the total size of the payloads of the read messages.
+func PayloadSize(messages []*ReadMessage) int64 {
+	var size int64
+	for _, m := range messages {
+		size += int64(len(m.Payload))
+	}
+	return size
+}
+
 // WriteMessage is a wrapper for an isb message with tag information which will be used
 // for conditional forwarding.
 type WriteMessage struct {
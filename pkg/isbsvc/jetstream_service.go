@@ -102,6 +102,22 @@ func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, b
 			}
 			log.Infow("Succeeded to create a side inputs KV", zap.String("kvName", kvName))
 		}
+		// the rate limit KV is shared by the vertices of the pipeline, it is named after the side inputs store.
+		rateLimitKVName := JetStreamRateLimitKVName(sideInputsStore)
+		if _, err := js.KeyValue(rateLimitKVName); err != nil {
+			if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+				return fmt.Errorf("failed to query information of KV %q, %w", rateLimitKVName, err)
+			}
+			if _, err := js.CreateKeyValue(&nats.KeyValueConfig{
+				Bucket:   rateLimitKVName,
+				History:  1,
+				Storage:  nats.FileStorage,
+				Replicas: v.GetInt("stream.replicas"),
+			}); err != nil {
+				return fmt.Errorf("failed to create rate limit KV %q, %w", rateLimitKVName, err)
+			}
+			log.Infow("Succeeded to create a rate limit KV", zap.String("kvName", rateLimitKVName))
+		}
 	}
 	for _, buffer := range buffers {
 		streamName := JetStreamName(buffer)
@@ -214,6 +230,11 @@ func (jss *jetStreamSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, b
 			return fmt.Errorf("failed to delete processor KV %q, %w", procKVName, err)
 		}
 		log.Infow("Succeeded to delete a processor KV", zap.String("kvName", procKVName))
+		// The checkpoint KV is only created by the file sources.
+		checkpointKVName := JetStreamSourceCheckpointKVName(bucket)
+		if err := js.DeleteKeyValue(checkpointKVName); err != nil && !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("failed to delete source checkpoint KV %q, %w", checkpointKVName, err)
+		}
 	}
 
 	if sideInputsStore != "" {
@@ -222,6 +243,11 @@ func (jss *jetStreamSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, b
 			return fmt.Errorf("failed to delete side inputs KV %q, %w", sideInputsKVName, err)
 		}
 		log.Infow("Succeeded to delete a side inputs KV", zap.String("kvName", sideInputsKVName))
+		rateLimitKVName := JetStreamRateLimitKVName(sideInputsStore)
+		if err := js.DeleteKeyValue(rateLimitKVName); err != nil && !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("failed to delete rate limit KV %q, %w", rateLimitKVName, err)
+		}
+		log.Infow("Succeeded to delete a rate limit KV", zap.String("kvName", rateLimitKVName))
 	}
 	return nil
 }
@@ -337,3 +363,13 @@ func JetStreamName(bufferName string) string {
 func JetStreamSideInputsStoreKVName(sideInputStoreName string) string {
 	return fmt.Sprintf("%s_SIDE_INPUTS", sideInputStoreName)
 }
+
+// JetStreamRateLimitKVName returns the name of the KV which stores the token buckets of the rate limited vertices of a pipeline.
+func JetStreamRateLimitKVName(pipelineStoreName string) string {
+	return fmt.Sprintf("%s_RATE_LIMIT", pipelineStoreName)
+}
+
+// JetStreamSourceCheckpointKVName returns the name of the KV which stores the read positions of a source vertex.
+func JetStreamSourceCheckpointKVName(bucketName string) string {
+	return fmt.Sprintf("%s_CHECKPOINT", bucketName)
+}
@@ -95,6 +95,29 @@ func (r *isbsRedisSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buc
 			log.Infow("Redis keys deleted", zap.String("stream", stream))
 		}
 	}
+	// the watermark KV stores are Redis hashes, which are created on the first write.
+	for _, bucket := range buckets {
+		otKVName := store.RedisOTKVName(bucket)
+		procKVName := store.RedisProcessorKVName(bucket)
+		// the checkpoint KV store only exists for the file sources, deleting a missing key is a no-op.
+		checkpointKVName := RedisSourceCheckpointKVName(bucket)
+		if err := r.client.DeleteKeys(ctx, otKVName, procKVName, checkpointKVName); err != nil {
+			errList = multierr.Append(errList, err)
+			log.Errorw("Failed to delete Redis watermark keys.", zap.String("otKVName", otKVName), zap.String("procKVName", procKVName), zap.Error(err))
+		} else {
+			log.Infow("Redis watermark keys deleted", zap.String("otKVName", otKVName), zap.String("procKVName", procKVName))
+		}
+	}
+	// the rate limit hash is shared by the vertices of the pipeline, it is named after the side inputs store.
+	if sideInputsStore != "" {
+		rateLimitKVName := RedisRateLimitKVName(sideInputsStore)
+		if err := r.client.DeleteKeys(ctx, rateLimitKVName); err != nil {
+			errList = multierr.Append(errList, err)
+			log.Errorw("Failed to delete Redis rate limit key.", zap.String("rateLimitKVName", rateLimitKVName), zap.Error(err))
+		} else {
+			log.Infow("Redis rate limit key deleted", zap.String("rateLimitKVName", rateLimitKVName))
+		}
+	}
 	if errList != nil {
 		return fmt.Errorf("failed to delete all or some Redis StreamGroups and keys")
 	}
@@ -138,16 +161,30 @@ func (r *isbsRedisSvc) GetBufferInfo(ctx context.Context, buffer string) (*Buffe
 
 // CreateWatermarkStores is used to create the watermark stores.
 func (r *isbsRedisSvc) CreateWatermarkStores(ctx context.Context, bucketName string, fromBufferPartitionCount int, isReduce bool) ([]store.WatermarkStore, error) {
-	// Watermark fetching is not supported for Redis ATM. Creating noop watermark fetcher.
+	log := logging.FromContext(ctx).With("bucket", bucketName)
+	ctx = logging.WithLogger(ctx, log)
 	var wmStores []store.WatermarkStore
 	partitions := 1
 	if isReduce {
 		partitions = fromBufferPartitionCount
 	}
+	// if it's not a reduce vertex, we only need one store to store the watermark
 	for i := 0; i < partitions; i++ {
-		wmStore, _ := store.BuildNoOpWatermarkStore()
+		wmStore, err := store.BuildRedisWatermarkStore(ctx, bucketName, r.client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create new Redis watermark store, %w", err)
+		}
 		wmStores = append(wmStores, wmStore)
 	}
-
 	return wmStores, nil
 }
+
+// RedisSourceCheckpointKVName returns the name of the hash which stores the read positions of a source vertex.
+func RedisSourceCheckpointKVName(bucketName string) string {
+	return fmt.Sprintf("%s_CHECKPOINT", bucketName)
+}
+
+// RedisRateLimitKVName returns the name of the hash which stores the token buckets of the rate limited vertices of a pipeline.
+func RedisRateLimitKVName(pipelineStoreName string) string {
+	return fmt.Sprintf("%s_RATE_LIMIT", pipelineStoreName)
+}
@@ -29,6 +29,7 @@ const (
 	LabelPartitionName      = "partition_name"
 
 	LabelReason = "reason"
+	LabelAction = "action"
 )
 
 // Generic forwarder metrics
@@ -172,6 +173,13 @@ var (
 		Help:      "Total number of source transformer Errors",
 	}, []string{LabelVertex, LabelPipeline, LabelVertexReplicaIndex, LabelPartitionName})
 
+	// SourceTransformerConversionError is used to indicate the number of messages failed to be converted by the builtin convert transformer
+	SourceTransformerConversionError = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "source_forwarder",
+		Name:      "transformer_conversion_error_total",
+		Help:      "Total number of messages failed to be converted by the builtin convert transformer",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexReplicaIndex, LabelPartitionName})
+
 	// SourceTransformerProcessingTime is a histogram to Observe Source Transformer Processing times as a whole
 	SourceTransformerProcessingTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Subsystem: "source_forwarder",
@@ -272,6 +280,24 @@ var (
 	}, []string{LabelVertex, LabelPipeline, LabelVertexReplicaIndex})
 )
 
+// Sink forwarder specific metrics
+var (
+	// SinkWriteRetries is used to indicate the number of messages retried to be written to the sink
+	SinkWriteRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "sink_forwarder",
+		Name:      "write_retry_total",
+		Help:      "Total number of messages retried to be written to the sink",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexReplicaIndex, LabelPartitionName})
+
+	// SinkRetriesExhausted is used to indicate the number of messages still failing after the max attempts of writing to the sink,
+	// the action is either "fallback" or "drop".
+	SinkRetriesExhausted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "sink_forwarder",
+		Name:      "retry_exhausted_total",
+		Help:      "Total number of messages still failing after the max attempts of writing to the sink",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexReplicaIndex, LabelPartitionName, LabelAction})
+)
+
 // Ctrl Message Metric
 var (
 	// CtrlMessagesCount is used to indicate the number of total ctrl messages sent.
@@ -42,10 +42,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
 	daemonclient "github.com/numaproj/numaflow/pkg/daemon/client"
 	"github.com/numaproj/numaflow/pkg/reconciler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
 
 const (
@@ -129,6 +131,17 @@ func (r *pipelineReconciler) reconcile(ctx context.Context, pl *dfv1.Pipeline) (
 		return ctrl.Result{}, nil
 	}
 
+	// A succeeded pipeline is not reconciled anymore, all of its vertices have been scaled down to zero, and its
+	// buffers carry the final watermark, so the spec changes are not applied. It has to be recreated to run again.
+	if pl.Status.Phase == dfv1.PipelinePhaseSucceeded {
+		if pl.Generation != pl.Status.ObservedGeneration {
+			log.Infow("The pipeline has succeeded, the spec changes are ignored", zap.Int64("generation", pl.Generation), zap.Int64("observedGeneration", pl.Status.ObservedGeneration))
+			r.recorder.Event(pl, corev1.EventTypeWarning, "SpecChangeIgnored", "The pipeline has succeeded, the spec changes are ignored, delete and recreate it to run it again")
+		}
+		return ctrl.Result{}, nil
+	}
+	pl.Status.ObservedGeneration = pl.Generation
+
 	// New, or reconciliation failed pipeline
 	if pl.Status.Phase == dfv1.PipelinePhaseUnknown || pl.Status.Phase == dfv1.PipelinePhaseFailed {
 		result, err := r.reconcileNonLifecycleChanges(ctx, pl)
@@ -160,8 +173,13 @@ func (r *pipelineReconciler) reconcile(ctx context.Context, pl *dfv1.Pipeline) (
 	result, err := r.reconcileNonLifecycleChanges(ctx, pl)
 	if err != nil {
 		r.recorder.Eventf(pl, corev1.EventTypeWarning, "ReconcilePipelineFailed", "Failed to reconcile pipeline: %v", err.Error())
+		return result, err
+	}
+	// A pipeline with bounded sources completes once its sources are exhausted, which is told by the watermarks.
+	if pl.HasBoundedSource() && !pl.Spec.Watermark.Disabled && pl.Status.Phase == dfv1.PipelinePhaseRunning {
+		return r.completePipeline(ctx, pl)
 	}
-	return result, err
+	return result, nil
 }
 
 // reconcileNonLifecycleChanges do the jobs not related to pipeline lifecycle changes.
@@ -637,6 +655,7 @@ func mergeLimits(plLimits dfv1.PipelineLimits, vLimits *dfv1.VertexLimits) dfv1.
 		result.BufferUsageLimit = vLimits.BufferUsageLimit
 		result.ReadBatchSize = vLimits.ReadBatchSize
 		result.ReadTimeout = vLimits.ReadTimeout
+		result.RateLimit = vLimits.RateLimit
 	}
 	if result.ReadBatchSize == nil {
 		result.ReadBatchSize = plLimits.ReadBatchSize
@@ -901,6 +920,57 @@ func (r *pipelineReconciler) scaleVertex(ctx context.Context, pl *dfv1.Pipeline,
 	return isVertexPatched, nil
 }
 
+// completePipeline moves the pipeline to Succeeded and scales down all the vertices once the final watermark has
+// reached every edge and all the buffers are drained, otherwise the request is requeued to check it later.
+func (r *pipelineReconciler) completePipeline(ctx context.Context, pl *dfv1.Pipeline) (ctrl.Result, error) {
+	log := logging.FromContext(ctx)
+	daemonClient, err := daemonclient.NewGRPCDaemonServiceClient(pl.GetDaemonServiceURL())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		_ = daemonClient.Close()
+	}()
+	watermarks, err := daemonClient.GetPipelineWatermarks(ctx, pl.Name)
+	if err != nil {
+		// the daemon service might not be ready yet.
+		log.Debugw("Failed to get the pipeline watermarks", zap.Error(err))
+		return ctrl.Result{RequeueAfter: dfv1.DefaultRequeueAfter}, nil
+	}
+	if !isFinalWatermarkPropagated(watermarks) {
+		return ctrl.Result{RequeueAfter: dfv1.DefaultRequeueAfter}, nil
+	}
+	drained, err := daemonClient.IsDrained(ctx, pl.Name)
+	if err != nil || !drained {
+		return ctrl.Result{RequeueAfter: dfv1.DefaultRequeueAfter}, nil
+	}
+	if _, err := r.scaleDownAllVertices(ctx, pl); err != nil {
+		return ctrl.Result{}, err
+	}
+	pl.Status.MarkPhaseSucceeded()
+	log.Info("All the sources are exhausted, and all the data has been processed")
+	r.recorder.Event(pl, corev1.EventTypeNormal, "PipelineSucceeded", "All the sources are exhausted, and all the data has been processed")
+	return ctrl.Result{}, nil
+}
+
+// isFinalWatermarkPropagated returns true if the watermarks of all the edges are the final watermark.
+func isFinalWatermarkPropagated(watermarks []*daemon.EdgeWatermark) bool {
+	if len(watermarks) == 0 {
+		return false
+	}
+	for _, ew := range watermarks {
+		if len(ew.Watermarks) == 0 {
+			return false
+		}
+		for _, w := range ew.Watermarks {
+			if !wmb.Watermark(time.UnixMilli(w.GetValue())).IsFinal() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (r *pipelineReconciler) safeToDelete(ctx context.Context, pl *dfv1.Pipeline) (bool, error) {
 	// update the phase to deleting
 	pl.Status.MarkPhaseDeleting()
@@ -19,10 +19,12 @@ package pipeline
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/goccy/go-json"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	appv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -37,7 +39,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
 	"github.com/numaproj/numaflow/pkg/reconciler"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
 
 const (
@@ -144,6 +148,65 @@ func Test_reconcile(t *testing.T) {
 	})
 }
 
+func Test_reconcileSucceeded(t *testing.T) {
+	cl := fake.NewClientBuilder().Build()
+	ctx := context.TODO()
+	testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+	testIsbSvc.Status.MarkConfigured()
+	testIsbSvc.Status.MarkDeployed()
+	err := cl.Create(ctx, testIsbSvc)
+	assert.Nil(t, err)
+	recorder := record.NewFakeRecorder(64)
+	r := &pipelineReconciler{
+		client:   cl,
+		scheme:   scheme.Scheme,
+		config:   reconciler.FakeGlobalConfig(t, fakeGlobalISBSvcConfig),
+		image:    testFlowImage,
+		logger:   zaptest.NewLogger(t).Sugar(),
+		recorder: recorder,
+	}
+	testObj := testPipeline.DeepCopy()
+	testObj.Generation = 1
+	testObj.Status.ObservedGeneration = 1
+	testObj.Status.MarkPhaseSucceeded()
+	_, err = r.reconcile(ctx, testObj)
+	assert.NoError(t, err)
+	assert.Equal(t, dfv1.PipelinePhaseSucceeded, testObj.Status.Phase)
+	// a succeeded pipeline is not reconciled.
+	vertices := &dfv1.VertexList{}
+	selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + testObj.Name)
+	err = r.client.List(ctx, vertices, &client.ListOptions{Namespace: testNamespace, LabelSelector: selector})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(vertices.Items))
+	// no warning without spec changes.
+	assert.Empty(t, recorder.Events)
+
+	testObj.Generation = 2
+	_, err = r.reconcile(ctx, testObj)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), testObj.Status.ObservedGeneration)
+	e := <-recorder.Events
+	assert.Contains(t, e, "SpecChangeIgnored")
+	assert.Contains(t, e, "the spec changes are ignored")
+}
+
+func Test_isFinalWatermarkPropagated(t *testing.T) {
+	final := wrapperspb.Int64(wmb.FinalWatermark.UnixMilli())
+	assert.False(t, isFinalWatermarkPropagated(nil))
+	assert.True(t, isFinalWatermarkPropagated([]*daemon.EdgeWatermark{
+		{Edge: "in-p1", Watermarks: []*wrapperspb.Int64Value{final, final}},
+		{Edge: "p1-out", Watermarks: []*wrapperspb.Int64Value{final}},
+	}))
+	assert.False(t, isFinalWatermarkPropagated([]*daemon.EdgeWatermark{
+		{Edge: "in-p1", Watermarks: []*wrapperspb.Int64Value{final, final}},
+		{Edge: "p1-out", Watermarks: []*wrapperspb.Int64Value{wrapperspb.Int64(time.Now().UnixMilli())}},
+	}))
+	assert.False(t, isFinalWatermarkPropagated([]*daemon.EdgeWatermark{
+		{Edge: "in-p1", Watermarks: []*wrapperspb.Int64Value{final}},
+		{Edge: "p1-out"},
+	}))
+}
+
 func Test_reconcileEvents(t *testing.T) {
 
 	fakeConfig := reconciler.FakeGlobalConfig(t, fakeGlobalISBSvcConfig)
@@ -314,7 +377,7 @@ func Test_copyVertexLimits(t *testing.T) {
 	assert.Equal(t, int64(one), int64(*v1.Limits.ReadBatchSize))
 	assert.Equal(t, "2s", v1.Limits.ReadTimeout.Duration.String())
 	two := uint64(2)
-	vertexLimitJson := `{"readTimeout": "3s"}`
+	vertexLimitJson := `{"readTimeout": "3s", "rateLimit": {"messagesPerSecond": 100}}`
 	var vertexLimit dfv1.VertexLimits
 	err = json.Unmarshal([]byte(vertexLimitJson), &vertexLimit)
 	assert.NoError(t, err)
@@ -323,6 +386,7 @@ func Test_copyVertexLimits(t *testing.T) {
 	copyVertexLimits(pl, v)
 	assert.Equal(t, two, *v.Limits.ReadBatchSize)
 	assert.Equal(t, "3s", v.Limits.ReadTimeout.Duration.String())
+	assert.Equal(t, uint64(100), v.Limits.RateLimit.MessagesPerSecond)
 }
 
 func Test_copyEdges(t *testing.T) {
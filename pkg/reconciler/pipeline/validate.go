@@ -17,11 +17,20 @@ limitations under the License.
 package pipeline
 
 import (
+	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
 )
 
 func ValidatePipeline(pl *dfv1.Pipeline) error {
@@ -103,8 +112,8 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 			if s.Source.UDSource.Container == nil || s.Source.UDSource.Container.Image == "" {
 				return fmt.Errorf("invalid user-defined source vertex %q, a customized image is required", k)
 			}
-			if s.Source.HTTP != nil || s.Source.Kafka != nil || s.Source.Nats != nil || s.Source.Generator != nil {
-				return fmt.Errorf("invalid user-defined source vertex %q, only one of 'http', 'kafka', 'nats', 'generator' and 'udSource' can be specified", k)
+			if s.Source.HTTP != nil || s.Source.Kafka != nil || s.Source.Nats != nil || s.Source.Generator != nil || s.Source.File != nil || s.Source.RedisStreams != nil {
+				return fmt.Errorf("invalid user-defined source vertex %q, only one of 'http', 'kafka', 'nats', 'generator', 'file', 'redisStreams' and 'udSource' can be specified", k)
 			}
 		}
 	}
@@ -269,9 +278,385 @@ func validateVertex(v dfv1.AbstractVertex) error {
 			return fmt.Errorf("vertex %q: sidecar container name %q is reserved for containers created by numaflow", v.Name, sc.Name)
 		}
 	}
+	if v.Limits != nil && v.Limits.RateLimit != nil {
+		if v.IsReduceUDF() {
+			return fmt.Errorf("vertex %q: rate limit is not supported for reduce vertices", v.Name)
+		}
+		if v.Limits.RateLimit.MessagesPerSecond == 0 {
+			return fmt.Errorf("vertex %q: messagesPerSecond of the rate limit should be greater than 0", v.Name)
+		}
+		if x := v.Limits.RateLimit.BytesPerSecond; x != nil && *x == 0 {
+			return fmt.Errorf("vertex %q: bytesPerSecond of the rate limit should be greater than 0", v.Name)
+		}
+	}
 	if v.UDF != nil {
 		return validateUDF(*v.UDF)
 	}
+	if v.Source != nil {
+		if err := validateSource(*v.Source); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+	}
+	if v.Sink != nil {
+		if err := validateSink(*v.Sink); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+		if err := validateFileSinkVolumes(*v.Sink, v.Volumes); err != nil {
+			return fmt.Errorf("vertex %q: %w", v.Name, err)
+		}
+		if v.Sink.Kafka != nil && v.Sink.Kafka.Transaction != nil {
+			if v.GetPartitionCount() > 1 {
+				return fmt.Errorf("vertex %q: partitions should not > 1 for transactional kafka sink vertices", v.Name)
+			}
+			// the checkpoint is kept per replica, a message redelivered to another replica would be written again.
+			if v.Scale.GetMaxReplicas() > 1 {
+				return fmt.Errorf("vertex %q: scale.max should be 1 for transactional kafka sink vertices", v.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func validateSource(source dfv1.Source) error {
+	if x := source.Generator; x != nil {
+		if x.Template != nil && !json.Valid([]byte(*x.Template)) {
+			return fmt.Errorf("invalid generator source template, it must be a valid JSON")
+		}
+		if kd := x.KeyDistribution; kd != nil {
+			switch kd.GetType() {
+			case dfv1.GeneratorKeyDistributionRoundRobin, dfv1.GeneratorKeyDistributionUniform:
+			case dfv1.GeneratorKeyDistributionZipf:
+				if _, err := kd.GetZipfExponent(); err != nil {
+					return fmt.Errorf("invalid generator source key distribution, %w", err)
+				}
+			case dfv1.GeneratorKeyDistributionHotKey:
+				if kd.GetHotKeyCount() <= 0 || (x.KeyCount != nil && kd.GetHotKeyCount() > *x.KeyCount) {
+					return fmt.Errorf("invalid generator source key distribution, hotKeyCount must be between 1 and keyCount")
+				}
+				if kd.GetHotKeyPercentage() > 100 {
+					return fmt.Errorf("invalid generator source key distribution, hotKeyPercentage must not be greater than 100")
+				}
+			default:
+				return fmt.Errorf("invalid generator source key distribution type %q", kd.Type)
+			}
+		}
+		if x.LateData != nil && x.LateData.Percentage > 100 {
+			return fmt.Errorf("invalid generator source lateData, percentage must not be greater than 100")
+		}
+		if x.MaxMessages != nil && *x.MaxMessages <= 0 {
+			return fmt.Errorf("invalid generator source maxMessages, it must be greater than 0")
+		}
+	}
+	if x := source.Kafka; x != nil {
+		if x.TopicPattern != "" {
+			if len(x.GetTopics()) > 0 {
+				return fmt.Errorf("kafka source topicPattern can not be used together with topic or topics")
+			}
+			if _, err := regexp.Compile(x.TopicPattern); err != nil {
+				return fmt.Errorf("invalid kafka source topicPattern %q, %w", x.TopicPattern, err)
+			}
+		} else if len(x.GetTopics()) == 0 {
+			return fmt.Errorf("one of topic, topics and topicPattern is required for kafka source")
+		}
+	}
+	if x := source.Kafka; x != nil && x.StartPosition != nil {
+		switch sp := x.StartPosition; sp.Type {
+		case dfv1.KafkaStartPositionEarliest, dfv1.KafkaStartPositionLatest:
+		case dfv1.KafkaStartPositionTimestamp:
+			if sp.Timestamp == nil {
+				return fmt.Errorf("timestamp is required for kafka source start position type %q", sp.Type)
+			}
+		case dfv1.KafkaStartPositionOffsets:
+			if len(sp.Offsets) == 0 {
+				return fmt.Errorf("offsets are required for kafka source start position type %q", sp.Type)
+			}
+			for p, o := range sp.Offsets {
+				if n, err := strconv.Atoi(p); err != nil || n < 0 {
+					return fmt.Errorf("invalid partition %q in kafka source start position offsets", p)
+				}
+				if o < 0 {
+					return fmt.Errorf("invalid offset %d of partition %q in kafka source start position offsets", o, p)
+				}
+			}
+		default:
+			return fmt.Errorf("invalid kafka source start position type %q", sp.Type)
+		}
+	}
+	if x := source.Kafka; x != nil && x.Rewind != nil && x.Rewind.Timestamp.IsZero() {
+		return fmt.Errorf("timestamp is required for kafka source rewind")
+	}
+	if x := source.File; x != nil {
+		if x.Path == "" {
+			return fmt.Errorf("path is required for file source")
+		}
+		if _, err := filepath.Match(x.Path, ""); err != nil {
+			return fmt.Errorf("invalid file source path %q, %w", x.Path, err)
+		}
+	}
+	if x := source.RedisStreams; x != nil {
+		if x.Stream == "" {
+			return fmt.Errorf("stream is required for redis streams source")
+		}
+		if x.URL == "" && (x.SentinelURL == "" || x.MasterName == "") {
+			return fmt.Errorf("either url, or sentinelUrl and masterName are required for redis streams source")
+		}
+	}
+	if x := source.JetStream; x != nil {
+		switch x.DeliverPolicy {
+		case dfv1.JetStreamDeliverByStartTime:
+			if x.StartTime == nil {
+				return fmt.Errorf("startTime is required for jetstream source deliver policy %q", x.DeliverPolicy)
+			}
+		case dfv1.JetStreamDeliverByStartSequence:
+			if x.StartSequence == 0 {
+				return fmt.Errorf("startSequence is required for jetstream source deliver policy %q", x.DeliverPolicy)
+			}
+		}
+	}
+	return nil
+}
+
+func validateSink(sink dfv1.Sink) error {
+	if x := sink.Kafka; x != nil {
+		if err := validateKafkaSink(*x); err != nil {
+			return err
+		}
+	}
+	if x := sink.Fallback; x != nil && x.Kafka != nil {
+		if x.Kafka.Transaction != nil {
+			return fmt.Errorf("transaction is not supported for fallback kafka sink")
+		}
+		if err := validateKafkaSink(*x.Kafka); err != nil {
+			return fmt.Errorf("invalid fallback sink, %w", err)
+		}
+	}
+	if x := sink.HTTP; x != nil {
+		if err := validateHTTPSink(*x); err != nil {
+			return err
+		}
+	}
+	if x := sink.Fallback; x != nil && x.HTTP != nil {
+		if err := validateHTTPSink(*x.HTTP); err != nil {
+			return fmt.Errorf("invalid fallback sink, %w", err)
+		}
+	}
+	if x := sink.File; x != nil {
+		if err := validateFileSink(*x); err != nil {
+			return err
+		}
+	}
+	if x := sink.Nats; x != nil {
+		if err := validateNatsSink("nats", x.URL, x.Subject); err != nil {
+			return err
+		}
+	}
+	if x := sink.Fallback; x != nil && x.Nats != nil {
+		if err := validateNatsSink("nats", x.Nats.URL, x.Nats.Subject); err != nil {
+			return fmt.Errorf("invalid fallback sink, %w", err)
+		}
+	}
+	if x := sink.JetStream; x != nil {
+		if err := validateNatsSink("jetstream", x.URL, x.Subject); err != nil {
+			return err
+		}
+	}
+	if x := sink.Fallback; x != nil && x.JetStream != nil {
+		if err := validateNatsSink("jetstream", x.JetStream.URL, x.JetStream.Subject); err != nil {
+			return fmt.Errorf("invalid fallback sink, %w", err)
+		}
+	}
+	if x := sink.Fallback; x != nil && x.File != nil {
+		if err := validateFileSink(*x.File); err != nil {
+			return fmt.Errorf("invalid fallback sink, %w", err)
+		}
+	}
+	if x := sink.SQL; x != nil {
+		if err := validateSQLSink(*x); err != nil {
+			return err
+		}
+	}
+	if x := sink.Fallback; x != nil && x.SQL != nil {
+		if err := validateSQLSink(*x.SQL); err != nil {
+			return fmt.Errorf("invalid fallback sink, %w", err)
+		}
+	}
+	if x := sink.RetryStrategy; x != nil {
+		if err := validateSinkRetryStrategy(*x, sink.Fallback != nil); err != nil {
+			return fmt.Errorf("invalid retry strategy of sink, %w", err)
+		}
+	}
+	return nil
+}
+
+func validateSinkRetryStrategy(rs dfv1.SinkRetryStrategy, hasFallback bool) error {
+	switch rs.GetOnExhausted() {
+	case dfv1.SinkRetryExhaustedFallback:
+		if !hasFallback {
+			return fmt.Errorf("fallback sink is required for onExhausted action %q", dfv1.SinkRetryExhaustedFallback)
+		}
+	case dfv1.SinkRetryExhaustedDrop, dfv1.SinkRetryExhaustedRetryForever:
+	default:
+		return fmt.Errorf("invalid onExhausted action %q", rs.OnExhausted)
+	}
+	if _, err := rs.GetFactor(); err != nil {
+		return err
+	}
+	if rs.GetMaxInterval() < rs.GetInterval() {
+		return fmt.Errorf("backoff max interval %v should not be less than the interval %v", rs.GetMaxInterval(), rs.GetInterval())
+	}
+	if rs.GetJitterPercentage() > 100 {
+		return fmt.Errorf("backoff jitter percentage should not be greater than 100")
+	}
+	return nil
+}
+
+func validateHTTPSink(hs dfv1.HTTPSink) error {
+	if hs.URL == "" {
+		return fmt.Errorf("url is required for http sink")
+	}
+	if strings.Count(hs.URL, "{{") != strings.Count(hs.URL, "}}") {
+		return fmt.Errorf("invalid url template %q of http sink, unbalanced \"{{\" and \"}}\"", hs.URL)
+	}
+	if a := hs.Auth; a != nil && a.Basic != nil && a.Token != nil {
+		return fmt.Errorf("only one of basic and token auth can be specified for http sink")
+	}
+	if a := hs.Auth; a != nil && a.Basic != nil && a.Basic.User == nil {
+		return fmt.Errorf("user is required for the basic auth of http sink")
+	}
+	return nil
+}
+
+// validateKafkaSink validates the transaction and the expressions of the kafka sink.
+func validateKafkaSink(sink dfv1.KafkaSink) error {
+	if x := sink.Transaction; x != nil && x.CheckpointTopic == "" {
+		return fmt.Errorf("checkpointTopic is required for transactional kafka sink")
+	}
+	if sink.TopicExpression != "" {
+		if err := expr.CompileWithMetadata(sink.TopicExpression); err != nil {
+			return fmt.Errorf("invalid topicExpression of kafka sink, %w", err)
+		}
+	}
+	if x := sink.Key; x != nil && x.Expression != "" {
+		if err := expr.CompileWithMetadata(x.Expression); err != nil {
+			return fmt.Errorf("invalid key expression of kafka sink, %w", err)
+		}
+	}
+	return nil
+}
+
+// validateNatsSink validates the url and the subject of the nats and jetstream sinks.
+func validateNatsSink(sinkType, url, subject string) error {
+	if url == "" {
+		return fmt.Errorf("url is required for %s sink", sinkType)
+	}
+	if subject == "" {
+		return fmt.Errorf("subject is required for %s sink", sinkType)
+	}
+	t, err := expr.ParseTemplate(subject)
+	if err != nil {
+		return fmt.Errorf("invalid subject %q of %s sink, %w", subject, sinkType, err)
+	}
+	if !t.HasExpressions() {
+		if err := natsclient.ValidatePublishSubject(subject); err != nil {
+			return fmt.Errorf("invalid subject of %s sink, %w", sinkType, err)
+		}
+	}
+	return nil
+}
+
+func validateFileSink(fs dfv1.FileSink) error {
+	if fs.Volume == "" {
+		return fmt.Errorf("volume is required for file sink")
+	}
+	if fs.Path != "" && !filepath.IsLocal(fs.Path) {
+		return fmt.Errorf("invalid path %q of file sink, it should be a relative path in the volume", fs.Path)
+	}
+	if strings.Contains(fs.Prefix, "/") || strings.HasPrefix(fs.Prefix, ".") {
+		return fmt.Errorf("invalid prefix %q of file sink, it should not contain \"/\" or start with \".\"", fs.Prefix)
+	}
+	switch fs.GetFormat() {
+	case dfv1.FileSinkFormatCSV, dfv1.FileSinkFormatParquet:
+		if len(fs.Columns) == 0 {
+			return fmt.Errorf("columns are required for file sink format %q", fs.GetFormat())
+		}
+		seen := make(map[string]bool)
+		for _, c := range fs.Columns {
+			if c == "" || seen[c] {
+				return fmt.Errorf("invalid columns of file sink, column names should be non-empty and unique")
+			}
+			seen[c] = true
+		}
+	default:
+		if len(fs.Columns) > 0 {
+			return fmt.Errorf("columns are only supported by file sink formats %q and %q", dfv1.FileSinkFormatCSV, dfv1.FileSinkFormatParquet)
+		}
+	}
+	if r := fs.Rotation; r != nil && r.Window != nil && r.Window.Duration != 0 && r.Window.Duration < time.Second {
+		return fmt.Errorf("rotation window of file sink should be at least 1s")
+	}
+	return nil
+}
+
+// validateFileSinkVolumes checks the volumes of the file sinks are in the volumes of the vertex.
+func validateFileSinkVolumes(sink dfv1.Sink, volumes []corev1.Volume) error {
+	fileSinks := []*dfv1.FileSink{sink.File}
+	if sink.Fallback != nil {
+		fileSinks = append(fileSinks, sink.Fallback.File)
+	}
+	for _, fs := range fileSinks {
+		if fs == nil {
+			continue
+		}
+		found := false
+		for _, v := range volumes {
+			if v.Name == fs.Volume {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("volume %q of file sink is not found in the volumes", fs.Volume)
+		}
+	}
+	return nil
+}
+
+func validateSQLSink(ss dfv1.SQLSink) error {
+	switch ss.Driver {
+	case dfv1.SQLSinkDriverPostgres, dfv1.SQLSinkDriverMySQL:
+	default:
+		return fmt.Errorf("invalid driver %q of sql sink, it should be one of %q and %q", ss.Driver, dfv1.SQLSinkDriverPostgres, dfv1.SQLSinkDriverMySQL)
+	}
+	if ss.DSN == nil {
+		return fmt.Errorf("dsn is required for sql sink")
+	}
+	if ss.Table == "" {
+		return fmt.Errorf("table is required for sql sink")
+	}
+	for _, p := range strings.Split(ss.Table, ".") {
+		if p == "" {
+			return fmt.Errorf("invalid table %q of sql sink", ss.Table)
+		}
+	}
+	if len(ss.Columns) == 0 && len(ss.KeyColumns) == 0 {
+		return fmt.Errorf("columns or key columns are required for sql sink")
+	}
+	seen := make(map[string]bool)
+	for _, c := range ss.KeyColumns {
+		if c == "" || seen[c] {
+			return fmt.Errorf("invalid columns of sql sink, column names should be non-empty and unique")
+		}
+		seen[c] = true
+	}
+	for _, c := range ss.Columns {
+		if c.Name == "" || seen[c.Name] {
+			return fmt.Errorf("invalid columns of sql sink, column names should be non-empty and unique")
+		}
+		seen[c.Name] = true
+	}
+	if ss.GetMode() == dfv1.SQLSinkModeUpsert && len(ss.KeyColumns) == 0 {
+		return fmt.Errorf("key columns are required for sql sink mode %q", dfv1.SQLSinkModeUpsert)
+	}
 	return nil
 }
 
@@ -677,6 +677,466 @@ func TestValidateVertex(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), `"sidecars" are not supported for source vertices`)
 	})
+
+	t.Run("transactional kafka sink with multiple partitions", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					Kafka: &dfv1.KafkaSink{Transaction: &dfv1.KafkaSinkTransaction{CheckpointTopic: "checkpoint"}},
+				},
+			},
+			Partitions: ptr.To[int32](2),
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "partitions should not > 1 for transactional kafka sink vertices")
+	})
+
+	t.Run("transactional kafka sink with multiple replicas", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					Kafka: &dfv1.KafkaSink{Transaction: &dfv1.KafkaSinkTransaction{CheckpointTopic: "checkpoint"}},
+				},
+			},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "scale.max should be 1 for transactional kafka sink vertices")
+		v.Scale.Max = ptr.To[int32](1)
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("file sink volume", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{File: &dfv1.FileSink{Volume: "data"}},
+				Fallback:     &dfv1.AbstractSink{File: &dfv1.FileSink{Volume: "dlq"}},
+			},
+			Volumes: []corev1.Volume{{Name: "data"}},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `volume "dlq" of file sink is not found`)
+
+		v.Volumes = append(v.Volumes, corev1.Volume{Name: "dlq"})
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name:   "my-vertex",
+			Limits: &dfv1.VertexLimits{RateLimit: &dfv1.RateLimit{MessagesPerSecond: 100, BytesPerSecond: ptr.To[uint64](1024)}},
+		}
+		assert.NoError(t, validateVertex(v))
+		v.Limits.RateLimit.BytesPerSecond = ptr.To[uint64](0)
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "bytesPerSecond of the rate limit should be greater than 0")
+		v.Limits.RateLimit = &dfv1.RateLimit{}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "messagesPerSecond of the rate limit should be greater than 0")
+		v.Limits.RateLimit.MessagesPerSecond = 100
+		v.UDF = &dfv1.UDF{GroupBy: &dfv1.GroupBy{}}
+		err = validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "rate limit is not supported for reduce vertices")
+	})
+}
+
+func TestValidateSource(t *testing.T) {
+	ts := metav1.Now()
+	t.Run("valid kafka start position", func(t *testing.T) {
+		for _, sp := range []dfv1.KafkaStartPosition{
+			{Type: dfv1.KafkaStartPositionEarliest},
+			{Type: dfv1.KafkaStartPositionTimestamp, Timestamp: &ts},
+			{Type: dfv1.KafkaStartPositionOffsets, Offsets: map[string]int64{"0": 10}},
+		} {
+			sp := sp
+			assert.NoError(t, validateSource(dfv1.Source{Kafka: &dfv1.KafkaSource{Topic: "t", StartPosition: &sp}}))
+		}
+	})
+
+	t.Run("invalid kafka start position", func(t *testing.T) {
+		err := validateSource(dfv1.Source{Kafka: &dfv1.KafkaSource{Topic: "t", StartPosition: &dfv1.KafkaStartPosition{Type: dfv1.KafkaStartPositionTimestamp}}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "timestamp is required")
+		err = validateSource(dfv1.Source{Kafka: &dfv1.KafkaSource{Topic: "t", StartPosition: &dfv1.KafkaStartPosition{Type: dfv1.KafkaStartPositionOffsets, Offsets: map[string]int64{"a": 10}}}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid partition")
+		err = validateSource(dfv1.Source{Kafka: &dfv1.KafkaSource{Topic: "t", StartPosition: &dfv1.KafkaStartPosition{Type: "unknown"}}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid kafka source start position type")
+	})
+
+	t.Run("kafka topics", func(t *testing.T) {
+		assert.NoError(t, validateSource(dfv1.Source{Kafka: &dfv1.KafkaSource{Topics: []string{"a", "b"}}}))
+		assert.NoError(t, validateSource(dfv1.Source{Kafka: &dfv1.KafkaSource{TopicPattern: "^orders-.*"}}))
+		err := validateSource(dfv1.Source{Kafka: &dfv1.KafkaSource{}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "one of topic, topics and topicPattern is required")
+		err = validateSource(dfv1.Source{Kafka: &dfv1.KafkaSource{Topic: "a", TopicPattern: "^orders-.*"}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not be used together")
+		err = validateSource(dfv1.Source{Kafka: &dfv1.KafkaSource{TopicPattern: "("}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid kafka source topicPattern")
+	})
+
+	t.Run("jetstream deliver policy", func(t *testing.T) {
+		assert.NoError(t, validateSource(dfv1.Source{JetStream: &dfv1.JetStreamSource{DeliverPolicy: dfv1.JetStreamDeliverByStartTime, StartTime: &ts}}))
+		assert.NoError(t, validateSource(dfv1.Source{JetStream: &dfv1.JetStreamSource{DeliverPolicy: dfv1.JetStreamDeliverByStartSequence, StartSequence: 10}}))
+		err := validateSource(dfv1.Source{JetStream: &dfv1.JetStreamSource{DeliverPolicy: dfv1.JetStreamDeliverByStartTime}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "startTime is required")
+		err = validateSource(dfv1.Source{JetStream: &dfv1.JetStreamSource{DeliverPolicy: dfv1.JetStreamDeliverByStartSequence}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "startSequence is required")
+	})
+
+	t.Run("kafka rewind without timestamp", func(t *testing.T) {
+		err := validateSource(dfv1.Source{Kafka: &dfv1.KafkaSource{Topic: "t", Rewind: &dfv1.KafkaRewind{}}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "timestamp is required for kafka source rewind")
+	})
+
+	t.Run("file path", func(t *testing.T) {
+		assert.NoError(t, validateSource(dfv1.Source{File: &dfv1.FileSource{Path: "/data/*.log"}}))
+		err := validateSource(dfv1.Source{File: &dfv1.FileSource{}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "path is required for file source")
+		err = validateSource(dfv1.Source{File: &dfv1.FileSource{Path: "/data/[a"}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid file source path")
+	})
+
+	t.Run("generator", func(t *testing.T) {
+		template := `{"id": "{{uuid}}"}`
+		assert.NoError(t, validateSource(dfv1.Source{Generator: &dfv1.GeneratorSource{
+			Template:        &template,
+			KeyCount:        ptr.To[int32](10),
+			KeyDistribution: &dfv1.GeneratorKeyDistribution{Type: dfv1.GeneratorKeyDistributionZipf, ZipfExponent: "1.5"},
+			LateData:        &dfv1.GeneratorLateData{Percentage: 10},
+		}}))
+		invalid := `{"id": `
+		err := validateSource(dfv1.Source{Generator: &dfv1.GeneratorSource{Template: &invalid}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid generator source template")
+		err = validateSource(dfv1.Source{Generator: &dfv1.GeneratorSource{KeyDistribution: &dfv1.GeneratorKeyDistribution{Type: dfv1.GeneratorKeyDistributionZipf, ZipfExponent: "0.5"}}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid zipf exponent")
+		err = validateSource(dfv1.Source{Generator: &dfv1.GeneratorSource{KeyCount: ptr.To[int32](2), KeyDistribution: &dfv1.GeneratorKeyDistribution{Type: dfv1.GeneratorKeyDistributionHotKey, HotKeyCount: ptr.To[int32](3)}}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "hotKeyCount must be between 1 and keyCount")
+		err = validateSource(dfv1.Source{Generator: &dfv1.GeneratorSource{LateData: &dfv1.GeneratorLateData{Percentage: 101}}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "percentage must not be greater than 100")
+		err = validateSource(dfv1.Source{Generator: &dfv1.GeneratorSource{MaxMessages: ptr.To[int64](0)}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "maxMessages, it must be greater than 0")
+	})
+
+	t.Run("redis streams", func(t *testing.T) {
+		assert.NoError(t, validateSource(dfv1.Source{RedisStreams: &dfv1.RedisStreamsSource{RedisConfig: dfv1.RedisConfig{URL: "redis:6379"}, Stream: "s"}}))
+		assert.NoError(t, validateSource(dfv1.Source{RedisStreams: &dfv1.RedisStreamsSource{RedisConfig: dfv1.RedisConfig{SentinelURL: "sentinel:26379", MasterName: "m"}, Stream: "s"}}))
+		err := validateSource(dfv1.Source{RedisStreams: &dfv1.RedisStreamsSource{RedisConfig: dfv1.RedisConfig{URL: "redis:6379"}}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "stream is required")
+		err = validateSource(dfv1.Source{RedisStreams: &dfv1.RedisStreamsSource{RedisConfig: dfv1.RedisConfig{SentinelURL: "sentinel:26379"}, Stream: "s"}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "either url, or sentinelUrl and masterName are required")
+	})
+}
+
+func TestValidateSink(t *testing.T) {
+	t.Run("transactional kafka sink without checkpoint topic", func(t *testing.T) {
+		sink := dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{
+				Kafka: &dfv1.KafkaSink{Transaction: &dfv1.KafkaSinkTransaction{}},
+			},
+		}
+		err := validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "checkpointTopic is required")
+	})
+
+	t.Run("transactional fallback kafka sink", func(t *testing.T) {
+		sink := dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{Log: &dfv1.Log{}},
+			Fallback: &dfv1.AbstractSink{
+				Kafka: &dfv1.KafkaSink{Transaction: &dfv1.KafkaSinkTransaction{CheckpointTopic: "checkpoint"}},
+			},
+		}
+		err := validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "transaction is not supported for fallback kafka sink")
+	})
+
+	t.Run("kafka sink expressions", func(t *testing.T) {
+		sink := dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{
+				Kafka: &dfv1.KafkaSink{
+					TopicExpression: "json(payload).topic",
+					Key:             &dfv1.KafkaSinkKey{Expression: "headers['x-id'] + keys[0]"},
+				},
+			},
+		}
+		assert.NoError(t, validateSink(sink))
+
+		sink.Kafka.Key.Expression = "json(payload).id +"
+		err := validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid key expression of kafka sink")
+
+		sink.Kafka.Key.Expression = ""
+		sink.Kafka.TopicExpression = "unknown(payload)"
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid topicExpression of kafka sink")
+	})
+
+	t.Run("http sink", func(t *testing.T) {
+		sink := dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{HTTP: &dfv1.HTTPSink{URL: "https://example.com/{{json(payload).id}}"}},
+		}
+		assert.NoError(t, validateSink(sink))
+
+		sink.HTTP.URL = ""
+		err := validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "url is required")
+
+		sink.HTTP.URL = "https://example.com/{{json(payload).id"
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unbalanced")
+
+		sink.HTTP.URL = "https://example.com"
+		sink.HTTP.Auth = &dfv1.HTTPSinkAuth{Basic: &dfv1.BasicAuth{User: &corev1.SecretKeySelector{}}, Token: &corev1.SecretKeySelector{}}
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only one of basic and token auth")
+
+		sink.HTTP.Auth = &dfv1.HTTPSinkAuth{Basic: &dfv1.BasicAuth{}}
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "user is required")
+	})
+
+	t.Run("fallback http sink", func(t *testing.T) {
+		sink := dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{Log: &dfv1.Log{}},
+			Fallback:     &dfv1.AbstractSink{HTTP: &dfv1.HTTPSink{}},
+		}
+		err := validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid fallback sink")
+	})
+
+	t.Run("file sink", func(t *testing.T) {
+		sink := dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{File: &dfv1.FileSink{Volume: "data", Path: "events/raw"}},
+		}
+		assert.NoError(t, validateSink(sink))
+
+		sink.File.Volume = ""
+		err := validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "volume is required")
+
+		sink.File.Volume = "data"
+		sink.File.Path = "../events"
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid path")
+
+		sink.File.Path = ""
+		sink.File.Prefix = "a/b"
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid prefix")
+
+		sink.File.Prefix = ""
+		sink.File.Format = dfv1.FileSinkFormatParquet
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "columns are required")
+
+		sink.File.Columns = []string{"id", "id"}
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unique")
+
+		sink.File.Columns = []string{"id", "name"}
+		assert.NoError(t, validateSink(sink))
+
+		sink.File.Format = dfv1.FileSinkFormatJSONL
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "columns are only supported")
+
+		sink.File.Columns = nil
+		sink.File.Rotation = &dfv1.FileSinkRotation{Window: &metav1.Duration{Duration: time.Millisecond}}
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "at least 1s")
+	})
+
+	t.Run("fallback file sink", func(t *testing.T) {
+		sink := dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{Log: &dfv1.Log{}},
+			Fallback:     &dfv1.AbstractSink{File: &dfv1.FileSink{}},
+		}
+		err := validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid fallback sink")
+	})
+
+	t.Run("nats sink", func(t *testing.T) {
+		sink := dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{Nats: &dfv1.NatsSink{URL: "nats://nats:4222", Subject: "orders.{{json(payload).region}}"}},
+		}
+		assert.NoError(t, validateSink(sink))
+
+		sink.Nats.URL = ""
+		err := validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "url is required")
+
+		sink.Nats.URL = "nats://nats:4222"
+		sink.Nats.Subject = ""
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "subject is required")
+
+		sink.Nats.Subject = "orders.{{json(payload).region"
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid subject")
+
+		sink.Nats.Subject = "orders.*"
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid subject")
+	})
+
+	t.Run("jetstream sink", func(t *testing.T) {
+		sink := dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{JetStream: &dfv1.JetStreamSink{URL: "nats://nats:4222", Subject: "orders.us", Stream: "ORDERS"}},
+		}
+		assert.NoError(t, validateSink(sink))
+
+		sink.JetStream.Subject = "orders..us"
+		err := validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid subject")
+	})
+
+	t.Run("sql sink", func(t *testing.T) {
+		sink := dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{SQL: &dfv1.SQLSink{
+				Driver:     dfv1.SQLSinkDriverPostgres,
+				DSN:        &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "db"}, Key: "dsn"},
+				Table:      "public.events",
+				Columns:    []dfv1.SQLSinkColumn{{Name: "name"}, {Name: "city", Field: "address.city"}},
+				KeyColumns: []string{"id"},
+				Mode:       dfv1.SQLSinkModeUpsert,
+			}},
+		}
+		assert.NoError(t, validateSink(sink))
+
+		sink.SQL.Driver = "sqlserver"
+		err := validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid driver")
+
+		sink.SQL.Driver = dfv1.SQLSinkDriverMySQL
+		sink.SQL.Table = "public."
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid table")
+
+		sink.SQL.Table = "events"
+		sink.SQL.Columns = append(sink.SQL.Columns, dfv1.SQLSinkColumn{Name: "id"})
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unique")
+
+		sink.SQL.Columns = sink.SQL.Columns[:2]
+		sink.SQL.KeyColumns = nil
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "key columns are required")
+
+		sink.SQL.Mode = ""
+		assert.NoError(t, validateSink(sink))
+
+		sink.SQL.DSN = nil
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "dsn is required")
+	})
+
+	t.Run("fallback nats sink", func(t *testing.T) {
+		sink := dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{Log: &dfv1.Log{}},
+			Fallback:     &dfv1.AbstractSink{JetStream: &dfv1.JetStreamSink{URL: "nats://nats:4222"}},
+		}
+		err := validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid fallback sink")
+	})
+
+	t.Run("retry strategy", func(t *testing.T) {
+		sink := dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{Log: &dfv1.Log{}},
+			RetryStrategy: &dfv1.SinkRetryStrategy{
+				MaxAttempts: ptr.To[uint32](5),
+				Backoff:     &dfv1.SinkRetryBackoff{Interval: &metav1.Duration{Duration: time.Second}, Factor: "1.5", JitterPercentage: ptr.To[uint32](20)},
+				OnExhausted: dfv1.SinkRetryExhaustedDrop,
+			},
+		}
+		assert.NoError(t, validateSink(sink))
+
+		sink.RetryStrategy.OnExhausted = dfv1.SinkRetryExhaustedFallback
+		err := validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "fallback sink is required")
+
+		sink.Fallback = &dfv1.AbstractSink{Log: &dfv1.Log{}}
+		assert.NoError(t, validateSink(sink))
+
+		sink.RetryStrategy.Backoff.Factor = "0.5"
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "at least 1")
+
+		sink.RetryStrategy.Backoff.Factor = ""
+		sink.RetryStrategy.Backoff.MaxInterval = &metav1.Duration{Duration: time.Millisecond}
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "max interval")
+
+		sink.RetryStrategy.Backoff.MaxInterval = nil
+		sink.RetryStrategy.Backoff.JitterPercentage = ptr.To[uint32](101)
+		err = validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "jitter percentage")
+	})
+
+	t.Run("fallback sql sink", func(t *testing.T) {
+		sink := dfv1.Sink{
+			AbstractSink: dfv1.AbstractSink{Log: &dfv1.Log{}},
+			Fallback:     &dfv1.AbstractSink{SQL: &dfv1.SQLSink{Driver: dfv1.SQLSinkDriverPostgres}},
+		}
+		err := validateSink(sink)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid fallback sink")
+	})
 }
 
 func TestValidateUDF(t *testing.T) {
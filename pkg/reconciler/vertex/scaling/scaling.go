@@ -166,7 +166,7 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 		log.Debug("Vertex being deleted.")
 		return nil
 	}
-	if !vertex.Scalable() { // A vertex which is not scalable, such as a Reducer, or autoscaling disabled.
+	if !vertex.Scalable() { // A vertex which is not scalable, such as a Reducer without persisted storage, or autoscaling disabled.
 		s.StopWatching(key) // Remove it in case it's watched.
 		return nil
 	}
@@ -200,6 +200,10 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 		log.Debug("Corresponding Pipeline not in Running state.")
 		return nil
 	}
+	if pl.Status.Phase == dfv1.PipelinePhaseSucceeded {
+		log.Debug("Corresponding Pipeline has succeeded.")
+		return nil
+	}
 	if int(vertex.Status.Replicas) != vertex.GetReplicas() {
 		log.Debugf("Vertex %s might be under processing, replicas mismatch.", vertex.Name)
 		return nil
@@ -288,6 +292,10 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 		_ = s.vertexMetricsCache.Add(key+"/length", totalBufferLength)
 	}
 
+	if vertex.IsReduceUDF() {
+		return s.scaleReduceVertex(ctx, vertex, totalRate, totalPending, secondsSinceLastScale, scaleDownCooldown)
+	}
+
 	var desired int32
 	current := int32(vertex.GetReplicas())
 	// if both totalRate and totalPending are 0, we scale down to 0
@@ -351,6 +359,26 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 	return nil
 }
 
+// scaleReduceVertex only supports scaling a reduce vertex to zero, it never scales to a replica number between 0 and
+// the partition count. Each replica of a reduce vertex owns one partition of the buffer, together with the PBQ/WAL state
+// persisted in its own PVC, and the state is neither drained nor moved to other replicas, so the vertex is scaled down
+// to 0 when there's no pending message and no processing rate, and back to the partition count (any non-zero replica
+// number of a reduce vertex resolves to the partition count) when there are pending messages. While the vertex is at 0, the open windows are not
+// closed, and the watermark of the vertex and its downstream vertices does not progress, until new data arrives and the
+// same replicas replay their WAL. A reduce vertex is only watched by the autoscaler when it's opted in with scale.min
+// explicitly set to 0, see Vertex.Scalable().
+func (s *Scaler) scaleReduceVertex(ctx context.Context, vertex *dfv1.Vertex, totalRate float64, totalPending int64, secondsSinceLastScale, scaleDownCooldown float64) error {
+	log := logging.FromContext(ctx)
+	if totalPending != 0 || totalRate != 0 {
+		return nil
+	}
+	if secondsSinceLastScale < scaleDownCooldown {
+		log.Debugf("Cooldown period for scaling down, skip scaling.")
+		return nil
+	}
+	return s.patchVertexReplicas(ctx, vertex, 0)
+}
+
 func (s *Scaler) desiredReplicas(_ context.Context, vertex *dfv1.Vertex, partitionProcessingRate []float64, partitionPending []int64, partitionBufferLengths []int64, partitionAvailableBufferLengths []int64) int32 {
 	maxDesired := int32(1)
 	// We calculate the max desired replicas based on the pending messages and processing rate for each partition.
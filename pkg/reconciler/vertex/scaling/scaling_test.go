@@ -21,7 +21,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -109,3 +112,49 @@ func Test_desiredReplicasMultiplePartitions(t *testing.T) {
 	assert.Equal(t, int32(4), s.desiredReplicas(context.TODO(), udf, []float64{5000, 3000, 5000}, []int64{0, 30000, 1}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}))
 	assert.Equal(t, int32(4), s.desiredReplicas(context.TODO(), udf, []float64{1000, 3000, 1000}, []int64{0, 27000, 3000}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}))
 }
+
+func Test_scaleReduceVertex(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = dfv1.AddToScheme(scheme)
+	vtx := &dfv1.Vertex{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-ns",
+			Name:      "test-pl-reduce",
+		},
+		Spec: dfv1.VertexSpec{
+			Replicas: ptr.To[int32](2),
+			AbstractVertex: dfv1.AbstractVertex{
+				Partitions: ptr.To[int32](2),
+				Scale:      dfv1.Scale{Min: ptr.To[int32](0)},
+				UDF: &dfv1.UDF{
+					GroupBy: &dfv1.GroupBy{
+						Keyed: true,
+						Storage: &dfv1.PBQStorage{
+							PersistentVolumeClaim: &dfv1.PersistenceStrategy{},
+						},
+					},
+				},
+			},
+		},
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(vtx.DeepCopy()).Build()
+	s := NewScaler(cl)
+	ctx := context.TODO()
+	getReplicas := func() int {
+		v := &dfv1.Vertex{}
+		assert.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(vtx), v))
+		return v.GetReplicas()
+	}
+
+	// Busy reduce vertex is kept at the partition count.
+	assert.NoError(t, s.scaleReduceVertex(ctx, vtx.DeepCopy(), 10, 100, 1000, 90))
+	assert.Equal(t, 2, getReplicas())
+
+	// In the cooldown period.
+	assert.NoError(t, s.scaleReduceVertex(ctx, vtx.DeepCopy(), 0, 0, 10, 90))
+	assert.Equal(t, 2, getReplicas())
+
+	// Idle reduce vertex is scaled down to 0.
+	assert.NoError(t, s.scaleReduceVertex(ctx, vtx.DeepCopy(), 0, 0, 1000, 90))
+	assert.Equal(t, 0, getReplicas())
+}
@@ -42,8 +42,32 @@ type Client struct {
 	log   *zap.SugaredLogger
 }
 
-// NewNATSClient Create a new NATS client
+// NewNATSClient Create a new NATS client of the inter-step buffer service
 func NewNATSClient(ctx context.Context, natsOptions ...nats.Option) (*Client, error) {
+	url, existing := os.LookupEnv(dfv1.EnvISBSvcJetStreamURL)
+	if !existing {
+		return nil, fmt.Errorf("environment variable %q not found", dfv1.EnvISBSvcJetStreamURL)
+	}
+	user, existing := os.LookupEnv(dfv1.EnvISBSvcJetStreamUser)
+	if !existing {
+		return nil, fmt.Errorf("environment variable %q not found", dfv1.EnvISBSvcJetStreamUser)
+	}
+	password, existing := os.LookupEnv(dfv1.EnvISBSvcJetStreamPassword)
+	if !existing {
+		return nil, fmt.Errorf("environment variable %q not found", dfv1.EnvISBSvcJetStreamPassword)
+	}
+	// Pass nats options for username password
+	opts := []nats.Option{nats.UserInfo(user, password)}
+	if sharedutil.LookupEnvStringOr(dfv1.EnvISBSvcJetStreamTLSEnabled, "false") == "true" {
+		opts = append(opts, nats.Secure(&tls.Config{
+			InsecureSkipVerify: true,
+		}))
+	}
+	return NewClient(ctx, url, append(opts, natsOptions...)...)
+}
+
+// NewClient creates a new NATS client of the url, with the default options to reconnect forever.
+func NewClient(ctx context.Context, url string, natsOptions ...nats.Option) (*Client, error) {
 	log := logging.FromContext(ctx)
 	var jsCtx nats.JetStreamContext
 	opts := []nats.Option{
@@ -83,26 +107,6 @@ func NewNATSClient(ctx context.Context, natsOptions ...nats.Option) (*Client, er
 		}),
 	}
 
-	url, existing := os.LookupEnv(dfv1.EnvISBSvcJetStreamURL)
-	if !existing {
-		return nil, fmt.Errorf("environment variable %q not found", dfv1.EnvISBSvcJetStreamURL)
-	}
-	user, existing := os.LookupEnv(dfv1.EnvISBSvcJetStreamUser)
-	if !existing {
-		return nil, fmt.Errorf("environment variable %q not found", dfv1.EnvISBSvcJetStreamUser)
-	}
-	password, existing := os.LookupEnv(dfv1.EnvISBSvcJetStreamPassword)
-	if !existing {
-		return nil, fmt.Errorf("environment variable %q not found", dfv1.EnvISBSvcJetStreamPassword)
-	}
-	// Pass nats options for username password
-	opts = append(opts, nats.UserInfo(user, password))
-	if sharedutil.LookupEnvStringOr(dfv1.EnvISBSvcJetStreamTLSEnabled, "false") == "true" {
-		opts = append(opts, nats.Secure(&tls.Config{
-			InsecureSkipVerify: true,
-		}))
-	}
-
 	opts = append(opts, natsOptions...)
 	if nc, err := nats.Connect(url, opts...); err != nil {
 		return nil, fmt.Errorf("failed to connect to nats url=%s: %w", url, err)
@@ -115,6 +119,50 @@ func NewNATSClient(ctx context.Context, natsOptions ...nats.Option) (*Client, er
 	}
 }
 
+// ConnectionOptions returns the nats options of the TLS and the auth, which are used to connect to the NATS servers
+// other than the inter-step buffer service, e.g. for the nats sources and sinks.
+func ConnectionOptions(tlsConfig *dfv1.TLS, auth *dfv1.NatsAuth) ([]nats.Option, error) {
+	var opts []nats.Option
+	if tlsConfig != nil {
+		c, err := sharedutil.GetTLSConfig(tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, nats.Secure(c))
+	}
+	if auth != nil {
+		switch {
+		case auth.Basic != nil && auth.Basic.User != nil && auth.Basic.Password != nil:
+			username, err := sharedutil.GetSecretFromVolume(auth.Basic.User)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get basic auth user, %w", err)
+			}
+			password, err := sharedutil.GetSecretFromVolume(auth.Basic.Password)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get basic auth password, %w", err)
+			}
+			opts = append(opts, nats.UserInfo(username, password))
+		case auth.Token != nil:
+			token, err := sharedutil.GetSecretFromVolume(auth.Token)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get auth token, %w", err)
+			}
+			opts = append(opts, nats.Token(token))
+		case auth.NKey != nil:
+			nkeyFile, err := sharedutil.GetSecretVolumePath(auth.NKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get configured nkey file, %w", err)
+			}
+			o, err := nats.NkeyOptionFromSeed(nkeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get NKey, %w", err)
+			}
+			opts = append(opts, o)
+		}
+	}
+	return opts, nil
+}
+
 // Subscribe returns a subscription for the given subject and stream
 func (c *Client) Subscribe(subject string, stream string, opts ...nats.SubOpt) (*nats.Subscription, error) {
 	var (
@@ -162,6 +210,16 @@ func (c *Client) PendingForStream(consumer string, stream string) (int64, error)
 	return int64(cInfo.NumPending) + int64(cInfo.NumAckPending), nil
 }
 
+// PublishMsg publishes the message to the subject of the message
+func (c *Client) PublishMsg(msg *nats.Msg) error {
+	return c.nc.PublishMsg(msg)
+}
+
+// Flush flushes the published messages to the server, and waits for the server to process them
+func (c *Client) Flush(ctx context.Context) error {
+	return c.nc.FlushWithContext(ctx)
+}
+
 // JetStreamContext returns a new JetStreamContext
 func (c *Client) JetStreamContext(opts ...nats.JSOpt) (nats.JetStreamContext, error) {
 	return c.nc.JetStream(opts...)
@@ -0,0 +1,42 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nats
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidatePublishSubject checks the subject can be published to, which should consist of non-empty tokens
+// separated by ".", without whitespaces or wildcards.
+func ValidatePublishSubject(subject string) error {
+	if subject == "" {
+		return fmt.Errorf("empty subject")
+	}
+	if strings.ContainsAny(subject, " \t\r\n") {
+		return fmt.Errorf("invalid subject %q, it should not contain whitespaces", subject)
+	}
+	for _, token := range strings.Split(subject, ".") {
+		switch token {
+		case "":
+			return fmt.Errorf("invalid subject %q, it should not contain empty tokens", subject)
+		case "*", ">":
+			return fmt.Errorf("invalid subject %q, wildcards are not allowed", subject)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePublishSubject(t *testing.T) {
+	for _, s := range []string{"a", "a.b.c", "orders.us-east.created", "a*b"} {
+		assert.NoError(t, ValidatePublishSubject(s), s)
+	}
+	for _, s := range []string{"", "a b", "a..b", ".a", "a.", "a.*", "a.>", "a\tb"} {
+		assert.Error(t, ValidatePublishSubject(s), s)
+	}
+}
@@ -27,7 +27,11 @@ import (
 
 var sprigFuncMap = sprig.GenericFuncMap()
 
-const root = "payload"
+const (
+	root        = "payload"
+	keysRoot    = "keys"
+	headersRoot = "headers"
+)
 
 func EvalBool(expression string, msg []byte) (bool, error) {
 	msgMap := map[string]interface{}{
@@ -29,6 +29,62 @@ func EvalStr(expression string, msg []byte) (string, error) {
 		root: string(msg),
 	}
 	env := getFuncMap(msgMap)
+	return evalStr(expression, env)
+}
+
+// EvalStrWithMetadata works like EvalStr, besides the message payload, the keys and headers of the message
+// can also be accessed in the expression, as "keys" and "headers".
+// See examples in eval_string_test.go
+func EvalStrWithMetadata(expression string, msg []byte, keys []string, headers map[string]string) (string, error) {
+	msgMap := map[string]interface{}{
+		root: string(msg),
+	}
+	env := getFuncMap(msgMap)
+	if keys == nil {
+		keys = []string{}
+	}
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	env[keysRoot] = keys
+	env[headersRoot] = headers
+	return evalStr(expression, env)
+}
+
+// CompileWithMetadata checks if the expression can be compiled in the environment of EvalStrWithMetadata,
+// so that the invalid expressions can be rejected before evaluating any message.
+func CompileWithMetadata(expression string) error {
+	env := getFuncMap(map[string]interface{}{
+		root: "",
+	})
+	env[keysRoot] = []string{}
+	env[headersRoot] = map[string]string{}
+	if _, err := expr.Compile(expression, expr.Env(env)); err != nil {
+		return fmt.Errorf("unable to compile expression '%s': %s", expression, err)
+	}
+	return nil
+}
+
+// Eval uses the given input expression to evaluate input message and returns the result as it is, e.g. a map built
+// with `{"id": json(payload).id}`.
+// See examples in eval_string_test.go
+func Eval(expression string, msg []byte) (interface{}, error) {
+	msgMap := map[string]interface{}{
+		root: string(msg),
+	}
+	env := getFuncMap(msgMap)
+	program, err := expr.Compile(expression, expr.Env(env))
+	if err != nil {
+		return nil, fmt.Errorf("unable to compile expression '%s': %s", expression, err)
+	}
+	result, err := expr.Run(program, env)
+	if err != nil {
+		return nil, fmt.Errorf("unable to execute compiled program %v", err)
+	}
+	return result, nil
+}
+
+func evalStr(expression string, env map[string]interface{}) (string, error) {
 	program, err := expr.Compile(expression, expr.Env(env))
 	if err != nil {
 		return "", fmt.Errorf("unable to compile expression '%s': %s", expression, err)
@@ -47,3 +47,42 @@ func Test_eval_expression(t *testing.T) {
 		assert.Contains(t, err.Error(), "unable to compile expression")
 	})
 }
+
+func Test_eval_expression_with_metadata(t *testing.T) {
+	t.Run("test payload evaluation", func(t *testing.T) {
+		b, err := EvalStrWithMetadata(`json(payload).a`, []byte(`{"a": "b"}`), nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "b", b)
+	})
+
+	t.Run("test keys evaluation", func(t *testing.T) {
+		b, err := EvalStrWithMetadata(`keys[1]`, []byte(`{"a": "b"}`), []string{"k1", "k2"}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "k2", b)
+	})
+
+	t.Run("test headers evaluation", func(t *testing.T) {
+		b, err := EvalStrWithMetadata(`headers["x-tenant"] + "-" + json(payload).a`, []byte(`{"a": "b"}`), nil, map[string]string{"x-tenant": "t1"})
+		assert.NoError(t, err)
+		assert.Equal(t, "t1-b", b)
+	})
+
+	t.Run("test out of range keys", func(t *testing.T) {
+		_, err := EvalStrWithMetadata(`keys[1]`, []byte(`{"a": "b"}`), []string{"k1"}, nil)
+		assert.Error(t, err)
+	})
+}
+
+func Test_eval(t *testing.T) {
+	t.Run("test a map result", func(t *testing.T) {
+		result, err := Eval(`{"id": json(payload).a.b, "name": "numa"}`, []byte(`{"a": {"b": 1}}`))
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"id": float64(1), "name": "numa"}, result)
+	})
+
+	t.Run("test invalid expression", func(t *testing.T) {
+		_, err := Eval(`ab\na`, []byte(`{"a": "b"}`))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to compile expression")
+	})
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Template is a string with the expressions enclosed in "{{" and "}}", which are evaluated against the messages,
+// e.g. "https://example.com/tenants/{{json(payload).tenant}}".
+type Template struct {
+	// literals are the parts of the string around the expressions, there is always one more literal than expressions.
+	literals    []string
+	expressions []string
+}
+
+// ParseTemplate parses the string to a Template.
+func ParseTemplate(s string) (*Template, error) {
+	t := &Template{}
+	for {
+		start := strings.Index(s, "{{")
+		if start < 0 {
+			if strings.Contains(s, "}}") {
+				return nil, fmt.Errorf("unexpected \"}}\" in the template")
+			}
+			t.literals = append(t.literals, s)
+			return t, nil
+		}
+		end := strings.Index(s[start:], "}}")
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed \"{{\" in the template")
+		}
+		expression := strings.TrimSpace(s[start+2 : start+end])
+		if expression == "" {
+			return nil, fmt.Errorf("empty expression in the template")
+		}
+		t.literals = append(t.literals, s[:start])
+		t.expressions = append(t.expressions, expression)
+		s = s[start+end+2:]
+	}
+}
+
+// HasExpressions returns true if there is any expression in the template.
+func (t *Template) HasExpressions() bool {
+	return len(t.expressions) > 0
+}
+
+// Render returns the string of a message, the expressions are evaluated with EvalStrWithMetadata,
+// and their results are transformed by the escape function if it's not nil.
+func (t *Template) Render(msg []byte, keys []string, headers map[string]string, escape func(string) string) (string, error) {
+	if len(t.expressions) == 0 {
+		return t.literals[0], nil
+	}
+	var b strings.Builder
+	for i, e := range t.expressions {
+		b.WriteString(t.literals[i])
+		v, err := EvalStrWithMetadata(e, msg, keys, headers)
+		if err != nil {
+			return "", fmt.Errorf("failed to evaluate the expression %q, %w", e, err)
+		}
+		if escape != nil {
+			v = escape(v)
+		}
+		b.WriteString(v)
+	}
+	b.WriteString(t.literals[len(t.literals)-1])
+	return b.String(), nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expr
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTemplate(t *testing.T) {
+	for _, s := range []string{"http://a/{{", "http://a/}}", "http://a/{{ }}", "http://a/{{x}}/{{y"} {
+		_, err := ParseTemplate(s)
+		assert.Error(t, err, s)
+	}
+
+	tpl, err := ParseTemplate("http://a/{{keys[0]}}/b?c={{json(payload).c}}")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"http://a/", "/b?c=", ""}, tpl.literals)
+	assert.Equal(t, []string{"keys[0]", "json(payload).c"}, tpl.expressions)
+	assert.True(t, tpl.HasExpressions())
+
+	tpl, err = ParseTemplate("http://a/b")
+	require.NoError(t, err)
+	assert.False(t, tpl.HasExpressions())
+}
+
+func TestTemplate_Render(t *testing.T) {
+	tpl, err := ParseTemplate("http://a/{{keys[0]}}/b?c={{json(payload).c}}")
+	require.NoError(t, err)
+	u, err := tpl.Render([]byte(`{"c":"d e"}`), []string{"k/1"}, nil, url.PathEscape)
+	require.NoError(t, err)
+	assert.Equal(t, "http://a/k%2F1/b?c=d%20e", u)
+
+	tpl, err = ParseTemplate("orders.{{headers['region']}}.{{json(payload).type}}")
+	require.NoError(t, err)
+	s, err := tpl.Render([]byte(`{"type":"created"}`), nil, map[string]string{"region": "us"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "orders.us.created", s)
+
+	_, err = tpl.Render([]byte(`not json`), nil, nil, nil)
+	assert.Error(t, err)
+
+	tpl, err = ParseTemplate("http://a/b")
+	require.NoError(t, err)
+	s, err = tpl.Render(nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "http://a/b", s)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package keyby computes the keys of the messages with the expressions, it's shared by the builtin keyBy function and
+// transformer.
+package keyby
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+)
+
+// expressionPrefix is the prefix of the kwargs which compute the keys in the order of their indexes,
+// e.g. "expression.0" and "expression.1".
+const expressionPrefix = "expression."
+
+// KeyBy computes the keys of a message, one key per expression.
+type KeyBy struct {
+	expressions []string
+}
+
+// New returns a KeyBy built with the kwargs of the builtin, either the single "expression", or the
+// "expression.<index>" ones in the order of their indexes.
+func New(args map[string]string) (*KeyBy, error) {
+	expressions, err := parseExpressions(args)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyBy{expressions: expressions}, nil
+}
+
+// Keys returns the keys computed with the expressions over the payload, the keys and the headers of a message.
+func (k *KeyBy) Keys(keys []string, payload []byte, headers map[string]string) ([]string, error) {
+	newKeys := make([]string, 0, len(k.expressions))
+	for _, e := range k.expressions {
+		key, err := expr.EvalStrWithMetadata(e, payload, keys, headers)
+		if err != nil {
+			return nil, err
+		}
+		newKeys = append(newKeys, key)
+	}
+	return newKeys, nil
+}
+
+// parseExpressions returns the key expressions, either the single "expression", or the "expression.<index>" ones
+// sorted by their indexes.
+func parseExpressions(args map[string]string) ([]string, error) {
+	type indexed struct {
+		index      int
+		expression string
+	}
+	var list []indexed
+	for name, v := range args {
+		s, ok := strings.CutPrefix(name, expressionPrefix)
+		if !ok {
+			continue
+		}
+		index, err := strconv.Atoi(s)
+		if err != nil || index < 0 {
+			return nil, fmt.Errorf("invalid key expression %q, the index should be a non-negative integer", name)
+		}
+		list = append(list, indexed{index: index, expression: v})
+	}
+	single, existing := args["expression"]
+	switch {
+	case existing && len(list) > 0:
+		return nil, fmt.Errorf(`"expression" and "expression.<index>" can not be used together`)
+	case existing:
+		return []string{single}, nil
+	case len(list) == 0:
+		return nil, fmt.Errorf(`missing "expression" or "expression.<index>"`)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].index < list[j].index })
+	expressions := make([]string, 0, len(list))
+	for _, e := range list {
+		expressions = append(expressions, e.expression)
+	}
+	return expressions, nil
+}
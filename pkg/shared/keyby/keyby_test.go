@@ -0,0 +1,78 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyby
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var jsonMsg = `{"user": {"id": 7, "region": "us"}, "amount": 10}`
+
+func TestNew(t *testing.T) {
+	for name, tc := range map[string]struct {
+		args map[string]string
+		err  string
+	}{
+		"missing expression":                  {args: map[string]string{}, err: "missing"},
+		"both expression and indexed ones":    {args: map[string]string{"expression": "payload", "expression.0": "payload"}, err: "can not be used together"},
+		"invalid index":                       {args: map[string]string{"expression.a": "payload"}, err: "non-negative integer"},
+		"negative index":                      {args: map[string]string{"expression.-1": "payload"}, err: "non-negative integer"},
+		"single expression":                   {args: map[string]string{"expression": "payload"}},
+		"indexed expressions with other args": {args: map[string]string{"expression.0": "payload", "other": "x"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(tc.args)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestKeys(t *testing.T) {
+	t.Run("single key", func(t *testing.T) {
+		k, err := New(map[string]string{"expression": "string(json(payload).user.id)"})
+		require.NoError(t, err)
+		keys, err := k.Keys([]string{"old"}, []byte(jsonMsg), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"7"}, keys)
+	})
+
+	t.Run("multiple keys from payload, keys and headers", func(t *testing.T) {
+		k, err := New(map[string]string{
+			"expression.10": "keys[0]",
+			"expression.2":  `headers["x-tenant"]`,
+			"expression.0":  "json(payload).user.region",
+		})
+		require.NoError(t, err)
+		keys, err := k.Keys([]string{"old"}, []byte(jsonMsg), map[string]string{"x-tenant": "t1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"us", "t1", "old"}, keys)
+	})
+
+	t.Run("failed expression", func(t *testing.T) {
+		k, err := New(map[string]string{"expression": "json(payload).user.id"})
+		require.NoError(t, err)
+		_, err = k.Keys(nil, []byte("not json"), nil)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package redis package implements the kv store and watcher using Redis.
+
+Each KV store is a Redis hash named after the store, every change to the hash is also published to a Redis Pub/Sub
+channel, so that the watchers get notified of the updates. A watcher replays the existing key-value pairs of the hash
+before streaming the updates, which matches the semantics of the JetStream KV watcher, and reads the hash again after
+every reconnection to catch up with the updates published while it was disconnected.
+*/
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	// watchHealthCheckInterval is the interval to ping the subscription when there's no update received.
+	watchHealthCheckInterval = 5 * time.Second
+	// watchRetryInterval is the interval to retry when the subscription or reading the hash fails.
+	watchRetryInterval = time.Second
+)
+
+// redisStore implements the KV store backed up by Redis.
+type redisStore struct {
+	kvName    string
+	client    *redisclient.RedisClient
+	doneCh    chan struct{}
+	closeOnce sync.Once
+	log       *zap.SugaredLogger
+}
+
+var _ kvs.KVStorer = (*redisStore)(nil)
+
+// NewKVRedisStore returns a Redis backed KVStorer.
+func NewKVRedisStore(ctx context.Context, kvName string, client *redisclient.RedisClient) (kvs.KVStorer, error) {
+	if client == nil {
+		return nil, fmt.Errorf("redis client is nil")
+	}
+	return &redisStore{
+		kvName: kvName,
+		client: client,
+		doneCh: make(chan struct{}),
+		log:    logging.FromContext(ctx).With("kvName", kvName),
+	}, nil
+}
+
+// kvEntry is each key-value entry in the store and the operation associated with the kv pair.
+// It is also the payload published to the Pub/Sub channel of the store.
+type kvEntry struct {
+	EntryKey   string        `json:"key"`
+	EntryValue []byte        `json:"value,omitempty"`
+	Op         kvs.KVWatchOp `json:"op"`
+}
+
+// Key returns the key
+func (k kvEntry) Key() string {
+	return k.EntryKey
+}
+
+// Value returns the value.
+func (k kvEntry) Value() []byte {
+	return k.EntryValue
+}
+
+// Operation returns the operation on that key-value pair.
+func (k kvEntry) Operation() kvs.KVWatchOp {
+	return k.Op
+}
+
+// GetAllKeys returns all the keys in the key-value store.
+func (rs *redisStore) GetAllKeys(ctx context.Context) ([]string, error) {
+	return rs.client.Client.HKeys(ctx, rs.kvName).Result()
+}
+
+// GetValue returns the value for a given key.
+func (rs *redisStore) GetValue(ctx context.Context, k string) ([]byte, error) {
+	val, err := rs.client.Client.HGet(ctx, rs.kvName, k).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return []byte(""), fmt.Errorf("key %s not found", k)
+		}
+		return []byte(""), err
+	}
+	return val, nil
+}
+
+// GetStoreName returns the store name.
+func (rs *redisStore) GetStoreName() string {
+	return rs.kvName
+}
+
+// DeleteKey deletes the key from the Redis key-value store, and notifies the watchers.
+func (rs *redisStore) DeleteKey(_ context.Context, k string) error {
+	return rs.update(kvEntry{EntryKey: k, Op: kvs.KVDelete}, func(pipe redis.Pipeliner) {
+		pipe.HDel(redisclient.RedisContext, rs.kvName, k)
+	})
+}
+
+// PutKV puts an element to the Redis key-value store, and notifies the watchers.
+func (rs *redisStore) PutKV(_ context.Context, k string, v []byte) error {
+	return rs.update(kvEntry{EntryKey: k, EntryValue: v, Op: kvs.KVPut}, func(pipe redis.Pipeliner) {
+		pipe.HSet(redisclient.RedisContext, rs.kvName, k, v)
+	})
+}
+
+// update applies the change to the hash and publishes it to the channel in a transaction.
+// We use the RedisContext instead of the given context, the deletion of the keys happens during the shutdown,
+// and a cancelled context will fail the operation.
+func (rs *redisStore) update(entry kvEntry, apply func(pipe redis.Pipeliner)) error {
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the kv entry, %w", err)
+	}
+	_, err = rs.client.Client.TxPipelined(redisclient.RedisContext, func(pipe redis.Pipeliner) error {
+		apply(pipe)
+		pipe.Publish(redisclient.RedisContext, RedisKVChannelName(rs.kvName), payload)
+		return nil
+	})
+	return err
+}
+
+// Watch watches the key-value store and returns the updates channel to read the updates on the KV store.
+// The existing key-value pairs are sent as put operations before the updates. Pub/Sub does not buffer the messages
+// published while the subscriber is disconnected, so every time the channel is (re)subscribed, the hash is read again
+// and the differences with what the watcher has seen are sent, the keys which are gone are sent as delete operations.
+func (rs *redisStore) Watch(ctx context.Context) <-chan kvs.KVEntry {
+	var updates = make(chan kvs.KVEntry)
+	go func() {
+		defer close(updates)
+		pubSub := rs.client.Client.Subscribe(ctx, RedisKVChannelName(rs.kvName))
+		// Receive doesn't return on the context cancellation, close the subscription to unblock it.
+		stopped := make(chan struct{})
+		defer close(stopped)
+		go func() {
+			select {
+			case <-ctx.Done():
+			case <-rs.doneCh:
+			case <-stopped:
+			}
+			if err := pubSub.Close(); err != nil {
+				rs.log.Warnw("Failed to close the subscription", zap.Error(err))
+			}
+		}()
+		// seen holds the values sent to the watcher, it is used to figure out the updates missed while disconnected.
+		seen := make(map[string]string)
+		for {
+			// go-redis reconnects and resubscribes the channel on the next receive after a connection error.
+			msg, err := pubSub.ReceiveTimeout(ctx, watchHealthCheckInterval)
+			if rs.stopped(ctx) {
+				rs.log.Infow("Stopping Watch", zap.String("watcher", rs.kvName))
+				return
+			}
+			if err != nil {
+				var netErr net.Error
+				if errors.As(err, &netErr) && netErr.Timeout() {
+					// nothing received in the interval, ping to detect a broken connection.
+					if err := pubSub.Ping(ctx); err != nil {
+						rs.log.Warnw("Failed to ping the subscription", zap.Error(err))
+					}
+					continue
+				}
+				rs.log.Errorw("Failed to receive from the kv store channel, retrying", zap.Error(err))
+				select {
+				case <-ctx.Done():
+				case <-rs.doneCh:
+				case <-time.After(watchRetryInterval):
+				}
+				continue
+			}
+			switch m := msg.(type) {
+			case *redis.Subscription:
+				// (re)subscribed, read the hash after subscribing, so that no update is missed in between.
+				if m.Kind != "subscribe" {
+					continue
+				}
+				if !rs.resync(ctx, updates, seen) {
+					return
+				}
+			case *redis.Message:
+				var entry kvEntry
+				if err := json.Unmarshal([]byte(m.Payload), &entry); err != nil {
+					rs.log.Errorw("Failed to unmarshal the kv entry", zap.String("payload", m.Payload), zap.Error(err))
+					continue
+				}
+				rs.log.Debugw("Received an update", zap.String("key", entry.EntryKey), zap.String("op", entry.Op.String()))
+				if entry.Op == kvs.KVDelete {
+					delete(seen, entry.EntryKey)
+				} else {
+					seen[entry.EntryKey] = string(entry.EntryValue)
+				}
+				if !rs.send(ctx, updates, entry) {
+					return
+				}
+			}
+		}
+	}()
+	return updates
+}
+
+// resync reads all the key-value pairs of the hash, and sends the ones which are different from what the watcher has
+// seen as put operations, and the seen keys which no longer exist as delete operations.
+// It returns false if the watcher is stopped.
+func (rs *redisStore) resync(ctx context.Context, updates chan<- kvs.KVEntry, seen map[string]string) bool {
+	var existing map[string]string
+	for {
+		var err error
+		if existing, err = rs.client.Client.HGetAll(ctx, rs.kvName).Result(); err == nil {
+			break
+		}
+		rs.log.Errorw("Failed to get the existing key-value pairs, retrying", zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return false
+		case <-rs.doneCh:
+			return false
+		case <-time.After(watchRetryInterval):
+		}
+	}
+	for k, v := range existing {
+		if old, ok := seen[k]; ok && old == v {
+			continue
+		}
+		seen[k] = v
+		if !rs.send(ctx, updates, kvEntry{EntryKey: k, EntryValue: []byte(v), Op: kvs.KVPut}) {
+			return false
+		}
+	}
+	for k := range seen {
+		if _, ok := existing[k]; ok {
+			continue
+		}
+		delete(seen, k)
+		if !rs.send(ctx, updates, kvEntry{EntryKey: k, Op: kvs.KVDelete}) {
+			return false
+		}
+	}
+	return true
+}
+
+// stopped returns true if the context is cancelled or the store is closed.
+func (rs *redisStore) stopped(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	case <-rs.doneCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// send sends the entry to the updates channel, it returns false if the watcher is stopped.
+func (rs *redisStore) send(ctx context.Context, updates chan<- kvs.KVEntry, entry kvEntry) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-rs.doneCh:
+		return false
+	case updates <- entry:
+		return true
+	}
+}
+
+// Close we don't need to close the Redis connection. It will be closed by the caller.
+// give the signal to watchers to stop watching
+func (rs *redisStore) Close() {
+	rs.closeOnce.Do(func() {
+		close(rs.doneCh)
+	})
+}
+
+// RedisKVChannelName returns the name of the Pub/Sub channel used to notify the updates of the KV store.
+func RedisKVChannelName(kvName string) string {
+	return fmt.Sprintf("%s_UPDATES", kvName)
+}
@@ -0,0 +1,153 @@
+//go:build isb_redis
+
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+)
+
+var redisOptions = &redis.UniversalOptions{
+	Addrs: []string{":6379"},
+}
+
+func TestRedisKVStoreOperations(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	kvName := "testRedisKVStore"
+	client := redisclient.NewRedisClient(redisOptions)
+	defer func() { _ = client.DeleteKeys(ctx, kvName) }()
+
+	kvStore, err := NewKVRedisStore(ctx, kvName, client)
+	assert.NoError(t, err)
+	defer kvStore.Close()
+	assert.Equal(t, kvName, kvStore.GetStoreName())
+
+	err = kvStore.PutKV(ctx, "key1", []byte("value1"))
+	assert.NoError(t, err)
+	err = kvStore.PutKV(ctx, "key2", []byte("value2"))
+	assert.NoError(t, err)
+
+	value, err := kvStore.GetValue(ctx, "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value1"), value)
+
+	keys, err := kvStore.GetAllKeys(ctx)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"key1", "key2"}, keys)
+
+	err = kvStore.DeleteKey(ctx, "key1")
+	assert.NoError(t, err)
+	_, err = kvStore.GetValue(ctx, "key1")
+	assert.Error(t, err)
+
+	keys, err = kvStore.GetAllKeys(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key2"}, keys)
+}
+
+func TestRedisKVStoreWatch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	kvName := "testRedisKVStoreWatch"
+	client := redisclient.NewRedisClient(redisOptions)
+	defer func() { _ = client.DeleteKeys(ctx, kvName) }()
+
+	kvStore, err := NewKVRedisStore(ctx, kvName, client)
+	assert.NoError(t, err)
+	defer kvStore.Close()
+
+	// existing key-value pairs are replayed to the watcher
+	err = kvStore.PutKV(ctx, "key1", []byte("value1"))
+	assert.NoError(t, err)
+
+	watchCh := kvStore.Watch(ctx)
+	entry := <-watchCh
+	assert.Equal(t, "key1", entry.Key())
+	assert.Equal(t, []byte("value1"), entry.Value())
+	assert.Equal(t, kvs.KVPut, entry.Operation())
+
+	err = kvStore.PutKV(ctx, "key2", []byte("value2"))
+	assert.NoError(t, err)
+	entry = <-watchCh
+	assert.Equal(t, "key2", entry.Key())
+	assert.Equal(t, []byte("value2"), entry.Value())
+	assert.Equal(t, kvs.KVPut, entry.Operation())
+
+	err = kvStore.DeleteKey(ctx, "key1")
+	assert.NoError(t, err)
+	entry = <-watchCh
+	assert.Equal(t, "key1", entry.Key())
+	assert.Equal(t, kvs.KVDelete, entry.Operation())
+
+	// the watcher is stopped after the store is closed
+	kvStore.Close()
+	_, ok := <-watchCh
+	assert.False(t, ok)
+}
+
+func TestRedisKVStoreWatchResync(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	kvName := "testRedisKVStoreWatchResync"
+	client := redisclient.NewRedisClient(redisOptions)
+	defer func() { _ = client.DeleteKeys(ctx, kvName) }()
+
+	kvStore, err := NewKVRedisStore(ctx, kvName, client)
+	assert.NoError(t, err)
+	defer kvStore.Close()
+
+	err = kvStore.PutKV(ctx, "key1", []byte("value1"))
+	assert.NoError(t, err)
+	err = kvStore.PutKV(ctx, "key2", []byte("value2"))
+	assert.NoError(t, err)
+
+	watchCh := kvStore.Watch(ctx)
+	seen := map[string]string{}
+	for i := 0; i < 2; i++ {
+		entry := <-watchCh
+		seen[entry.Key()] = string(entry.Value())
+	}
+	assert.Equal(t, map[string]string{"key1": "value1", "key2": "value2"}, seen)
+
+	// change the hash without publishing, as if the updates were published while the watcher was disconnected.
+	assert.NoError(t, client.Client.HSet(ctx, kvName, "key2", "value2-1").Err())
+	assert.NoError(t, client.Client.HDel(ctx, kvName, "key1").Err())
+	assert.NoError(t, client.Client.ClientKillByFilter(ctx, "TYPE", "pubsub").Err())
+
+	got := map[string]kvs.KVWatchOp{}
+	for i := 0; i < 2; i++ {
+		entry := <-watchCh
+		got[entry.Key()] = entry.Operation()
+		if entry.Operation() == kvs.KVPut {
+			assert.Equal(t, []byte("value2-1"), entry.Value())
+		}
+	}
+	assert.Equal(t, map[string]kvs.KVWatchOp{"key1": kvs.KVDelete, "key2": kvs.KVPut}, got)
+}
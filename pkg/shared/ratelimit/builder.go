@@ -0,0 +1,33 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"fmt"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// PipelineStoreName returns the name of the pipeline-wide stores, which is the same as the side inputs store name.
+func PipelineStoreName(vertex *dfv1.Vertex) string {
+	return fmt.Sprintf("%s-%s", vertex.Namespace, vertex.Spec.PipelineName)
+}
+
+// IsRateLimited returns true if the vertex has a rate limit.
+func IsRateLimited(vertex *dfv1.Vertex) bool {
+	return vertex.Spec.Limits != nil && vertex.Spec.Limits.RateLimit != nil
+}
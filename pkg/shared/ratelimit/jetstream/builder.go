@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package jetstream builds the rate limiters of the vertices with the JetStream ISB Service, it's separated from the
+// ratelimit package, which is used by the forwarders, as it depends on the isbsvc package.
+package jetstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	"github.com/numaproj/numaflow/pkg/shared/ratelimit"
+)
+
+// BuildRateLimiter returns the rate limiter of the vertex, whose token bucket is stored in the rate limit KV of the
+// pipeline, or nil if the vertex is not rate limited. The KV is created by the ISB Service creating job, or here if
+// the pipeline was created before rate limiting was supported, with the same replicas as the side inputs KV.
+func BuildRateLimiter(ctx context.Context, vertex *dfv1.Vertex, client *jsclient.Client) (ratelimit.RateLimiter, error) {
+	if !ratelimit.IsRateLimited(vertex) {
+		return nil, nil
+	}
+	storeName := ratelimit.PipelineStoreName(vertex)
+	kvName := isbsvc.JetStreamRateLimitKVName(storeName)
+	js, err := client.JetStreamContext()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a js context from nats connection, %w", err)
+	}
+	kv, err := js.KeyValue(kvName)
+	if err != nil {
+		if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return nil, fmt.Errorf("failed to query information of bucket %q, %w", kvName, err)
+		}
+		replicas := 1
+		if siKV, err := js.KeyValue(isbsvc.JetStreamSideInputsStoreKVName(storeName)); err == nil {
+			if status, err := siKV.Status(); err == nil {
+				if s, ok := status.(*nats.KeyValueBucketStatus); ok {
+					replicas = s.StreamInfo().Config.Replicas
+				}
+			}
+		}
+		if kv, err = js.CreateKeyValue(&nats.KeyValueConfig{
+			Bucket:   kvName,
+			History:  1,
+			Storage:  nats.FileStorage,
+			Replicas: replicas,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to create rate limit KV %q, %w", kvName, err)
+		}
+	}
+	return ratelimit.NewRateLimiter(ctx, ratelimit.NewJetStreamStore(kv), vertex.Spec.Name, *vertex.Spec.Limits.RateLimit), nil
+}
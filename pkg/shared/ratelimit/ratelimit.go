@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit limits the throughput of a vertex across all of its replicas. The replicas share a token bucket,
+// which is stored in a KV of the ISB Service and updated with compare-and-swap operations.
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// RateLimiter limits the number of messages and bytes read by a vertex.
+type RateLimiter interface {
+	// Acquire blocks until at least one message is allowed to be read, and returns the number of messages allowed,
+	// which is at most n. It only returns an error if the context is canceled.
+	Acquire(ctx context.Context, n int64) (int64, error)
+	// Release gives back the acquired messages which were not read, and consumes the bytes read.
+	Release(ctx context.Context, unused int64, bytes int64)
+}
+
+// ReadWithLimit reads at most batchSize messages with the read function, the batch size is reduced to the number of
+// messages allowed by the limiter, which can be nil if the vertex is not rate limited. The limiter fails only when the
+// context is canceled, then the messages are read without the limit to drain the buffer for shutting down.
+func ReadWithLimit(ctx context.Context, limiter RateLimiter, batchSize int64, read func(context.Context, int64) ([]*isb.ReadMessage, error)) ([]*isb.ReadMessage, error) {
+	if limiter == nil {
+		return read(ctx, batchSize)
+	}
+	allowed, err := limiter.Acquire(ctx, batchSize)
+	if err != nil {
+		return read(ctx, batchSize)
+	}
+	messages, err := read(ctx, allowed)
+	limiter.Release(ctx, allowed-int64(len(messages)), isb.PayloadSize(messages))
+	return messages, err
+}
+
+// bucket is the state of the token bucket shared by the replicas.
+type bucket struct {
+	// Messages is the number of message tokens.
+	Messages float64 `json:"messages"`
+	// Bytes is the number of byte tokens, it goes negative when more bytes are read than allowed.
+	Bytes float64 `json:"bytes"`
+	// UpdatedAt is the time in milliseconds when the tokens were last refilled.
+	UpdatedAt int64 `json:"updatedAt"`
+}
+
+// tokenBucket is a RateLimiter backed by a token bucket in a Store. The capacity of the bucket is one second of tokens.
+type tokenBucket struct {
+	store             Store
+	key               string
+	messagesPerSecond float64
+	// bytesPerSecond is 0 if the bytes are not limited.
+	bytesPerSecond float64
+	retryInterval  time.Duration
+	now            func() time.Time
+	log            *zap.SugaredLogger
+}
+
+var _ RateLimiter = (*tokenBucket)(nil)
+
+// NewRateLimiter returns a RateLimiter which stores its token bucket under the key in the store.
+func NewRateLimiter(ctx context.Context, store Store, key string, rateLimit dfv1.RateLimit) RateLimiter {
+	tb := &tokenBucket{
+		store:             store,
+		key:               key,
+		messagesPerSecond: float64(rateLimit.MessagesPerSecond),
+		retryInterval:     time.Second,
+		now:               time.Now,
+		log:               logging.FromContext(ctx).With("rateLimitKey", key),
+	}
+	if x := rateLimit.BytesPerSecond; x != nil {
+		tb.bytesPerSecond = float64(*x)
+	}
+	return tb
+}
+
+func (tb *tokenBucket) Acquire(ctx context.Context, n int64) (int64, error) {
+	for {
+		var acquired int64
+		var wait time.Duration
+		err := tb.store.Update(ctx, tb.key, func(value []byte) ([]byte, error) {
+			b, err := tb.refill(value)
+			if err != nil {
+				return nil, err
+			}
+			acquired, wait = 0, 0
+			switch {
+			case tb.bytesPerSecond > 0 && b.Bytes < 0:
+				// wait for the debt of the bytes to be paid off.
+				wait = time.Duration(-b.Bytes / tb.bytesPerSecond * float64(time.Second))
+			case b.Messages < 1:
+				wait = time.Duration((1 - b.Messages) / tb.messagesPerSecond * float64(time.Second))
+			default:
+				acquired = min(n, int64(b.Messages))
+				b.Messages -= float64(acquired)
+			}
+			return json.Marshal(b)
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return 0, ctx.Err()
+			}
+			tb.log.Warnw("Failed to acquire from the rate limiter, retrying", zap.Error(err))
+			wait = tb.retryInterval
+		} else if acquired > 0 {
+			return acquired, nil
+		}
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(max(wait, time.Millisecond)):
+		}
+	}
+}
+
+func (tb *tokenBucket) Release(ctx context.Context, unused int64, bytes int64) {
+	if tb.bytesPerSecond == 0 {
+		bytes = 0
+	}
+	if unused <= 0 && bytes <= 0 {
+		return
+	}
+	err := tb.store.Update(ctx, tb.key, func(value []byte) ([]byte, error) {
+		b, err := tb.refill(value)
+		if err != nil {
+			return nil, err
+		}
+		b.Messages = min(tb.messagesPerSecond, b.Messages+float64(max(unused, 0)))
+		b.Bytes -= float64(bytes)
+		return json.Marshal(b)
+	})
+	if err != nil && ctx.Err() == nil {
+		tb.log.Warnw("Failed to release to the rate limiter", zap.Error(err))
+	}
+}
+
+// refill decodes the bucket and adds the tokens accumulated since it was last refilled, a missing bucket is full.
+func (tb *tokenBucket) refill(value []byte) (*bucket, error) {
+	now := tb.now().UnixMilli()
+	if len(value) == 0 {
+		return &bucket{Messages: tb.messagesPerSecond, Bytes: tb.bytesPerSecond, UpdatedAt: now}, nil
+	}
+	b := &bucket{}
+	if err := json.Unmarshal(value, b); err != nil {
+		return nil, fmt.Errorf("failed to decode the token bucket, %w", err)
+	}
+	if elapsed := float64(now-b.UpdatedAt) / 1000; elapsed > 0 {
+		b.Messages = min(tb.messagesPerSecond, b.Messages+elapsed*tb.messagesPerSecond)
+		b.Bytes = min(tb.bytesPerSecond, b.Bytes+elapsed*tb.bytesPerSecond)
+		b.UpdatedAt = now
+	}
+	return b, nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+func TestTokenBucket_Acquire(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	rl := NewRateLimiter(ctx, NewInMemoryStore(), "vertex", dfv1.RateLimit{MessagesPerSecond: 10})
+	// the bucket is full at the beginning.
+	acquired, err := rl.Acquire(ctx, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), acquired)
+	// the next message is allowed after a refill.
+	start := time.Now()
+	acquired, err = rl.Acquire(ctx, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), acquired)
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestTokenBucket_Release(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	now := time.Now()
+	rl := NewRateLimiter(ctx, NewInMemoryStore(), "vertex", dfv1.RateLimit{MessagesPerSecond: 10, BytesPerSecond: ptr.To[uint64](100)}).(*tokenBucket)
+	rl.now = func() time.Time { return now }
+	acquired, err := rl.Acquire(ctx, 8)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), acquired)
+	// give back the unused messages.
+	rl.Release(ctx, 5, 50)
+	acquired, err = rl.Acquire(ctx, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), acquired)
+	// read more bytes than allowed, nothing is allowed until the debt is paid off.
+	rl.Release(ctx, 0, 150)
+	ctxTimeout, cancelTimeout := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancelTimeout()
+	_, err = rl.Acquire(ctxTimeout, 1)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	now = now.Add(time.Second)
+	acquired, err = rl.Acquire(ctx, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), acquired)
+}
+
+func TestTokenBucket_SharedByReplicas(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	now := time.Now()
+	store := NewInMemoryStore()
+	var total int64
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		rl := NewRateLimiter(ctx, store, "vertex", dfv1.RateLimit{MessagesPerSecond: 100}).(*tokenBucket)
+		rl.now = func() time.Time { return now }
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			acquired, err := rl.Acquire(ctx, 30)
+			assert.NoError(t, err)
+			mu.Lock()
+			total += acquired
+			mu.Unlock()
+		}()
+	}
+	// 3 replicas get 30 messages and the last one gets the rest of the 100 tokens.
+	wg.Wait()
+	assert.Equal(t, int64(100), total)
+}
+
+func TestReadWithLimit(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var requested int64
+	read := func(_ context.Context, count int64) ([]*isb.ReadMessage, error) {
+		requested = count
+		return []*isb.ReadMessage{{Message: isb.Message{Body: isb.Body{Payload: []byte("hello")}}}}, nil
+	}
+	// not rate limited.
+	msgs, err := ReadWithLimit(ctx, nil, 100, read)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	assert.Equal(t, int64(100), requested)
+
+	now := time.Now()
+	rl := NewRateLimiter(ctx, NewInMemoryStore(), "vertex", dfv1.RateLimit{MessagesPerSecond: 10}).(*tokenBucket)
+	rl.now = func() time.Time { return now }
+	_, err = ReadWithLimit(ctx, rl, 100, read)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), requested)
+	// the unused messages are given back.
+	_, err = ReadWithLimit(ctx, rl, 100, read)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), requested)
+
+	// the messages are read without the limit once the context is canceled.
+	_, err = rl.Acquire(ctx, 100)
+	assert.NoError(t, err)
+	canceled, cancelRead := context.WithCancel(ctx)
+	cancelRead()
+	_, err = ReadWithLimit(canceled, rl, 100, read)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), requested)
+}
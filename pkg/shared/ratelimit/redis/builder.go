@@ -0,0 +1,38 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package redis builds the rate limiters of the vertices with the Redis ISB Service, it's separated from the
+// ratelimit package, which is used by the forwarders, as it depends on the isbsvc package.
+package redis
+
+import (
+	"context"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/ratelimit"
+)
+
+// BuildRateLimiter returns the rate limiter of the vertex, whose token bucket is stored in the rate limit hash of the
+// pipeline, or nil if the vertex is not rate limited.
+func BuildRateLimiter(ctx context.Context, vertex *dfv1.Vertex, client *redisclient.RedisClient) (ratelimit.RateLimiter, error) {
+	if !ratelimit.IsRateLimited(vertex) {
+		return nil, nil
+	}
+	hashName := isbsvc.RedisRateLimitKVName(ratelimit.PipelineStoreName(vertex))
+	return ratelimit.NewRateLimiter(ctx, ratelimit.NewRedisStore(client, hashName), vertex.Spec.Name, *vertex.Spec.Limits.RateLimit), nil
+}
@@ -0,0 +1,41 @@
+//go:build isb_redis
+
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+)
+
+func TestRedisStore_Update(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	client := redisclient.NewRedisClient(&redis.UniversalOptions{Addrs: []string{":6379"}})
+	hashName := "testRateLimit"
+	defer func() { _ = client.DeleteKeys(ctx, hashName) }()
+
+	store := NewRedisStore(client, hashName)
+	assert.Equal(t, []byte("10"), increment(t, ctx, store, "vertex", 10))
+}
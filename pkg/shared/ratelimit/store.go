@@ -0,0 +1,141 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"github.com/redis/go-redis/v9"
+
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+)
+
+// UpdateFunc returns the new value of a key from its current value, the current value is nil if the key doesn't exist.
+type UpdateFunc func(value []byte) ([]byte, error)
+
+// Store stores the token buckets of the rate limiters.
+type Store interface {
+	// Update atomically updates the value of the key. The function is called again with the latest value if the key
+	// is updated by someone else in the meantime.
+	Update(ctx context.Context, key string, fn UpdateFunc) error
+}
+
+// jetStreamStore is a Store backed by a JetStream KV, it uses the revisions of the keys for compare-and-swap.
+type jetStreamStore struct {
+	kv nats.KeyValue
+}
+
+// NewJetStreamStore returns a Store backed by the JetStream KV.
+func NewJetStreamStore(kv nats.KeyValue) Store {
+	return &jetStreamStore{kv: kv}
+}
+
+func (s *jetStreamStore) Update(ctx context.Context, key string, fn UpdateFunc) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var current []byte
+		var revision uint64
+		entry, err := s.kv.Get(key)
+		if err == nil {
+			current, revision = entry.Value(), entry.Revision()
+		} else if !errors.Is(err, nats.ErrKeyNotFound) {
+			return fmt.Errorf("failed to get key %q, %w", key, err)
+		}
+		value, err := fn(current)
+		if err != nil {
+			return err
+		}
+		if revision == 0 {
+			_, err = s.kv.Create(key, value)
+		} else {
+			_, err = s.kv.Update(key, value, revision)
+		}
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, nats.ErrKeyExists) {
+			return fmt.Errorf("failed to update key %q, %w", key, err)
+		}
+		// the key was updated by someone else, try again with the latest value.
+	}
+}
+
+// redisStore is a Store backed by a Redis hash, it uses optimistic transactions for compare-and-swap.
+type redisStore struct {
+	client   *redisclient.RedisClient
+	hashName string
+}
+
+// NewRedisStore returns a Store backed by the Redis hash.
+func NewRedisStore(client *redisclient.RedisClient, hashName string) Store {
+	return &redisStore{client: client, hashName: hashName}
+}
+
+func (s *redisStore) Update(ctx context.Context, key string, fn UpdateFunc) error {
+	for {
+		err := s.client.Client.Watch(ctx, func(tx *redis.Tx) error {
+			current, err := tx.HGet(ctx, s.hashName, key).Bytes()
+			if err != nil && !errors.Is(err, redis.Nil) {
+				return fmt.Errorf("failed to get key %q, %w", key, err)
+			}
+			value, err := fn(current)
+			if err != nil {
+				return err
+			}
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.HSet(ctx, s.hashName, key, value)
+				return nil
+			})
+			return err
+		}, s.hashName)
+		if !errors.Is(err, redis.TxFailedErr) {
+			return err
+		}
+		// the hash was updated by someone else, try again with the latest value.
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// inMemoryStore is a Store in memory, it is only used for testing.
+type inMemoryStore struct {
+	sync.Mutex
+	data map[string][]byte
+}
+
+// NewInMemoryStore returns a Store in memory.
+func NewInMemoryStore() Store {
+	return &inMemoryStore{data: make(map[string][]byte)}
+}
+
+func (s *inMemoryStore) Update(_ context.Context, key string, fn UpdateFunc) error {
+	s.Lock()
+	defer s.Unlock()
+	value, err := fn(s.data[key])
+	if err != nil {
+		return err
+	}
+	s.data[key] = value
+	return nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+
+	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+)
+
+// increment updates the key from concurrent goroutines and returns the final value.
+func increment(t *testing.T, ctx context.Context, store Store, key string, times int) []byte {
+	var wg sync.WaitGroup
+	for i := 0; i < times; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := store.Update(ctx, key, func(value []byte) ([]byte, error) {
+				n := 0
+				if value != nil {
+					n, _ = strconv.Atoi(string(value))
+				}
+				return []byte(strconv.Itoa(n + 1)), nil
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	var result []byte
+	assert.NoError(t, store.Update(ctx, key, func(value []byte) ([]byte, error) {
+		result = value
+		return value, nil
+	}))
+	return result
+}
+
+func TestInMemoryStore_Update(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.Equal(t, []byte("10"), increment(t, ctx, NewInMemoryStore(), "vertex", 10))
+}
+
+func TestJetStreamStore_Update(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	testClient := natstest.JetStreamClient(t, s)
+	defer testClient.Close()
+
+	js, err := testClient.JetStreamContext()
+	assert.NoError(t, err)
+	kv, err := js.CreateKeyValue(&nats.KeyValueConfig{Bucket: "testRateLimit", History: 1})
+	assert.NoError(t, err)
+
+	store := NewJetStreamStore(kv)
+	assert.Equal(t, []byte("10"), increment(t, ctx, store, "vertex", 10))
+
+	// a deleted key is created again.
+	assert.NoError(t, kv.Delete("vertex"))
+	assert.Equal(t, []byte("1"), increment(t, ctx, store, "vertex", 1))
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package redact masks the sensitive data, e.g. emails and card numbers, in the message payloads, it's shared by the
+// builtin redact function and transformer.
+package redact
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Mode is how the sensitive data is masked.
+type Mode string
+
+const (
+	// ModeHash replaces the data with the hex encoded HMAC-SHA256 of it, so that the redacted values still join.
+	ModeHash Mode = "hash"
+	// ModeTruncate keeps the first characters of the data.
+	ModeTruncate Mode = "truncate"
+	// ModeReplace replaces the data with a fixed replacement.
+	ModeReplace Mode = "replace"
+	// ModeDrop removes the data, a field is removed from the payload.
+	ModeDrop Mode = "drop"
+)
+
+const (
+	// patternPrefix is the prefix of the kwargs which define the custom patterns, e.g. "pattern.ssn".
+	patternPrefix      = "pattern."
+	defaultReplacement = "***"
+	defaultLength      = 4
+)
+
+// builtinPatterns are the predefined patterns which can be enabled by their names with the "patterns" kwarg.
+var builtinPatterns = map[string]pattern{
+	"email": {re: regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)},
+	// the card numbers are checked with the Luhn algorithm, so that the other long numbers, e.g. IDs and timestamps,
+	// are not masked.
+	"creditCard": {re: regexp.MustCompile(`\b(?:\d[ \-]?){12,18}\d\b`), valid: isLuhnValid},
+}
+
+// pattern is a regular expression of the sensitive data, whose matches are masked if they're valid.
+type pattern struct {
+	re *regexp.Regexp
+	// valid tells if a match is the sensitive data, all the matches are if it's nil.
+	valid func(string) bool
+}
+
+// Redactor masks the fields and the matches of the patterns in the payloads.
+type Redactor struct {
+	fields      [][]string
+	patterns    []pattern
+	mode        Mode
+	replacement string
+	length      int
+	hashKey     []byte
+}
+
+// New returns a Redactor built with the kwargs of the builtin.
+func New(args map[string]string) (*Redactor, error) {
+	r := &Redactor{
+		mode:        ModeReplace,
+		replacement: defaultReplacement,
+		length:      defaultLength,
+	}
+	for _, f := range strings.Split(args["fields"], ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			r.fields = append(r.fields, strings.Split(f, "."))
+		}
+	}
+	for _, name := range strings.Split(args["patterns"], ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		p, ok := builtinPatterns[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown builtin pattern %q", name)
+		}
+		r.patterns = append(r.patterns, p)
+	}
+	var names []string
+	for k := range args {
+		if strings.HasPrefix(k, patternPrefix) {
+			names = append(names, k)
+		}
+	}
+	// the custom patterns are applied in a deterministic order.
+	sort.Strings(names)
+	for _, name := range names {
+		p, err := regexp.Compile(args[name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q, %w", name, err)
+		}
+		r.patterns = append(r.patterns, pattern{re: p})
+	}
+	if len(r.fields) == 0 && len(r.patterns) == 0 {
+		return nil, fmt.Errorf(`at least one of "fields", "patterns" or "pattern.<name>" is required`)
+	}
+	if m, ok := args["mode"]; ok {
+		r.mode = Mode(m)
+	}
+	switch r.mode {
+	case ModeReplace:
+		if v, ok := args["replacement"]; ok {
+			r.replacement = v
+		}
+	case ModeTruncate:
+		if v, ok := args["length"]; ok {
+			l, err := strconv.Atoi(v)
+			if err != nil || l < 0 {
+				return nil, fmt.Errorf("invalid length %q, it should be a non-negative integer", v)
+			}
+			r.length = l
+		}
+	case ModeHash:
+		file, ok := args["hashKeyFile"]
+		if !ok {
+			return nil, fmt.Errorf(`"hashKeyFile" is required for mode %q`, ModeHash)
+		}
+		key, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the hash key file %q, %w", file, err)
+		}
+		if r.hashKey = bytes.TrimSpace(key); len(r.hashKey) == 0 {
+			return nil, fmt.Errorf("the hash key file %q is empty", file)
+		}
+	case ModeDrop:
+	default:
+		return nil, fmt.Errorf("unsupported mode %q, supported modes are %q, %q, %q and %q", r.mode, ModeHash, ModeTruncate, ModeReplace, ModeDrop)
+	}
+	return r, nil
+}
+
+// Redact returns the payload with the fields and the matches of the patterns masked. The fields require a JSON object
+// payload, the patterns are applied to the string values of a JSON payload, or to the whole payload if it's not JSON.
+// An error is returned if the payload can't be redacted, the message should not be forwarded then.
+func (r *Redactor) Redact(payload []byte) ([]byte, error) {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(payload))
+	d.UseNumber()
+	isJSON := d.Decode(&v) == nil && !d.More()
+	if _, isObject := v.(map[string]interface{}); len(r.fields) > 0 && (!isJSON || !isObject) {
+		return nil, fmt.Errorf("payload is not a JSON object, the fields can not be redacted")
+	}
+	if !isJSON {
+		return []byte(r.redactString(string(payload))), nil
+	}
+	if obj, ok := v.(map[string]interface{}); ok {
+		for _, path := range r.fields {
+			r.redactField(obj, path)
+		}
+	}
+	if len(r.patterns) > 0 {
+		v = r.redactValue(v)
+	}
+	result, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the redacted payload, %w", err)
+	}
+	return result, nil
+}
+
+// redactField masks the field at the path, or removes it in the drop mode.
+func (r *Redactor) redactField(obj map[string]interface{}, path []string) {
+	for _, k := range path[:len(path)-1] {
+		child, ok := obj[k].(map[string]interface{})
+		if !ok {
+			return
+		}
+		obj = child
+	}
+	k := path[len(path)-1]
+	v, ok := obj[k]
+	if !ok || v == nil {
+		return
+	}
+	if r.mode == ModeDrop {
+		delete(obj, k)
+		return
+	}
+	var s string
+	switch x := v.(type) {
+	case string:
+		s = x
+	case json.Number:
+		s = x.String()
+	default:
+		b, _ := json.Marshal(x)
+		s = string(b)
+	}
+	obj[k] = r.mask(s)
+}
+
+// redactValue applies the patterns to the object keys, and the string and number values recursively, a number with a
+// match becomes a string. The keys masked to the same one keep the value of the last of them in the sorted order.
+func (r *Redactor) redactValue(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		result := make(map[string]interface{}, len(x))
+		for _, k := range keys {
+			result[r.redactString(k)] = r.redactValue(x[k])
+		}
+		return result
+	case []interface{}:
+		for i, child := range x {
+			x[i] = r.redactValue(child)
+		}
+	case string:
+		return r.redactString(x)
+	case json.Number:
+		if s := r.redactString(x.String()); s != x.String() {
+			return s
+		}
+	}
+	return v
+}
+
+// RedactKeys returns the keys with the matches of the patterns masked, the fields don't apply to the keys.
+func (r *Redactor) RedactKeys(keys []string) []string {
+	if len(r.patterns) == 0 || len(keys) == 0 {
+		return keys
+	}
+	result := make([]string, len(keys))
+	for i, k := range keys {
+		result[i] = r.redactString(k)
+	}
+	return result
+}
+
+func (r *Redactor) redactString(s string) string {
+	for _, p := range r.patterns {
+		s = p.re.ReplaceAllStringFunc(s, func(m string) string {
+			if p.valid != nil && !p.valid(m) {
+				return m
+			}
+			return r.mask(m)
+		})
+	}
+	return s
+}
+
+// isLuhnValid returns true if the digits of the string, ignoring the other characters, pass the Luhn checksum.
+func isLuhnValid(s string) bool {
+	sum, double := 0, false
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if double {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// mask masks the data according to the mode.
+func (r *Redactor) mask(s string) string {
+	switch r.mode {
+	case ModeHash:
+		h := hmac.New(sha256.New, r.hashKey)
+		h.Write([]byte(s))
+		return hex.EncodeToString(h.Sum(nil))
+	case ModeTruncate:
+		if runes := []rune(s); len(runes) > r.length {
+			return string(runes[:r.length])
+		}
+		return s
+	case ModeDrop:
+		return ""
+	default:
+		return r.replacement
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redact
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func hmacHex(key, s string) string {
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write([]byte(s))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func TestNew(t *testing.T) {
+	for name, args := range map[string]map[string]string{
+		"nothing to redact":   {"mode": "replace"},
+		"unknown pattern":     {"patterns": "phone"},
+		"invalid pattern":     {"pattern.bad": "("},
+		"unsupported mode":    {"fields": "a", "mode": "encrypt"},
+		"invalid length":      {"fields": "a", "mode": "truncate", "length": "-1"},
+		"missing hash key":    {"fields": "a", "mode": "hash"},
+		"hash key not exists": {"fields": "a", "mode": "hash", "hashKeyFile": "/non/existing/key"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(args)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	payload := `{"user":{"email":"numa@numaproj.io","card":"4111 1111 1111 1111"},"note":"contact numa@numaproj.io or flow@numaproj.io","amount":10}`
+
+	t.Run("replace fields", func(t *testing.T) {
+		r, err := New(map[string]string{"fields": "user.email, user.missing, missing.field"})
+		require.NoError(t, err)
+		result, err := r.Redact([]byte(payload))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"user":{"email":"***","card":"4111 1111 1111 1111"},"note":"contact numa@numaproj.io or flow@numaproj.io","amount":10}`, string(result))
+	})
+
+	t.Run("replace patterns", func(t *testing.T) {
+		r, err := New(map[string]string{"patterns": "email,creditCard", "replacement": "<redacted>"})
+		require.NoError(t, err)
+		result, err := r.Redact([]byte(payload))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"user":{"email":"<redacted>","card":"<redacted>"},"note":"contact <redacted> or <redacted>","amount":10}`, string(result))
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		r, err := New(map[string]string{"fields": "user.card", "pattern.domain": `@numaproj\.io`, "mode": "drop"})
+		require.NoError(t, err)
+		result, err := r.Redact([]byte(payload))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"user":{"email":"numa"},"note":"contact numa or flow","amount":10}`, string(result))
+	})
+
+	t.Run("truncate", func(t *testing.T) {
+		r, err := New(map[string]string{"fields": "user.card,amount", "mode": "truncate", "length": "1"})
+		require.NoError(t, err)
+		result, err := r.Redact([]byte(payload))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"user":{"email":"numa@numaproj.io","card":"4"},"note":"contact numa@numaproj.io or flow@numaproj.io","amount":"1"}`, string(result))
+	})
+
+	t.Run("hash", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "key")
+		require.NoError(t, os.WriteFile(file, []byte("secret\n"), 0600))
+		r, err := New(map[string]string{"fields": "user.email", "patterns": "email", "mode": "hash", "hashKeyFile": file})
+		require.NoError(t, err)
+		result, err := r.Redact([]byte(payload))
+		require.NoError(t, err)
+		numa, flow := hmacHex("secret", "numa@numaproj.io"), hmacHex("secret", "flow@numaproj.io")
+		assert.JSONEq(t, `{"user":{"email":"`+numa+`","card":"4111 1111 1111 1111"},"note":"contact `+numa+` or `+flow+`","amount":10}`, string(result))
+	})
+
+	t.Run("number matching a pattern", func(t *testing.T) {
+		r, err := New(map[string]string{"patterns": "creditCard"})
+		require.NoError(t, err)
+		result, err := r.Redact([]byte(`{"card":4111111111111111,"amount":12345678901234567890}`))
+		require.NoError(t, err)
+		assert.Equal(t, `{"amount":12345678901234567890,"card":"***"}`, string(result))
+	})
+
+	t.Run("card numbers failing the Luhn check", func(t *testing.T) {
+		r, err := New(map[string]string{"patterns": "creditCard"})
+		require.NoError(t, err)
+		result, err := r.Redact([]byte(`{"id":1234567890123,"card":"4111-1111-1111-1111","order":"order 4111-1111-1111-1112"}`))
+		require.NoError(t, err)
+		assert.Equal(t, `{"card":"***","id":1234567890123,"order":"order 4111-1111-1111-1112"}`, string(result))
+	})
+
+	t.Run("object keys matching a pattern", func(t *testing.T) {
+		r, err := New(map[string]string{"patterns": "email"})
+		require.NoError(t, err)
+		result, err := r.Redact([]byte(`{"contacts":{"numa@numaproj.io":{"name":"numa"},"flow@numaproj.io":"flow"},"region":"us"}`))
+		require.NoError(t, err)
+		assert.Equal(t, `{"contacts":{"***":{"name":"numa"}},"region":"us"}`, string(result))
+
+		r, err = New(map[string]string{"pattern.domain": `@numaproj\.io`, "mode": "drop"})
+		require.NoError(t, err)
+		result, err = r.Redact([]byte(`{"users":[{"numa@numaproj.io":1}]}`))
+		require.NoError(t, err)
+		assert.Equal(t, `{"users":[{"numa":1}]}`, string(result))
+	})
+
+	t.Run("non JSON payload", func(t *testing.T) {
+		r, err := New(map[string]string{"patterns": "email"})
+		require.NoError(t, err)
+		result, err := r.Redact([]byte("mail to numa@numaproj.io"))
+		require.NoError(t, err)
+		assert.Equal(t, "mail to ***", string(result))
+
+		r, err = New(map[string]string{"fields": "email"})
+		require.NoError(t, err)
+		_, err = r.Redact([]byte("mail to numa@numaproj.io"))
+		assert.Error(t, err)
+		_, err = r.Redact([]byte(`["numa@numaproj.io"]`))
+		assert.Error(t, err)
+	})
+}
+
+func TestRedactKeys(t *testing.T) {
+	r, err := New(map[string]string{"patterns": "email"})
+	require.NoError(t, err)
+	keys := []string{"numa@numaproj.io", "region"}
+	assert.Equal(t, []string{"***", "region"}, r.RedactKeys(keys))
+	assert.Equal(t, "numa@numaproj.io", keys[0])
+	assert.Nil(t, r.RedactKeys(nil))
+
+	// the fields don't apply to the keys.
+	r, err = New(map[string]string{"fields": "email"})
+	require.NoError(t, err)
+	assert.Equal(t, keys, r.RedactKeys(keys))
+}
+
+func TestIsLuhnValid(t *testing.T) {
+	assert.True(t, isLuhnValid("4111111111111111"))
+	assert.True(t, isLuhnValid("4111 1111 1111 1111"))
+	assert.True(t, isLuhnValid("5500-0000-0000-0004"))
+	assert.False(t, isLuhnValid("4111111111111112"))
+	assert.False(t, isLuhnValid("1234567890123"))
+}
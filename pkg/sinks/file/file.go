@@ -0,0 +1,310 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sinks/udsink"
+)
+
+// rotationCheckInterval is the interval to check if the files should be rotated by the interval.
+const rotationCheckInterval = time.Second
+
+// ToFile writes the messages to rolling files in a directory of a mounted volume. A write returns after the content
+// is synced to the disk, so that a message is never acknowledged before it's persisted.
+type ToFile struct {
+	name         string
+	pipelineName string
+	dir          string
+	// filePrefix is the prefix of the names of the files written by the replica.
+	filePrefix string
+	format     dfv1.FileSinkFormat
+	columns    []string
+	extension  string
+	maxSize    int64
+	interval   time.Duration
+	window     time.Duration
+	comp       *compressor
+	mu         sync.Mutex
+	// files are the open files, keyed by the start of their event time windows in Unix milliseconds,
+	// the key is always 0 if the files are not rotated by windows.
+	files map[int64]*rollingFile
+	// latestWindow is the start of the latest window written.
+	latestWindow int64
+	seq          int64
+	cancel       context.CancelFunc
+	doneCh       chan struct{}
+	log          *zap.SugaredLogger
+}
+
+type Option func(*ToFile) error
+
+// WithFallback indicates it's the fallback sink, which writes to the fallback mount path.
+func WithFallback() Option {
+	return func(t *ToFile) error {
+		t.dir = dfv1.PathFallbackFileSinkMount
+		t.filePrefix = t.name + "-fallback"
+		return nil
+	}
+}
+
+// WithDirectory sets the directory of the mounted volume.
+func WithDirectory(dir string) Option {
+	return func(t *ToFile) error {
+		t.dir = dir
+		return nil
+	}
+}
+
+// NewToFile returns ToFile type, the sink spec is passed in as it could be the fallback sink.
+func NewToFile(ctx context.Context, vertexInstance *dfv1.VertexInstance, fileSink *dfv1.FileSink, opts ...Option) (*ToFile, error) {
+	t := &ToFile{
+		name:         vertexInstance.Vertex.Spec.Name,
+		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
+		dir:          dfv1.PathFileSinkMount,
+		filePrefix:   vertexInstance.Vertex.Spec.Name,
+		format:       fileSink.GetFormat(),
+		columns:      fileSink.Columns,
+		extension:    extension(fileSink.GetFormat(), fileSink.GetCompression()),
+		maxSize:      fileSink.GetMaxSize(),
+		interval:     fileSink.GetInterval(),
+		window:       fileSink.GetWindow(),
+		files:        make(map[int64]*rollingFile),
+		doneCh:       make(chan struct{}),
+		log:          logging.FromContext(ctx).With("sinkType", "file"),
+	}
+	for _, o := range opts {
+		if err := o(t); err != nil {
+			return nil, err
+		}
+	}
+	if fileSink.Prefix != "" {
+		t.filePrefix = fileSink.Prefix
+	}
+	t.filePrefix = fmt.Sprintf("%s-%d-", t.filePrefix, vertexInstance.Replica)
+	t.dir = filepath.Join(t.dir, fileSink.Path)
+	if t.format != dfv1.FileSinkFormatJSONL && len(t.columns) == 0 {
+		return nil, fmt.Errorf("columns are required by the format %q", t.format)
+	}
+	if err := os.MkdirAll(t.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create the directory %q, %w", t.dir, err)
+	}
+	recovered, err := recoverFiles(t.dir, t.filePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover the files left by the previous run, %w", err)
+	}
+	if len(recovered) > 0 {
+		t.log.Infow("Recovered the files left by the previous run", zap.Strings("files", recovered))
+	}
+	if t.comp, err = newCompressor(fileSink.GetCompression()); err != nil {
+		return nil, err
+	}
+	cctx, cancel := context.WithCancel(ctx)
+	t.cancel = cancel
+	go t.rotateByInterval(cctx)
+	return t, nil
+}
+
+// GetName returns the name.
+func (t *ToFile) GetName() string {
+	return t.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (t *ToFile) GetPartitionIdx() int32 {
+	return 0
+}
+
+// batch is the records written to the file of a window, with the indexes of their messages.
+type batch struct {
+	window  int64
+	records []interface{}
+	indexes []int
+}
+
+// Write writes the messages to the files of their windows and syncs the files. A message that can't be written in the
+// format gets an error wrapping udsink.WriteToFallbackErr, so that it's written to the fallback sink, the messages
+// failed to be written to the disk are retried.
+func (t *ToFile) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	var batches []*batch
+	byWindow := make(map[int64]*batch)
+	var invalid int
+	for i, msg := range messages {
+		r, err := encode(t.format, t.columns, msg.Payload)
+		if err != nil {
+			errs[i] = fmt.Errorf("failed to encode the message as %s, %s, %w", t.format, err, &udsink.WriteToFallbackErr)
+			invalid++
+			continue
+		}
+		w := t.windowOf(msg.EventTime)
+		b, ok := byWindow[w]
+		if !ok {
+			b = &batch{window: w}
+			byWindow[w] = b
+			batches = append(batches, b)
+		}
+		b.records = append(b.records, r)
+		b.indexes = append(b.indexes, i)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	t.rotateExpired(now)
+	var written, failed int
+	for _, b := range batches {
+		if err := t.writeBatch(b, now); err != nil {
+			t.log.Errorw("Failed to write the messages", zap.Int64("window", b.window), zap.Error(err))
+			for _, i := range b.indexes {
+				errs[i] = err
+			}
+			failed += len(b.indexes)
+			continue
+		}
+		written += len(b.indexes)
+	}
+	if t.window > 0 {
+		// The files of the windows before the latest one are closed, the late messages go to new files.
+		for w := range t.files {
+			if w < t.latestWindow {
+				t.closeFile(w)
+			}
+		}
+	}
+	fileSinkWriteCount.With(map[string]string{metrics.LabelVertex: t.name, metrics.LabelPipeline: t.pipelineName}).Add(float64(written))
+	if invalid > 0 {
+		fileSinkWriteErrors.With(map[string]string{metrics.LabelVertex: t.name, metrics.LabelPipeline: t.pipelineName, metrics.LabelReason: "invalid"}).Add(float64(invalid))
+	}
+	if failed > 0 {
+		fileSinkWriteErrors.With(map[string]string{metrics.LabelVertex: t.name, metrics.LabelPipeline: t.pipelineName, metrics.LabelReason: "io"}).Add(float64(failed))
+	}
+	return nil, errs
+}
+
+// writeBatch appends the records to the file of the window, the file is closed if it reaches the max size, or if
+// the formatter is full, or if it fails, in which case the records are written to a new file when they're retried.
+func (t *ToFile) writeBatch(b *batch, now time.Time) error {
+	rf, ok := t.files[b.window]
+	if !ok {
+		var err error
+		if rf, err = openRollingFile(t.dir, t.fileName(b.window, now), newFormatter(t.format, t.columns, t.comp), now); err != nil {
+			return err
+		}
+		t.files[b.window] = rf
+	}
+	if b.window > t.latestWindow {
+		t.latestWindow = b.window
+	}
+	if err := rf.append(b.records); err != nil {
+		t.closeFile(b.window)
+		return err
+	}
+	if rf.committed >= t.maxSize || rf.formatter.full() {
+		t.closeFile(b.window)
+	}
+	return nil
+}
+
+// fileName returns the name of a new file.
+func (t *ToFile) fileName(window int64, now time.Time) string {
+	t.seq++
+	if t.window > 0 {
+		return fmt.Sprintf("%sw%d-%d-%d.%s", t.filePrefix, window, now.UnixMilli(), t.seq, t.extension)
+	}
+	return fmt.Sprintf("%s%d-%d.%s", t.filePrefix, now.UnixMilli(), t.seq, t.extension)
+}
+
+// windowOf returns the start of the event time window in Unix milliseconds, or 0 if the files are not rotated by windows.
+func (t *ToFile) windowOf(eventTime time.Time) int64 {
+	size := t.window.Milliseconds()
+	if size <= 0 {
+		return 0
+	}
+	ms := eventTime.UnixMilli()
+	return ms - ((ms%size)+size)%size
+}
+
+// closeFile closes the file of the window, the file is left in progress if it fails, which is recovered after restarting.
+func (t *ToFile) closeFile(window int64) {
+	rf := t.files[window]
+	delete(t.files, window)
+	if err := rf.close(); err != nil {
+		t.log.Errorw("Failed to close the file", zap.String("file", rf.name), zap.Error(err))
+		return
+	}
+	if rf.committed > 0 {
+		fileSinkFilesCount.With(map[string]string{metrics.LabelVertex: t.name, metrics.LabelPipeline: t.pipelineName}).Inc()
+	}
+}
+
+// rotateExpired closes the files which have been open for the interval.
+func (t *ToFile) rotateExpired(now time.Time) {
+	for w, rf := range t.files {
+		if now.Sub(rf.createdAt) >= t.interval {
+			t.closeFile(w)
+		}
+	}
+}
+
+// rotateByInterval closes the expired files periodically, as there might be no more writes to the files.
+func (t *ToFile) rotateByInterval(ctx context.Context) {
+	defer close(t.doneCh)
+	ticker := time.NewTicker(rotationCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			t.mu.Lock()
+			t.rotateExpired(now)
+			t.mu.Unlock()
+		}
+	}
+}
+
+// Close closes all the open files.
+func (t *ToFile) Close() error {
+	t.cancel()
+	<-t.doneCh
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var errs []error
+	for w, rf := range t.files {
+		delete(t.files, w)
+		if err := rf.close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	t.comp.close()
+	return errors.Join(errs...)
+}
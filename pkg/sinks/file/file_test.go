@@ -0,0 +1,281 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/sinks/udsink"
+)
+
+func newTestToFile(t *testing.T, fileSink *dfv1.FileSink, opts ...Option) (*ToFile, string) {
+	t.Helper()
+	dir := t.TempDir()
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "test-pipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "test-sink",
+				Sink: &dfv1.Sink{AbstractSink: dfv1.AbstractSink{File: fileSink}},
+			},
+		}},
+		Replica: 1,
+	}
+	toFile, err := NewToFile(context.Background(), vertexInstance, fileSink, append(opts, WithDirectory(dir))...)
+	require.NoError(t, err)
+	return toFile, filepath.Join(dir, fileSink.Path)
+}
+
+func testMessages(eventTime time.Time, payloads ...string) []isb.Message {
+	var messages []isb.Message
+	for i, p := range payloads {
+		messages = append(messages, isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+				ID:          isb.MessageID{VertexName: "test", Offset: "0-0", Index: int32(i)},
+			},
+			Body: isb.Body{Payload: []byte(p)},
+		})
+	}
+	return messages
+}
+
+// listFiles returns the names of the files in the directory, sorted.
+func listFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func assertNoErrors(t *testing.T, errs []error) {
+	t.Helper()
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+}
+
+func TestToFile_JSONL(t *testing.T) {
+	toFile, dir := newTestToFile(t, &dfv1.FileSink{Volume: "data", Path: "out"})
+	_, errs := toFile.Write(context.Background(), testMessages(time.Now(), `{"a": 1}`, "plain text"))
+	assertNoErrors(t, errs)
+
+	// The file is in progress, and the content is committed before the write returns.
+	files := listFiles(t, dir)
+	require.Len(t, files, 2)
+	assert.True(t, strings.HasPrefix(files[0], ".test-sink-1-") && strings.HasSuffix(files[0], ".jsonl"+committedSuffix))
+	assert.True(t, strings.HasSuffix(files[1], ".jsonl"+inProgressSuffix))
+	name := strings.TrimSuffix(strings.TrimPrefix(files[1], "."), inProgressSuffix)
+	committed, err := readCommitted(dir, name)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("{\"a\":1}\n\"plain text\"\n")), committed)
+
+	_, errs = toFile.Write(context.Background(), testMessages(time.Now(), `{"b":2}`))
+	assertNoErrors(t, errs)
+	require.NoError(t, toFile.Close())
+	assert.Equal(t, []string{name}, listFiles(t, dir))
+	assert.Equal(t, "{\"a\":1}\n\"plain text\"\n{\"b\":2}\n", readFile(t, filepath.Join(dir, name)))
+}
+
+func TestToFile_CSV(t *testing.T) {
+	toFile, dir := newTestToFile(t, &dfv1.FileSink{
+		Volume:      "data",
+		Prefix:      "events",
+		Format:      dfv1.FileSinkFormatCSV,
+		Columns:     []string{"id", "name", "tags"},
+		Compression: dfv1.FileSinkCompressionGzip,
+	})
+	_, errs := toFile.Write(context.Background(), testMessages(time.Now(), `{"id":1,"name":"a, b"}`, "not json"))
+	assert.NoError(t, errs[0])
+	assert.True(t, errors.Is(errs[1], &udsink.WriteToFallbackErr))
+	_, errs = toFile.Write(context.Background(), testMessages(time.Now(), `{"id":2,"name":null,"tags":["x", "y"]}`))
+	assertNoErrors(t, errs)
+	require.NoError(t, toFile.Close())
+
+	files := listFiles(t, dir)
+	require.Len(t, files, 1)
+	assert.True(t, strings.HasPrefix(files[0], "events-1-"))
+	assert.True(t, strings.HasSuffix(files[0], ".csv.gz"))
+	f, err := os.Open(filepath.Join(dir, files[0]))
+	require.NoError(t, err)
+	defer f.Close()
+	// The chunks are written as gzip members, which are read as a whole.
+	r, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	content, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "id,name,tags\n1,\"a, b\",\n2,,\"[\"\"x\"\",\"\"y\"\"]\"\n", string(content))
+}
+
+func TestToFile_Zstd(t *testing.T) {
+	toFile, dir := newTestToFile(t, &dfv1.FileSink{Volume: "data", Compression: dfv1.FileSinkCompressionZstd})
+	for i := 0; i < 3; i++ {
+		_, errs := toFile.Write(context.Background(), testMessages(time.Now(), `{"a":1}`))
+		assertNoErrors(t, errs)
+	}
+	require.NoError(t, toFile.Close())
+	files := listFiles(t, dir)
+	require.Len(t, files, 1)
+	assert.True(t, strings.HasSuffix(files[0], ".jsonl.zst"))
+	dec, err := zstd.NewReader(nil)
+	require.NoError(t, err)
+	defer dec.Close()
+	content, err := dec.DecodeAll([]byte(readFile(t, filepath.Join(dir, files[0]))), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"a\":1}\n{\"a\":1}\n{\"a\":1}\n", string(content))
+}
+
+func TestToFile_RotateBySize(t *testing.T) {
+	size := apiresource.MustParse("10")
+	toFile, dir := newTestToFile(t, &dfv1.FileSink{Volume: "data", Rotation: &dfv1.FileSinkRotation{MaxSize: &size}})
+	_, errs := toFile.Write(context.Background(), testMessages(time.Now(), `"0123456789"`))
+	assertNoErrors(t, errs)
+	_, errs = toFile.Write(context.Background(), testMessages(time.Now(), `"a"`))
+	assertNoErrors(t, errs)
+	// The first file is closed as it reaches the max size, the second one is still in progress.
+	files := listFiles(t, dir)
+	require.Len(t, files, 3)
+	assert.Equal(t, "\"0123456789\"\n", readFile(t, filepath.Join(dir, files[2])))
+	require.NoError(t, toFile.Close())
+	assert.Len(t, listFiles(t, dir), 2)
+}
+
+func TestToFile_RotateByInterval(t *testing.T) {
+	toFile, dir := newTestToFile(t, &dfv1.FileSink{Volume: "data", Rotation: &dfv1.FileSinkRotation{Interval: &metav1.Duration{Duration: time.Minute}}})
+	defer func() { _ = toFile.Close() }()
+	_, errs := toFile.Write(context.Background(), testMessages(time.Now(), `"a"`))
+	assertNoErrors(t, errs)
+	toFile.mu.Lock()
+	toFile.rotateExpired(time.Now())
+	assert.Len(t, toFile.files, 1)
+	toFile.rotateExpired(time.Now().Add(time.Minute))
+	assert.Len(t, toFile.files, 0)
+	toFile.mu.Unlock()
+	files := listFiles(t, dir)
+	require.Len(t, files, 1)
+	assert.Equal(t, "\"a\"\n", readFile(t, filepath.Join(dir, files[0])))
+}
+
+func TestToFile_RotateByWindow(t *testing.T) {
+	toFile, dir := newTestToFile(t, &dfv1.FileSink{Volume: "data", Rotation: &dfv1.FileSinkRotation{Window: &metav1.Duration{Duration: time.Minute}}})
+	base := time.Unix(1700000010, 0)
+	messages := append(testMessages(base, `"w1"`), testMessages(base.Add(40*time.Second), `"w2"`)...)
+	_, errs := toFile.Write(context.Background(), messages)
+	assertNoErrors(t, errs)
+	// The file of the earlier window is closed once a later window is written.
+	files := listFiles(t, dir)
+	require.Len(t, files, 3)
+	assert.True(t, strings.HasPrefix(files[2], "test-sink-1-w1699999980000-"))
+	assert.Equal(t, "\"w1\"\n", readFile(t, filepath.Join(dir, files[2])))
+
+	// A late message is written to a new file, which is closed right away.
+	_, errs = toFile.Write(context.Background(), testMessages(base, `"late"`))
+	assertNoErrors(t, errs)
+	require.NoError(t, toFile.Close())
+	files = listFiles(t, dir)
+	require.Len(t, files, 3)
+	assert.True(t, strings.HasPrefix(files[0], "test-sink-1-w1699999980000-"))
+	assert.True(t, strings.HasPrefix(files[1], "test-sink-1-w1699999980000-"))
+	assert.True(t, strings.HasPrefix(files[2], "test-sink-1-w1700000040000-"))
+	assert.Equal(t, "\"w2\"\n", readFile(t, filepath.Join(dir, files[2])))
+}
+
+func TestToFile_Fallback(t *testing.T) {
+	toFile, dir := newTestToFile(t, &dfv1.FileSink{Volume: "data"}, WithFallback())
+	_, errs := toFile.Write(context.Background(), testMessages(time.Now(), `"a"`))
+	assertNoErrors(t, errs)
+	require.NoError(t, toFile.Close())
+	files := listFiles(t, dir)
+	require.Len(t, files, 1)
+	assert.True(t, strings.HasPrefix(files[0], "test-sink-fallback-1-"))
+}
+
+func TestRecoverFiles(t *testing.T) {
+	dir := t.TempDir()
+	// The content after the committed size is not acknowledged.
+	require.NoError(t, os.WriteFile(inProgressPath(dir, "test-sink-1-1-1.jsonl"), []byte("\"a\"\n\"b"), 0o644))
+	require.NoError(t, os.WriteFile(committedPath(dir, "test-sink-1-1-1.jsonl"), binary.LittleEndian.AppendUint64(nil, 4), 0o644))
+	// Nothing is committed.
+	require.NoError(t, os.WriteFile(inProgressPath(dir, "test-sink-1-2-2.jsonl"), []byte("\"c"), 0o644))
+	// The committed file is left after the file is renamed.
+	require.NoError(t, os.WriteFile(committedPath(dir, "test-sink-1-3-3.jsonl"), binary.LittleEndian.AppendUint64(nil, 4), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "test-sink-1-3-3.jsonl"), []byte("\"d\"\n"), 0o644))
+	// The file of another replica.
+	require.NoError(t, os.WriteFile(inProgressPath(dir, "test-sink-2-4-4.jsonl"), []byte("\"e\"\n"), 0o644))
+	// The file of the replica 0 of another vertex "test-sink-1", whose prefix starts with the prefix.
+	require.NoError(t, os.WriteFile(inProgressPath(dir, "test-sink-1-0-5-5.jsonl"), []byte("\"f\"\n"), 0o644))
+	require.NoError(t, os.WriteFile(inProgressPath(dir, "test-sink-1-0-w6-5-5.jsonl"), []byte("\"g\"\n"), 0o644))
+
+	recovered, err := recoverFiles(dir, "test-sink-1-")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"test-sink-1-1-1.jsonl"}, recovered)
+	assert.Equal(t, []string{
+		".test-sink-1-0-5-5.jsonl" + inProgressSuffix,
+		".test-sink-1-0-w6-5-5.jsonl" + inProgressSuffix,
+		".test-sink-2-4-4.jsonl" + inProgressSuffix,
+		"test-sink-1-1-1.jsonl",
+		"test-sink-1-3-3.jsonl",
+	}, listFiles(t, dir))
+	assert.Equal(t, "\"a\"\n", readFile(t, filepath.Join(dir, "test-sink-1-1-1.jsonl")))
+	assert.Equal(t, "\"d\"\n", readFile(t, filepath.Join(dir, "test-sink-1-3-3.jsonl")))
+}
+
+func TestIsOwnedFile(t *testing.T) {
+	for n, owned := range map[string]bool{
+		".sink-1-1700000000000-1.jsonl" + inProgressSuffix:                  true,
+		".sink-1-1700000000000-1.csv.gz" + committedSuffix:                  true,
+		".sink-1-w1699999999000-1700000000000-2.parquet" + inProgressSuffix: true,
+		".sink-1-w-60000-1700000000000-2.jsonl" + inProgressSuffix:          true,
+		"sink-1-1700000000000-1.jsonl":                                      false,
+		".sink-1-1700000000000-1.jsonl":                                     false,
+		".sink-1-0-1700000000000-1.jsonl" + inProgressSuffix:                false,
+		".sink-1-0-w1-1700000000000-1.jsonl" + committedSuffix:              false,
+		".sink-2-1700000000000-1.jsonl" + inProgressSuffix:                  false,
+	} {
+		assert.Equal(t, owned, isOwnedFile(n, "sink-1-"), n)
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// formatter encodes the records of a file, a new formatter is created for each file.
+type formatter interface {
+	// chunk returns the bytes appended to the file at the offset for the records, the committed content of the file is
+	// never overwritten, so that it's still valid if the process crashes in the middle of writing a chunk.
+	chunk(records []interface{}, offset int64) ([]byte, error)
+	// full returns true if the file should be closed, as no more chunks should be appended to it.
+	full() bool
+}
+
+// newFormatter returns a formatter of the format.
+func newFormatter(format dfv1.FileSinkFormat, columns []string, comp *compressor) formatter {
+	switch format {
+	case dfv1.FileSinkFormatCSV:
+		return &csvFormatter{columns: columns, comp: comp}
+	case dfv1.FileSinkFormatParquet:
+		return &parquetFormatter{columns: columns, comp: comp}
+	default:
+		return &jsonlFormatter{comp: comp}
+	}
+}
+
+// encode converts a message payload to a record of the format, an error means the payload can't be written in the format.
+func encode(format dfv1.FileSinkFormat, columns []string, payload []byte) (interface{}, error) {
+	switch format {
+	case dfv1.FileSinkFormatCSV:
+		values, err := columnValues(payload, columns)
+		if err != nil {
+			return nil, err
+		}
+		row := make([]string, len(values))
+		for i, v := range values {
+			if v != nil {
+				row[i] = *v
+			}
+		}
+		return row, nil
+	case dfv1.FileSinkFormatParquet:
+		return columnValues(payload, columns)
+	default:
+		if json.Valid(payload) {
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, payload); err != nil {
+				return nil, err
+			}
+			return buf.Bytes(), nil
+		}
+		return json.Marshal(string(payload))
+	}
+}
+
+// extension returns the extension of the file names.
+func extension(format dfv1.FileSinkFormat, compression dfv1.FileSinkCompression) string {
+	ext := string(format)
+	if format == dfv1.FileSinkFormatParquet {
+		// The pages of the parquet files are compressed, rather than the files.
+		return ext
+	}
+	switch compression {
+	case dfv1.FileSinkCompressionGzip:
+		ext += ".gz"
+	case dfv1.FileSinkCompressionZstd:
+		ext += ".zst"
+	}
+	return ext
+}
+
+// jsonlFormatter writes a payload per line, the JSON payloads are compacted and the others are written as JSON strings.
+type jsonlFormatter struct {
+	comp *compressor
+}
+
+func (f *jsonlFormatter) chunk(records []interface{}, _ int64) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, r := range records {
+		buf.Write(r.([]byte))
+		buf.WriteByte('\n')
+	}
+	return f.comp.compress(buf.Bytes())
+}
+
+func (f *jsonlFormatter) full() bool {
+	return false
+}
+
+// csvFormatter writes the columns of the JSON object payloads, with a header line at the beginning of the file.
+type csvFormatter struct {
+	columns []string
+	comp    *compressor
+}
+
+func (f *csvFormatter) chunk(records []interface{}, offset int64) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if offset == 0 {
+		if err := w.Write(f.columns); err != nil {
+			return nil, err
+		}
+	}
+	for _, r := range records {
+		if err := w.Write(r.([]string)); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return f.comp.compress(buf.Bytes())
+}
+
+func (f *csvFormatter) full() bool {
+	return false
+}
+
+// columnValues returns the values of the columns of a JSON object payload, the strings are unquoted and the other
+// values are written as JSON, nil means the field is absent or null.
+func columnValues(payload []byte, columns []string) ([]*string, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &obj); err != nil {
+		return nil, fmt.Errorf("the payload is not a JSON object, %w", err)
+	}
+	values := make([]*string, len(columns))
+	for i, c := range columns {
+		raw, ok := obj[c]
+		if !ok || string(raw) == "null" {
+			continue
+		}
+		var s string
+		if raw[0] == '"' {
+			if err := json.Unmarshal(raw, &s); err != nil {
+				return nil, err
+			}
+		} else {
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, raw); err != nil {
+				return nil, err
+			}
+			s = buf.String()
+		}
+		values[i] = &s
+	}
+	return values, nil
+}
+
+// compressor compresses the chunks of the files, each chunk is compressed as a gzip member or a zstd frame,
+// the concatenation of which is a valid compressed file.
+type compressor struct {
+	compression dfv1.FileSinkCompression
+	zstd        *zstd.Encoder
+}
+
+func newCompressor(compression dfv1.FileSinkCompression) (*compressor, error) {
+	c := &compressor{compression: compression}
+	if compression == dfv1.FileSinkCompressionZstd {
+		enc, err := zstd.NewWriter(nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the zstd encoder, %w", err)
+		}
+		c.zstd = enc
+	}
+	return c, nil
+}
+
+func (c *compressor) compress(data []byte) ([]byte, error) {
+	switch c.compression {
+	case dfv1.FileSinkCompressionGzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case dfv1.FileSinkCompressionZstd:
+		return c.zstd.EncodeAll(data, nil), nil
+	default:
+		return data, nil
+	}
+}
+
+func (c *compressor) close() {
+	if c.zstd != nil {
+		_ = c.zstd.Close()
+	}
+}
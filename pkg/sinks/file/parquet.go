@@ -0,0 +1,224 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+const (
+	parquetMagic          = "PAR1"
+	parquetCreatedBy      = "numaflow file sink"
+	parquetSchemaRootName = "schema"
+	// maxParquetRowGroups is the max number of the row groups of a file, as the footer listing all of them is
+	// written again after every chunk.
+	maxParquetRowGroups = 128
+)
+
+// The values of the enums of the parquet format.
+const (
+	parquetTypeByteArray      int32 = 6
+	parquetRepetitionOptional int32 = 1
+	parquetConvertedTypeUTF8  int32 = 0
+	parquetEncodingPlain      int32 = 0
+	parquetEncodingRLE        int32 = 3
+	parquetPageTypeData       int32 = 0
+	parquetCodecUncompressed  int32 = 0
+	parquetCodecGzip          int32 = 2
+	parquetCodecZstd          int32 = 6
+	parquetFormatVersion      int32 = 1
+)
+
+// parquetFormatter writes the columns of the JSON object payloads to a parquet file, all the columns are optional
+// UTF-8 strings. Each chunk is a row group with a single data page per column, followed by the footer of all the row
+// groups, so that the file is a valid parquet file after every chunk. The footers of the previous chunks are left in
+// the file, they're skipped by the readers, as the footer at the end refers to the pages by their absolute offsets.
+// The file is full after maxParquetRowGroups chunks, which bounds the size of the footers written.
+type parquetFormatter struct {
+	columns   []string
+	comp      *compressor
+	rowGroups []parquetRowGroup
+	numRows   int64
+}
+
+type parquetRowGroup struct {
+	columns []parquetColumnChunk
+	numRows int64
+}
+
+type parquetColumnChunk struct {
+	offset           int64
+	numValues        int64
+	uncompressedSize int64
+	compressedSize   int64
+}
+
+func (f *parquetFormatter) chunk(records []interface{}, offset int64) ([]byte, error) {
+	var buf bytes.Buffer
+	if offset == 0 {
+		buf.WriteString(parquetMagic)
+	}
+	rg := parquetRowGroup{numRows: int64(len(records))}
+	for i := range f.columns {
+		values := make([]*string, len(records))
+		for j, r := range records {
+			values[j] = r.([]*string)[i]
+		}
+		uncompressed := parquetPage(values)
+		compressed, err := f.comp.compress(uncompressed)
+		if err != nil {
+			return nil, err
+		}
+		header := parquetPageHeader(len(values), len(uncompressed), len(compressed))
+		rg.columns = append(rg.columns, parquetColumnChunk{
+			offset:           offset + int64(buf.Len()),
+			numValues:        int64(len(values)),
+			uncompressedSize: int64(len(header) + len(uncompressed)),
+			compressedSize:   int64(len(header) + len(compressed)),
+		})
+		buf.Write(header)
+		buf.Write(compressed)
+	}
+	f.rowGroups = append(f.rowGroups, rg)
+	f.numRows += rg.numRows
+	buf.Write(f.footer())
+	return buf.Bytes(), nil
+}
+
+func (f *parquetFormatter) full() bool {
+	return len(f.rowGroups) >= maxParquetRowGroups
+}
+
+// parquetPage returns the content of a data page, which is the definition levels followed by the plain encoded values.
+func parquetPage(values []*string) []byte {
+	// The definition levels are encoded with bit-packed runs of the RLE/bit-packing hybrid encoding,
+	// prefixed with the length, each group of 8 levels is packed into a byte.
+	groups := (len(values) + 7) / 8
+	levels := make([]byte, 0, groups+binary.MaxVarintLen64)
+	levels = binary.AppendUvarint(levels, uint64(groups)<<1|1)
+	packed := make([]byte, groups)
+	for i, v := range values {
+		if v != nil {
+			packed[i/8] |= 1 << (i % 8)
+		}
+	}
+	levels = append(levels, packed...)
+
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(len(levels)))
+	buf.Write(levels)
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		_ = binary.Write(&buf, binary.LittleEndian, uint32(len(*v)))
+		buf.WriteString(*v)
+	}
+	return buf.Bytes()
+}
+
+func parquetPageHeader(numValues, uncompressedSize, compressedSize int) []byte {
+	w := &thriftWriter{}
+	w.structBegin()
+	w.i32Field(1, parquetPageTypeData)
+	w.i32Field(2, int32(uncompressedSize))
+	w.i32Field(3, int32(compressedSize))
+	w.structField(5)
+	w.i32Field(1, int32(numValues))
+	w.i32Field(2, parquetEncodingPlain)
+	w.i32Field(3, parquetEncodingRLE)
+	w.i32Field(4, parquetEncodingRLE)
+	w.structEnd()
+	w.structEnd()
+	return w.bytes()
+}
+
+func (f *parquetFormatter) codec() int32 {
+	switch f.comp.compression {
+	case dfv1.FileSinkCompressionGzip:
+		return parquetCodecGzip
+	case dfv1.FileSinkCompressionZstd:
+		return parquetCodecZstd
+	default:
+		return parquetCodecUncompressed
+	}
+}
+
+// footer returns the file metadata of all the row groups, followed by its length and the magic number.
+func (f *parquetFormatter) footer() []byte {
+	w := &thriftWriter{}
+	w.structBegin()
+	w.i32Field(1, parquetFormatVersion)
+	w.listField(2, thriftStruct, len(f.columns)+1)
+	w.structBegin()
+	w.stringField(4, parquetSchemaRootName)
+	w.i32Field(5, int32(len(f.columns)))
+	w.structEnd()
+	for _, c := range f.columns {
+		w.structBegin()
+		w.i32Field(1, parquetTypeByteArray)
+		w.i32Field(3, parquetRepetitionOptional)
+		w.stringField(4, c)
+		w.i32Field(6, parquetConvertedTypeUTF8)
+		// The logical type is a union, of which the field 1 is the empty StringType.
+		w.structField(10)
+		w.structField(1)
+		w.structEnd()
+		w.structEnd()
+		w.structEnd()
+	}
+	w.i64Field(3, f.numRows)
+	w.listField(4, thriftStruct, len(f.rowGroups))
+	for _, rg := range f.rowGroups {
+		var totalSize int64
+		w.structBegin()
+		w.listField(1, thriftStruct, len(rg.columns))
+		for i, c := range rg.columns {
+			totalSize += c.uncompressedSize
+			w.structBegin()
+			w.i64Field(2, c.offset)
+			w.structField(3)
+			w.i32Field(1, parquetTypeByteArray)
+			w.listField(2, thriftI32, 2)
+			w.i32Value(parquetEncodingPlain)
+			w.i32Value(parquetEncodingRLE)
+			w.listField(3, thriftBinary, 1)
+			w.stringValue(f.columns[i])
+			w.i32Field(4, f.codec())
+			w.i64Field(5, c.numValues)
+			w.i64Field(6, c.uncompressedSize)
+			w.i64Field(7, c.compressedSize)
+			w.i64Field(9, c.offset)
+			w.structEnd()
+			w.structEnd()
+		}
+		w.i64Field(2, totalSize)
+		w.i64Field(3, rg.numRows)
+		w.structEnd()
+	}
+	w.stringField(6, parquetCreatedBy)
+	w.structEnd()
+
+	footer := w.bytes()
+	out := make([]byte, 0, len(footer)+8)
+	out = append(out, footer...)
+	out = binary.LittleEndian.AppendUint32(out, uint32(len(footer)))
+	return append(out, parquetMagic...)
+}
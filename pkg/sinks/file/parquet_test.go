@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// parquetRow is a row of the test parquet files, read with the parquet library.
+type parquetRow struct {
+	ID   *string `parquet:"id,optional"`
+	Name *string `parquet:"name,optional"`
+}
+
+// readParquetFile reads the rows of a parquet file with the parquet library, and returns the number of row groups.
+func readParquetFile(t *testing.T, path string) ([]parquetRow, int) {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	stat, err := f.Stat()
+	require.NoError(t, err)
+	pf, err := parquet.OpenFile(f, stat.Size())
+	require.NoError(t, err)
+	var columns []string
+	for _, field := range pf.Schema().Fields() {
+		columns = append(columns, field.Name())
+		assert.True(t, field.Optional())
+		assert.Equal(t, parquet.String().Type(), field.Type())
+	}
+	assert.Equal(t, []string{"id", "name"}, columns)
+	rows, err := parquet.Read[parquetRow](f, stat.Size())
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(rows)), pf.NumRows())
+	return rows, len(pf.RowGroups())
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestToFile_Parquet(t *testing.T) {
+	for _, compression := range []dfv1.FileSinkCompression{dfv1.FileSinkCompressionNone, dfv1.FileSinkCompressionGzip, dfv1.FileSinkCompressionZstd} {
+		t.Run(string(compression), func(t *testing.T) {
+			toFile, dir := newTestToFile(t, &dfv1.FileSink{
+				Volume:      "data",
+				Format:      dfv1.FileSinkFormatParquet,
+				Columns:     []string{"id", "name"},
+				Compression: compression,
+			})
+			_, errs := toFile.Write(context.Background(), testMessages(time.Now(), `{"id":1,"name":"a"}`, `{"id":2}`))
+			assertNoErrors(t, errs)
+			_, errs = toFile.Write(context.Background(), testMessages(time.Now(), `{"id":3,"name":{"x": 1}}`))
+			assertNoErrors(t, errs)
+			require.NoError(t, toFile.Close())
+
+			files := listFiles(t, dir)
+			require.Len(t, files, 1)
+			assert.Equal(t, ".parquet", filepath.Ext(files[0]))
+			rows, rowGroups := readParquetFile(t, filepath.Join(dir, files[0]))
+			// a row group per write.
+			assert.Equal(t, 2, rowGroups)
+			var ids, names []*string
+			for _, r := range rows {
+				ids = append(ids, r.ID)
+				names = append(names, r.Name)
+			}
+			assert.Equal(t, []*string{strPtr("1"), strPtr("2"), strPtr("3")}, ids)
+			assert.Equal(t, []*string{strPtr("a"), nil, strPtr(`{"x":1}`)}, names)
+		})
+	}
+}
+
+func TestToFile_ParquetMaxRowGroups(t *testing.T) {
+	toFile, dir := newTestToFile(t, &dfv1.FileSink{
+		Volume:  "data",
+		Format:  dfv1.FileSinkFormatParquet,
+		Columns: []string{"id", "name"},
+	})
+	for i := 0; i <= maxParquetRowGroups; i++ {
+		_, errs := toFile.Write(context.Background(), testMessages(time.Now(), `{"id":1,"name":"a"}`))
+		assertNoErrors(t, errs)
+	}
+	require.NoError(t, toFile.Close())
+
+	// the file is closed once it has the max number of the row groups.
+	files := listFiles(t, dir)
+	require.Len(t, files, 2)
+	rows, rowGroups := readParquetFile(t, filepath.Join(dir, files[0]))
+	assert.Len(t, rows, maxParquetRowGroups)
+	assert.Equal(t, maxParquetRowGroups, rowGroups)
+	rows, rowGroups = readParquetFile(t, filepath.Join(dir, files[1]))
+	assert.Len(t, rows, 1)
+	assert.Equal(t, 1, rowGroups)
+}
+
+func TestParquetRecoverAfterCrash(t *testing.T) {
+	dir := t.TempDir()
+	name := "test-sink-1-1-1.parquet"
+	rf, err := openRollingFile(dir, name, newFormatter(dfv1.FileSinkFormatParquet, []string{"id", "name"}, &compressor{}), time.Now())
+	require.NoError(t, err)
+	require.NoError(t, rf.append([]interface{}{[]*string{strPtr("1"), strPtr("a")}}))
+	// The next chunk is written, but its size fails to be committed, as if it crashed before that.
+	require.NoError(t, rf.committedFile.Close())
+	assert.Error(t, rf.append([]interface{}{[]*string{strPtr("2"), nil}}))
+	require.NoError(t, rf.file.Close())
+
+	recovered, err := recoverFiles(dir, "test-sink-1-")
+	require.NoError(t, err)
+	assert.Equal(t, []string{name}, recovered)
+	rows, rowGroups := readParquetFile(t, filepath.Join(dir, name))
+	assert.Equal(t, 1, rowGroups)
+	assert.Equal(t, []parquetRow{{ID: strPtr("1"), Name: strPtr("a")}}, rows)
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	inProgressSuffix = ".inprogress"
+	committedSuffix  = ".committed"
+)
+
+// fileNameSuffixPattern matches the part of a file name after its prefix, which is the optional window start, the
+// created time, the sequence and the extension, see ToFile.fileName.
+var fileNameSuffixPattern = regexp.MustCompile(`^(?:w-?\d+-)?\d+-\d+\.[a-z.]+$`)
+
+// rollingFile is a file being written. It's written as a hidden in-progress file, which is renamed to its final name
+// when it's closed. The size of the content synced to the disk is recorded in a hidden committed file, so that the
+// in-progress file can be truncated to the committed content and renamed after a crash.
+type rollingFile struct {
+	dir           string
+	name          string
+	file          *os.File
+	committedFile *os.File
+	formatter     formatter
+	// committed is the size of the content synced to the disk.
+	committed int64
+	createdAt time.Time
+}
+
+func inProgressPath(dir, name string) string {
+	return filepath.Join(dir, "."+name+inProgressSuffix)
+}
+
+func committedPath(dir, name string) string {
+	return filepath.Join(dir, "."+name+committedSuffix)
+}
+
+func openRollingFile(dir, name string, f formatter, createdAt time.Time) (*rollingFile, error) {
+	file, err := os.OpenFile(inProgressPath(dir, name), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the file %q, %w", name, err)
+	}
+	committedFile, err := os.OpenFile(committedPath(dir, name), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		return nil, fmt.Errorf("failed to create the committed file of %q, %w", name, err)
+	}
+	rf := &rollingFile{dir: dir, name: name, file: file, committedFile: committedFile, formatter: f, createdAt: createdAt}
+	if err := syncDir(dir); err != nil {
+		_ = rf.close()
+		return nil, err
+	}
+	return rf, nil
+}
+
+// append writes the records after the committed content and syncs them to the disk, the records are committed only
+// if it succeeds. The file should be closed after a failure, as the state of the formatter is not rolled back.
+func (rf *rollingFile) append(records []interface{}) error {
+	data, err := rf.formatter.chunk(records, rf.committed)
+	if err != nil {
+		return fmt.Errorf("failed to encode the records, %w", err)
+	}
+	if _, err := rf.file.WriteAt(data, rf.committed); err != nil {
+		return fmt.Errorf("failed to write the file %q, %w", rf.name, err)
+	}
+	committed := rf.committed + int64(len(data))
+	if err := rf.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync the file %q, %w", rf.name, err)
+	}
+	if _, err := rf.committedFile.WriteAt(binary.LittleEndian.AppendUint64(nil, uint64(committed)), 0); err != nil {
+		return fmt.Errorf("failed to write the committed file of %q, %w", rf.name, err)
+	}
+	if err := rf.committedFile.Sync(); err != nil {
+		return fmt.Errorf("failed to sync the committed file of %q, %w", rf.name, err)
+	}
+	rf.committed = committed
+	return nil
+}
+
+// close renames the file to its final name with the committed content, or removes it if nothing is committed.
+func (rf *rollingFile) close() error {
+	_ = rf.committedFile.Close()
+	if err := rf.file.Close(); err != nil {
+		return fmt.Errorf("failed to close the file %q, %w", rf.name, err)
+	}
+	return finalize(rf.dir, rf.name, rf.committed)
+}
+
+// finalize truncates the in-progress file to the committed size and renames it to its final name,
+// the file is removed if the size is 0.
+func finalize(dir, name string, committed int64) error {
+	tmp := inProgressPath(dir, name)
+	if committed == 0 {
+		if err := os.Remove(tmp); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to remove the empty file %q, %w", name, err)
+		}
+	} else {
+		file, err := os.OpenFile(tmp, os.O_WRONLY, 0)
+		if err != nil {
+			return fmt.Errorf("failed to open the file %q, %w", name, err)
+		}
+		err = file.Truncate(committed)
+		if err == nil {
+			err = file.Sync()
+		}
+		_ = file.Close()
+		if err != nil {
+			return fmt.Errorf("failed to truncate the file %q, %w", name, err)
+		}
+		if err := os.Rename(tmp, filepath.Join(dir, name)); err != nil {
+			return fmt.Errorf("failed to rename the file %q, %w", name, err)
+		}
+	}
+	if err := syncDir(dir); err != nil {
+		return err
+	}
+	if err := os.Remove(committedPath(dir, name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove the committed file of %q, %w", name, err)
+	}
+	return nil
+}
+
+// recoverFiles finalizes the in-progress files of the prefix left by a previous run, e.g. after a crash, and returns
+// their names. The content after the committed size is discarded, as it's not acknowledged and is written again.
+func recoverFiles(dir, prefix string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the directory %q, %w", dir, err)
+	}
+	var recovered []string
+	for _, e := range entries {
+		n := e.Name()
+		if !isOwnedFile(n, prefix) {
+			continue
+		}
+		switch {
+		case strings.HasSuffix(n, inProgressSuffix):
+			name := strings.TrimSuffix(strings.TrimPrefix(n, "."), inProgressSuffix)
+			committed, err := readCommitted(dir, name)
+			if err != nil {
+				return nil, err
+			}
+			if err := finalize(dir, name, committed); err != nil {
+				return nil, err
+			}
+			if committed > 0 {
+				recovered = append(recovered, name)
+			}
+		case strings.HasSuffix(n, committedSuffix):
+			// A committed file without the in-progress file is left if it crashed in the middle of finalizing.
+			name := strings.TrimSuffix(strings.TrimPrefix(n, "."), committedSuffix)
+			if _, err := os.Stat(inProgressPath(dir, name)); errors.Is(err, fs.ErrNotExist) {
+				if err := os.Remove(filepath.Join(dir, n)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+					return nil, fmt.Errorf("failed to remove the committed file %q, %w", n, err)
+				}
+			}
+		}
+	}
+	return recovered, nil
+}
+
+// isOwnedFile returns true if the name is of a hidden in-progress or committed file named with the prefix. The whole
+// name is matched, as the prefix of a replica might be the prefix of the files of another vertex, e.g. "sink-1-" of
+// the replica 1 of "sink" and "sink-1-0-" of the replica 0 of "sink-1".
+func isOwnedFile(n, prefix string) bool {
+	name, ok := strings.CutPrefix(n, "."+prefix)
+	if !ok {
+		return false
+	}
+	if s, ok := strings.CutSuffix(name, inProgressSuffix); ok {
+		name = s
+	} else if s, ok := strings.CutSuffix(name, committedSuffix); ok {
+		name = s
+	} else {
+		return false
+	}
+	return fileNameSuffixPattern.MatchString(name)
+}
+
+// readCommitted returns the committed size of an in-progress file, 0 if it's not recorded.
+func readCommitted(dir, name string) (int64, error) {
+	b, err := os.ReadFile(committedPath(dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to read the committed file of %q, %w", name, err)
+	}
+	if len(b) < 8 {
+		return 0, nil
+	}
+	return int64(binary.LittleEndian.Uint64(b)), nil
+}
+
+// syncDir syncs the directory, so that the files created, renamed and removed in it are persisted.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return fmt.Errorf("failed to open the directory %q, %w", dir, err)
+	}
+	defer d.Close()
+	if err := d.Sync(); err != nil {
+		return fmt.Errorf("failed to sync the directory %q, %w", dir, err)
+	}
+	return nil
+}
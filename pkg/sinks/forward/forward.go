@@ -33,6 +33,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/ratelimit"
 	"github.com/numaproj/numaflow/pkg/sinks/sinker"
 	"github.com/numaproj/numaflow/pkg/sinks/udsink"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
@@ -58,6 +59,8 @@ type DataForward struct {
 	idleManager wmb.IdleManager
 	// wmbChecker checks if the idle watermark is valid.
 	wmbChecker wmb.WMBChecker
+	// retryPolicy decides how the messages failed to be written to the sink are retried.
+	retryPolicy *retryPolicy
 	Shutdown
 }
 
@@ -77,6 +80,10 @@ func NewDataForward(
 			return nil, err
 		}
 	}
+	rp, err := newRetryPolicy(dOpts.retryStrategy, dOpts.retryInterval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid retry strategy, %w", err)
+	}
 	// creating a context here which is managed by the forwarder's lifecycle
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -93,6 +100,7 @@ func NewDataForward(
 		vertexReplica: vertexInstance.Replica,
 		idleManager:   idleManager,
 		wmbChecker:    wmb.NewWMBChecker(2), // TODO: make configurable
+		retryPolicy:   rp,
 		Shutdown: Shutdown{
 			rwlock: new(sync.RWMutex),
 		},
@@ -176,7 +184,7 @@ func (df *DataForward) forwardAChunk(ctx context.Context) {
 	// There is a chance that we have read the message and the container got forcefully terminated before processing. To provide
 	// at-least-once semantics for reading, during restart we will have to reprocess all unacknowledged messages. It is the
 	// responsibility of the Read function to do that.
-	readMessages, err := df.fromBufferPartition.Read(ctx, df.opts.readBatchSize)
+	readMessages, err := ratelimit.ReadWithLimit(ctx, df.opts.rateLimiter, df.opts.readBatchSize, df.fromBufferPartition.Read)
 	df.opts.logger.Debugw("Read from buffer", zap.String("bufferFrom", df.fromBufferPartition.GetName()), zap.Int64("length", int64(len(readMessages))))
 	if err != nil {
 		df.opts.logger.Warnw("failed to read fromBufferPartition", zap.Error(err))
@@ -336,7 +344,9 @@ func (df *DataForward) ackFromBuffer(ctx context.Context, offsets []isb.Offset)
 	return ctxClosedErr
 }
 
-// writeToSink forwards an array of messages to a sink and it is a blocking call it keeps retrying until shutdown has been initiated.
+// writeToSink forwards an array of messages to a sink and it is a blocking call it keeps retrying until shutdown has been initiated,
+// or the retries are exhausted with the fallback or drop action of the retry strategy. The messages exhausted with the
+// fallback action are returned along with the messages asked to be written to the fallback sink.
 func (df *DataForward) writeToSink(ctx context.Context, sinkWriter sinker.SinkWriter, messages []isb.Message, isFbSinkWriter bool) ([]isb.Offset, []isb.Message, error) {
 	var (
 		err        error
@@ -346,7 +356,7 @@ func (df *DataForward) writeToSink(ctx context.Context, sinkWriter sinker.SinkWr
 	writeOffsets := make([]isb.Offset, 0, len(messages))
 	var fallbackMessages []isb.Message
 
-	for {
+	for attempts := 1; ; attempts++ {
 		_writeOffsets, errs := sinkWriter.Write(ctx, messages)
 		// Note: this is an unwanted memory allocation during a happy path. We want only minimal allocation since using failedMessages is an unlikely path.
 		var failedMessages []isb.Message
@@ -390,20 +400,26 @@ func (df *DataForward) writeToSink(ctx context.Context, sinkWriter sinker.SinkWr
 			}
 		}
 
-		if needRetry {
-			df.opts.logger.Errorw("Retrying failed messages",
-				zap.Any("errors", errorArrayToMap(errs)),
-				zap.String(metrics.LabelPipeline, df.pipelineName),
-				zap.String(metrics.LabelVertex, df.vertexName),
-				zap.String(metrics.LabelPartitionName, sinkWriter.GetName()),
-			)
-			// set messages to failed for the retry
-			messages = failedMessages
-			// TODO: implement retry with backoff etc.
-			time.Sleep(df.opts.retryInterval)
-		} else {
+		if !needRetry {
 			break
 		}
+		if df.retryPolicy.exhausted(attempts) && df.handleExhausted(sinkWriter, failedMessages, errs, isFbSinkWriter, &fallbackMessages) {
+			break
+		}
+		df.opts.logger.Errorw("Retrying failed messages",
+			zap.Any("errors", errorArrayToMap(errs)),
+			zap.String(metrics.LabelPipeline, df.pipelineName),
+			zap.String(metrics.LabelVertex, df.vertexName),
+			zap.String(metrics.LabelPartitionName, sinkWriter.GetName()),
+		)
+		metrics.SinkWriteRetries.With(map[string]string{metrics.LabelVertex: df.vertexName, metrics.LabelPipeline: df.pipelineName, metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)), metrics.LabelPartitionName: sinkWriter.GetName()}).Add(float64(len(failedMessages)))
+		// set messages to failed for the retry
+		messages = failedMessages
+		select {
+		case <-ctx.Done():
+			return nil, nil, fmt.Errorf("writeToSink failed, context cancelled while waiting to retry the failed messages:%d, %w", len(failedMessages), ctx.Err())
+		case <-time.After(df.retryPolicy.backoff(attempts)):
+		}
 	}
 
 	metrics.WriteMessagesCount.With(map[string]string{metrics.LabelVertex: df.vertexName, metrics.LabelPipeline: df.pipelineName, metrics.LabelVertexType: string(dfv1.VertexTypeSink), metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)), metrics.LabelPartitionName: sinkWriter.GetName()}).Add(float64(writeCount))
@@ -412,6 +428,42 @@ func (df *DataForward) writeToSink(ctx context.Context, sinkWriter sinker.SinkWr
 	return writeOffsets, fallbackMessages, nil
 }
 
+// handleExhausted takes the exhausted action of the retry strategy on the messages still failing after the max attempts,
+// they are either added to the fallback messages or dropped. It returns false if the messages need to be retried,
+// which is the case of the fallback action when the fallback sink is not available, or it's the fallback sink failing.
+func (df *DataForward) handleExhausted(sinkWriter sinker.SinkWriter, failedMessages []isb.Message, errs []error, isFbSinkWriter bool, fallbackMessages *[]isb.Message) bool {
+	action := df.retryPolicy.onExhausted
+	switch action {
+	case dfv1.SinkRetryExhaustedFallback:
+		if isFbSinkWriter || df.opts.fbSinkWriter == nil {
+			return false
+		}
+		df.opts.logger.Errorw("Retries exhausted, writing the failed messages to fallback sink",
+			zap.Int("count", len(failedMessages)),
+			zap.Any("errors", errorArrayToMap(errs)),
+			zap.String(metrics.LabelPartitionName, sinkWriter.GetName()),
+		)
+		*fallbackMessages = append(*fallbackMessages, failedMessages...)
+	case dfv1.SinkRetryExhaustedDrop:
+		df.opts.logger.Errorw("Retries exhausted, dropping the failed messages",
+			zap.Int("count", len(failedMessages)),
+			zap.Any("errors", errorArrayToMap(errs)),
+			zap.String(metrics.LabelPartitionName, sinkWriter.GetName()),
+		)
+		var dropBytes float64
+		for _, m := range failedMessages {
+			dropBytes += float64(len(m.Payload))
+		}
+		labels := map[string]string{metrics.LabelVertex: df.vertexName, metrics.LabelPipeline: df.pipelineName, metrics.LabelVertexType: string(dfv1.VertexTypeSink), metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)), metrics.LabelPartitionName: sinkWriter.GetName(), metrics.LabelReason: "retries exhausted"}
+		metrics.DropMessagesCount.With(labels).Add(float64(len(failedMessages)))
+		metrics.DropBytesCount.With(labels).Add(dropBytes)
+	default:
+		return false
+	}
+	metrics.SinkRetriesExhausted.With(map[string]string{metrics.LabelVertex: df.vertexName, metrics.LabelPipeline: df.pipelineName, metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)), metrics.LabelPartitionName: sinkWriter.GetName(), metrics.LabelAction: string(action)}).Add(float64(len(failedMessages)))
+	return true
+}
+
 // errorArrayToMap summarizes an error array to map
 func errorArrayToMap(errs []error) map[string]int64 {
 	result := make(map[string]int64)
@@ -24,6 +24,7 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/ratelimit"
 	"github.com/numaproj/numaflow/pkg/sinks/sinker"
 )
 
@@ -33,14 +34,18 @@ type options struct {
 	readBatchSize int64
 	// sinkConcurrency sets the concurrency for concurrent processing
 	sinkConcurrency int
-	// retryInterval is the time.Duration to sleep before retrying
+	// retryInterval is the time.Duration to sleep before retrying, it's used when the retry strategy is not specified
 	retryInterval time.Duration
+	// retryStrategy is the retry strategy of the sink, the messages are retried forever if it's nil.
+	retryStrategy *dfv1.SinkRetryStrategy
 	// fbSinkWriter is the writer for the fallback sink
 	fbSinkWriter sinker.SinkWriter
 	// logger is used to pass the logger variable
 	logger *zap.SugaredLogger
 	// cbPublisher is the callback publisher for the vertex.
 	cbPublisher *callback.Uploader
+	// rateLimiter limits the throughput of the vertex, it is nil if the vertex is not rate limited.
+	rateLimiter ratelimit.RateLimiter
 }
 
 type Option func(*options) error
@@ -78,6 +83,14 @@ func WithRetryInterval(f time.Duration) Option {
 	}
 }
 
+// WithRetryStrategy sets the retry strategy of the sink
+func WithRetryStrategy(rs *dfv1.SinkRetryStrategy) Option {
+	return func(o *options) error {
+		o.retryStrategy = rs
+		return nil
+	}
+}
+
 // WithLogger is used to return logger information
 func WithLogger(l *zap.SugaredLogger) Option {
 	return func(o *options) error {
@@ -101,3 +114,11 @@ func WithCallbackUploader(cp *callback.Uploader) Option {
 		return nil
 	}
 }
+
+// WithRateLimiter sets the rate limiter shared by the replicas of the vertex
+func WithRateLimiter(rl ratelimit.RateLimiter) Option {
+	return func(o *options) error {
+		o.rateLimiter = rl
+		return nil
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forward
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// retryPolicy decides the intervals between the attempts to write the messages to a sink, and the action taken on
+// the messages still failing after the max attempts.
+type retryPolicy struct {
+	maxAttempts int
+	interval    time.Duration
+	maxInterval time.Duration
+	factor      float64
+	// jitter is the fraction of an interval it's randomly increased by at most.
+	jitter      float64
+	onExhausted dfv1.SinkRetryExhaustedAction
+}
+
+// newRetryPolicy returns the policy of the retry strategy. Without a retry strategy, the messages are retried
+// forever with the given interval.
+func newRetryPolicy(rs *dfv1.SinkRetryStrategy, defaultInterval time.Duration) (*retryPolicy, error) {
+	if rs == nil {
+		return &retryPolicy{
+			interval:    defaultInterval,
+			maxInterval: defaultInterval,
+			factor:      1,
+			onExhausted: dfv1.SinkRetryExhaustedRetryForever,
+		}, nil
+	}
+	factor, err := rs.GetFactor()
+	if err != nil {
+		return nil, err
+	}
+	return &retryPolicy{
+		maxAttempts: rs.GetMaxAttempts(),
+		interval:    rs.GetInterval(),
+		maxInterval: rs.GetMaxInterval(),
+		factor:      factor,
+		jitter:      float64(rs.GetJitterPercentage()) / 100,
+		onExhausted: rs.GetOnExhausted(),
+	}, nil
+}
+
+// exhausted returns true if the messages which have failed the attempts are not retried anymore.
+func (p *retryPolicy) exhausted(attempts int) bool {
+	return p.onExhausted != dfv1.SinkRetryExhaustedRetryForever && attempts >= p.maxAttempts
+}
+
+// backoff returns the interval before the next attempt after the failed attempts, the interval grows by the factor
+// after each attempt up to the max interval, and is randomly increased by the jitter.
+func (p *retryPolicy) backoff(attempts int) time.Duration {
+	d := float64(p.interval) * math.Pow(p.factor, float64(attempts-1))
+	if d > float64(p.maxInterval) {
+		d = float64(p.maxInterval)
+	}
+	if p.jitter > 0 {
+		d += d * p.jitter * rand.Float64()
+	}
+	return time.Duration(d)
+}
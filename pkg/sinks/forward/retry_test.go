@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forward
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+// testSinkWriter fails all the messages of the first failures attempts.
+type testSinkWriter struct {
+	name     string
+	failures int
+	attempts int
+	written  []isb.Message
+}
+
+func (w *testSinkWriter) GetName() string {
+	return w.name
+}
+
+func (w *testSinkWriter) GetPartitionIdx() int32 {
+	return 0
+}
+
+func (w *testSinkWriter) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	w.attempts++
+	errs := make([]error, len(messages))
+	for i, m := range messages {
+		if w.attempts <= w.failures {
+			errs[i] = errors.New("sink unavailable")
+			continue
+		}
+		w.written = append(w.written, m)
+	}
+	return nil, errs
+}
+
+func (w *testSinkWriter) Close() error {
+	return nil
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		p, err := newRetryPolicy(nil, time.Millisecond)
+		assert.NoError(t, err)
+		assert.False(t, p.exhausted(100))
+		assert.Equal(t, time.Millisecond, p.backoff(1))
+		assert.Equal(t, time.Millisecond, p.backoff(10))
+	})
+
+	t.Run("exponential backoff", func(t *testing.T) {
+		p, err := newRetryPolicy(&dfv1.SinkRetryStrategy{
+			MaxAttempts: ptr.To[uint32](4),
+			Backoff:     &dfv1.SinkRetryBackoff{Interval: &metav1.Duration{Duration: time.Second}, MaxInterval: &metav1.Duration{Duration: 5 * time.Second}, Factor: "2"},
+			OnExhausted: dfv1.SinkRetryExhaustedDrop,
+		}, time.Millisecond)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Second, p.backoff(1))
+		assert.Equal(t, 2*time.Second, p.backoff(2))
+		assert.Equal(t, 4*time.Second, p.backoff(3))
+		assert.Equal(t, 5*time.Second, p.backoff(4))
+		assert.Equal(t, 5*time.Second, p.backoff(1000))
+		assert.False(t, p.exhausted(3))
+		assert.True(t, p.exhausted(4))
+	})
+
+	t.Run("jitter", func(t *testing.T) {
+		p, err := newRetryPolicy(&dfv1.SinkRetryStrategy{
+			Backoff: &dfv1.SinkRetryBackoff{Interval: &metav1.Duration{Duration: time.Second}, JitterPercentage: ptr.To[uint32](50)},
+		}, time.Millisecond)
+		assert.NoError(t, err)
+		for i := 0; i < 100; i++ {
+			d := p.backoff(1)
+			assert.GreaterOrEqual(t, d, time.Second)
+			assert.LessOrEqual(t, d, 1500*time.Millisecond)
+		}
+		// retryForever is the default action.
+		assert.False(t, p.exhausted(100))
+	})
+
+	t.Run("invalid factor", func(t *testing.T) {
+		_, err := newRetryPolicy(&dfv1.SinkRetryStrategy{Backoff: &dfv1.SinkRetryBackoff{Factor: "x"}}, time.Millisecond)
+		assert.Error(t, err)
+	})
+}
+
+func newTestRetryForward(t *testing.T, rs *dfv1.SinkRetryStrategy, sinkWriter, fbSinkWriter *testSinkWriter) *DataForward {
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   testPipelineName,
+			AbstractVertex: dfv1.AbstractVertex{Name: testVertexName},
+		}},
+	}
+	idleManager, _ := wmb.NewIdleManager(1, 1)
+	opts := []Option{WithRetryStrategy(rs)}
+	if fbSinkWriter != nil {
+		opts = append(opts, WithFbSinkWriter(fbSinkWriter))
+	}
+	f, err := NewDataForward(vertexInstance, simplebuffer.NewInMemoryBuffer("from", 10, 0), sinkWriter, &testForwardFetcher{}, &testForwarderPublisher{}, idleManager, opts...)
+	assert.NoError(t, err)
+	return f
+}
+
+func TestWriteToSink_RetryStrategy(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	messages := testutils.BuildTestWriteMessages(5, testStartTime, nil, "testVertex")
+	backoff := &dfv1.SinkRetryBackoff{Interval: &metav1.Duration{Duration: time.Millisecond}}
+
+	t.Run("drop", func(t *testing.T) {
+		sinkWriter := &testSinkWriter{name: "drop-sink", failures: 100}
+		f := newTestRetryForward(t, &dfv1.SinkRetryStrategy{MaxAttempts: ptr.To[uint32](3), Backoff: backoff, OnExhausted: dfv1.SinkRetryExhaustedDrop}, sinkWriter, nil)
+		_, fallbackMessages, err := f.writeToSink(ctx, sinkWriter, messages, false)
+		assert.NoError(t, err)
+		assert.Empty(t, fallbackMessages)
+		assert.Equal(t, 3, sinkWriter.attempts)
+		assert.Empty(t, sinkWriter.written)
+		assert.Equal(t, 10.0, testutil.ToFloat64(metrics.SinkWriteRetries.WithLabelValues(testVertexName, testPipelineName, "0", "drop-sink")))
+		assert.Equal(t, 5.0, testutil.ToFloat64(metrics.SinkRetriesExhausted.WithLabelValues(testVertexName, testPipelineName, "0", "drop-sink", "drop")))
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		sinkWriter := &testSinkWriter{name: "fallback-sink", failures: 100}
+		fbSinkWriter := &testSinkWriter{name: "fallback-fb-sink", failures: 4}
+		f := newTestRetryForward(t, &dfv1.SinkRetryStrategy{MaxAttempts: ptr.To[uint32](2), Backoff: backoff, OnExhausted: dfv1.SinkRetryExhaustedFallback}, sinkWriter, fbSinkWriter)
+		_, fallbackMessages, err := f.writeToSink(ctx, sinkWriter, messages, false)
+		assert.NoError(t, err)
+		assert.Len(t, fallbackMessages, 5)
+		assert.Equal(t, 2, sinkWriter.attempts)
+		assert.Equal(t, 5.0, testutil.ToFloat64(metrics.SinkRetriesExhausted.WithLabelValues(testVertexName, testPipelineName, "0", "fallback-sink", "fallback")))
+
+		// The messages failing in the fallback sink are retried until they are written.
+		_, _, err = f.writeToSink(ctx, fbSinkWriter, fallbackMessages, true)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, fbSinkWriter.attempts)
+		assert.Len(t, fbSinkWriter.written, 5)
+	})
+
+	t.Run("succeed before exhausted", func(t *testing.T) {
+		sinkWriter := &testSinkWriter{name: "retry-sink", failures: 2}
+		f := newTestRetryForward(t, &dfv1.SinkRetryStrategy{MaxAttempts: ptr.To[uint32](3), Backoff: backoff, OnExhausted: dfv1.SinkRetryExhaustedDrop}, sinkWriter, nil)
+		_, fallbackMessages, err := f.writeToSink(ctx, sinkWriter, messages, false)
+		assert.NoError(t, err)
+		assert.Empty(t, fallbackMessages)
+		assert.Len(t, sinkWriter.written, 5)
+	})
+
+	t.Run("cancelled while waiting", func(t *testing.T) {
+		sinkWriter := &testSinkWriter{name: "cancel-sink", failures: 100}
+		f := newTestRetryForward(t, &dfv1.SinkRetryStrategy{Backoff: &dfv1.SinkRetryBackoff{Interval: &metav1.Duration{Duration: time.Minute}}}, sinkWriter, nil)
+		cctx, ccancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer ccancel()
+		_, _, err := f.writeToSink(cctx, sinkWriter, messages, false)
+		assert.Error(t, err)
+		assert.Equal(t, 1, sinkWriter.attempts)
+	})
+}
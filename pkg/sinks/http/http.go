@@ -0,0 +1,321 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/sinks/udsink"
+)
+
+const (
+	// maxConcurrentRequests is the maximum number of the requests sent concurrently in a write.
+	maxConcurrentRequests = 16
+	// maxErrorBodySize is the maximum size of the response body included in the errors.
+	maxErrorBodySize = 512
+)
+
+// ToHTTP sends the messages to an HTTP endpoint.
+type ToHTTP struct {
+	name         string
+	pipelineName string
+	method       string
+	url          *expr.Template
+	headers      map[string]string
+	// batchSize is the maximum number of the messages in a request, 0 means the messages are not batched.
+	batchSize int
+	// authorization is the value of the Authorization header, empty means no authorization. It's reloaded with the
+	// loadAuthorization after a 401 response, as the mounted secret might have been rotated.
+	authorization     string
+	authMu            sync.RWMutex
+	loadAuthorization func() (string, error)
+	client            *http.Client
+	log               *zap.SugaredLogger
+}
+
+// request is a request to send, with the indexes of its messages.
+type request struct {
+	url     string
+	indexes []int
+}
+
+// NewToHTTP returns ToHTTP type, the sink spec is passed in as it could be the fallback sink.
+func NewToHTTP(ctx context.Context, vertexInstance *dfv1.VertexInstance, httpSink *dfv1.HTTPSink) (*ToHTTP, error) {
+	t, err := expr.ParseTemplate(httpSink.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url template, %w", err)
+	}
+	h := &ToHTTP{
+		name:         vertexInstance.Vertex.Spec.Name,
+		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
+		method:       httpSink.GetMethod(),
+		url:          t,
+		headers:      httpSink.Headers,
+		log:          logging.FromContext(ctx).With("sinkType", "http"),
+	}
+	if b := httpSink.Batch; b != nil {
+		h.batchSize = dfv1.DefaultReadBatchSize
+		if x := vertexInstance.Vertex.Spec.Limits; x != nil && x.ReadBatchSize != nil {
+			h.batchSize = int(*x.ReadBatchSize)
+		}
+		if b.MaxSize != nil && *b.MaxSize > 0 {
+			h.batchSize = int(*b.MaxSize)
+		}
+	}
+	h.loadAuthorization = func() (string, error) { return readAuthorization(httpSink.Auth) }
+	if h.authorization, err = h.loadAuthorization(); err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if httpSink.TLS != nil {
+		c, err := sharedutil.GetTLSConfig(httpSink.TLS)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = c
+	}
+	h.client = &http.Client{Transport: transport, Timeout: httpSink.GetTimeout()}
+	return h, nil
+}
+
+// readAuthorization returns the value of the Authorization header with the secrets mounted, empty if there's no auth.
+func readAuthorization(a *dfv1.HTTPSinkAuth) (string, error) {
+	switch {
+	case a == nil:
+		return "", nil
+	case a.Token != nil:
+		token, err := sharedutil.GetSecretFromVolume(a.Token)
+		if err != nil {
+			return "", fmt.Errorf("failed to get the bearer token, %w", err)
+		}
+		return "Bearer " + token, nil
+	case a.Basic != nil && a.Basic.User != nil:
+		user, err := sharedutil.GetSecretFromVolume(a.Basic.User)
+		if err != nil {
+			return "", fmt.Errorf("failed to get the basic auth user, %w", err)
+		}
+		var password string
+		if a.Basic.Password != nil {
+			if password, err = sharedutil.GetSecretFromVolume(a.Basic.Password); err != nil {
+				return "", fmt.Errorf("failed to get the basic auth password, %w", err)
+			}
+		}
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(user, password)
+		return req.Header.Get("Authorization"), nil
+	}
+	return "", nil
+}
+
+// getAuthorization returns the current value of the Authorization header.
+func (h *ToHTTP) getAuthorization() string {
+	h.authMu.RLock()
+	defer h.authMu.RUnlock()
+	return h.authorization
+}
+
+// reloadAuthorization reads the secrets again after a request with the authorization got a 401 response, it's only
+// reloaded once by the concurrent requests failed with the same authorization.
+func (h *ToHTTP) reloadAuthorization(rejected string) {
+	h.authMu.Lock()
+	defer h.authMu.Unlock()
+	if h.authorization != rejected {
+		return
+	}
+	authorization, err := h.loadAuthorization()
+	if err != nil {
+		h.log.Errorw("Failed to reload the authorization", zap.Error(err))
+		return
+	}
+	if authorization != h.authorization {
+		h.log.Info("Reloaded the authorization, the secret has been changed")
+		h.authorization = authorization
+	}
+}
+
+// GetName returns the name.
+func (h *ToHTTP) GetName() string {
+	return h.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (h *ToHTTP) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write sends the messages, the requests are sent concurrently. A message failed with a permanent failure,
+// i.e. a 4xx response other than 401, 403, 408, 425 and 429, or an invalid URL, gets an error wrapping
+// udsink.WriteToFallbackErr, so that it's written to the fallback sink, the other failures are retried.
+func (h *ToHTTP) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	requests := h.buildRequests(messages, errs)
+	sem := make(chan struct{}, maxConcurrentRequests)
+	var wg sync.WaitGroup
+	for _, r := range requests {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(r request) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			err := h.send(ctx, r, messages)
+			for _, i := range r.indexes {
+				errs[i] = err
+			}
+		}(r)
+	}
+	wg.Wait()
+	var sent, permanent, retryable int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			sent++
+		case isPermanent(err):
+			permanent++
+		default:
+			retryable++
+		}
+	}
+	httpSinkWriteCount.With(map[string]string{metrics.LabelVertex: h.name, metrics.LabelPipeline: h.pipelineName}).Add(float64(sent))
+	if permanent > 0 {
+		httpSinkWriteErrors.With(map[string]string{metrics.LabelVertex: h.name, metrics.LabelPipeline: h.pipelineName, metrics.LabelReason: "permanent"}).Add(float64(permanent))
+	}
+	if retryable > 0 {
+		httpSinkWriteErrors.With(map[string]string{metrics.LabelVertex: h.name, metrics.LabelPipeline: h.pipelineName, metrics.LabelReason: "retryable"}).Add(float64(retryable))
+	}
+	return nil, errs
+}
+
+// buildRequests groups the messages into the requests, the messages with the same URL are batched if the batch is
+// enabled, in the order of the messages. The messages whose URL can't be rendered get permanent errors.
+func (h *ToHTTP) buildRequests(messages []isb.Message, errs []error) []request {
+	var requests []request
+	// open is the index of the request being batched of each URL.
+	open := make(map[string]int)
+	for i, msg := range messages {
+		// The results of the expressions are path escaped.
+		u, err := h.url.Render(msg.Payload, msg.Keys, msg.Headers, url.PathEscape)
+		if err != nil {
+			errs[i] = fmt.Errorf("failed to render the url, %s, %w", err, &udsink.WriteToFallbackErr)
+			continue
+		}
+		if h.batchSize > 0 {
+			if idx, ok := open[u]; ok && len(requests[idx].indexes) < h.batchSize {
+				requests[idx].indexes = append(requests[idx].indexes, i)
+				continue
+			}
+			open[u] = len(requests)
+		}
+		requests = append(requests, request{url: u, indexes: []int{i}})
+	}
+	return requests
+}
+
+// body returns the body of the request, a batched request is a JSON array of the payloads, the payloads which are
+// not valid JSON are encoded as JSON strings.
+func (h *ToHTTP) body(r request, messages []isb.Message) ([]byte, error) {
+	if h.batchSize == 0 {
+		return messages[r.indexes[0]].Payload, nil
+	}
+	items := make([]json.RawMessage, 0, len(r.indexes))
+	for _, i := range r.indexes {
+		p := messages[i].Payload
+		if !json.Valid(p) {
+			var err error
+			if p, err = json.Marshal(string(p)); err != nil {
+				return nil, err
+			}
+		}
+		items = append(items, p)
+	}
+	return json.Marshal(items)
+}
+
+func (h *ToHTTP) send(ctx context.Context, r request, messages []isb.Message) error {
+	body, err := h.body(r, messages)
+	if err != nil {
+		return fmt.Errorf("failed to build the request body, %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, h.method, r.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create the request, %s, %w", err, &udsink.WriteToFallbackErr)
+	}
+	if h.batchSize > 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range h.headers {
+		req.Header.Set(k, v)
+	}
+	authorization := h.getAuthorization()
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send the request, %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode == http.StatusUnauthorized {
+		h.reloadAuthorization(authorization)
+	}
+	if isPermanentStatus(resp.StatusCode) {
+		return fmt.Errorf("request failed with status %d: %s, %w", resp.StatusCode, respBody, &udsink.WriteToFallbackErr)
+	}
+	return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, respBody)
+}
+
+// isPermanentStatus returns true if the request should not be retried with the status, which are the client errors,
+// except the ones indicating the request could succeed later, including the auth failures, which are fixed by
+// rotating the credentials.
+func isPermanentStatus(code int) bool {
+	switch code {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusRequestTimeout, http.StatusTooEarly, http.StatusTooManyRequests:
+		return false
+	}
+	return code >= 400 && code < 500
+}
+
+func isPermanent(err error) bool {
+	return errors.Is(err, &udsink.WriteToFallbackErr)
+}
+
+func (h *ToHTTP) Close() error {
+	h.client.CloseIdleConnections()
+	return nil
+}
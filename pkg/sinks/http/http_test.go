@@ -0,0 +1,260 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/sinks/udsink"
+)
+
+// receivedRequest is a request received by the test server.
+type receivedRequest struct {
+	path    string
+	method  string
+	headers http.Header
+	body    string
+}
+
+// testServer records the requests, and responds with the status returned by the statusFn.
+type testServer struct {
+	sync.Mutex
+	requests []receivedRequest
+	statusFn func(r receivedRequest) int
+}
+
+func (s *testServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	req := receivedRequest{path: r.URL.Path, method: r.Method, headers: r.Header, body: string(body)}
+	s.Lock()
+	s.requests = append(s.requests, req)
+	s.Unlock()
+	status := http.StatusOK
+	if s.statusFn != nil {
+		status = s.statusFn(req)
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte("response"))
+}
+
+func newTestVertexInstance(httpSink *dfv1.HTTPSink) *dfv1.VertexInstance {
+	return &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "test-pipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "test-sink",
+				Sink: &dfv1.Sink{AbstractSink: dfv1.AbstractSink{HTTP: httpSink}},
+			},
+		}},
+	}
+}
+
+func newTestMessages(payloads ...string) []isb.Message {
+	messages := make([]isb.Message, 0, len(payloads))
+	for i, p := range payloads {
+		messages = append(messages, isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: time.Unix(1636470000, 0)},
+				ID:          isb.MessageID{VertexName: "test", Offset: "0-0", Index: int32(i)},
+				Keys:        []string{"k"},
+				Headers:     map[string]string{"x-tenant": "t1"},
+			},
+			Body: isb.Body{Payload: []byte(p)},
+		})
+	}
+	return messages
+}
+
+func TestToHTTP_Write(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("a request per message", func(t *testing.T) {
+		s := &testServer{}
+		server := httptest.NewServer(s)
+		defer server.Close()
+		sink := &dfv1.HTTPSink{URL: server.URL + "/tenants/{{headers['x-tenant']}}/users/{{ json(payload).id }}", Method: http.MethodPut, Headers: map[string]string{"X-Source": "numaflow"}}
+		h, err := NewToHTTP(ctx, newTestVertexInstance(sink), sink)
+		require.NoError(t, err)
+		defer func() { _ = h.Close() }()
+
+		_, errs := h.Write(ctx, newTestMessages(`{"id":"a b"}`, `{"id":"c"}`))
+		assert.Equal(t, make([]error, 2), errs)
+		require.Len(t, s.requests, 2)
+		paths := map[string]string{}
+		for _, r := range s.requests {
+			assert.Equal(t, http.MethodPut, r.method)
+			assert.Equal(t, "numaflow", r.headers.Get("X-Source"))
+			paths[r.path] = r.body
+		}
+		assert.Equal(t, map[string]string{"/tenants/t1/users/a b": `{"id":"a b"}`, "/tenants/t1/users/c": `{"id":"c"}`}, paths)
+	})
+
+	t.Run("batched", func(t *testing.T) {
+		s := &testServer{}
+		server := httptest.NewServer(s)
+		defer server.Close()
+		maxSize := uint32(2)
+		sink := &dfv1.HTTPSink{URL: server.URL + "/{{json(payload).group}}", Batch: &dfv1.HTTPSinkBatch{MaxSize: &maxSize}}
+		h, err := NewToHTTP(ctx, newTestVertexInstance(sink), sink)
+		require.NoError(t, err)
+
+		_, errs := h.Write(ctx, newTestMessages(`{"group":"a","v":1}`, `{"group":"b","v":2}`, `{"group":"a","v":3}`, `{"group":"a","v":4}`))
+		assert.Equal(t, make([]error, 4), errs)
+		bodies := map[string][]string{}
+		for _, r := range s.requests {
+			assert.Equal(t, http.MethodPost, r.method)
+			assert.Equal(t, "application/json", r.headers.Get("Content-Type"))
+			bodies[r.path] = append(bodies[r.path], r.body)
+		}
+		assert.ElementsMatch(t, []string{`[{"group":"a","v":1},{"group":"a","v":3}]`, `[{"group":"a","v":4}]`}, bodies["/a"])
+		assert.Equal(t, []string{`[{"group":"b","v":2}]`}, bodies["/b"])
+	})
+
+	t.Run("non JSON payloads in a batch", func(t *testing.T) {
+		s := &testServer{}
+		server := httptest.NewServer(s)
+		defer server.Close()
+		sink := &dfv1.HTTPSink{URL: server.URL, Batch: &dfv1.HTTPSinkBatch{}}
+		h, err := NewToHTTP(ctx, newTestVertexInstance(sink), sink)
+		require.NoError(t, err)
+
+		_, errs := h.Write(ctx, newTestMessages(`{"v":1}`, "plain text"))
+		assert.Equal(t, make([]error, 2), errs)
+		require.Len(t, s.requests, 1)
+		var items []interface{}
+		require.NoError(t, json.Unmarshal([]byte(s.requests[0].body), &items))
+		assert.Equal(t, []interface{}{map[string]interface{}{"v": float64(1)}, "plain text"}, items)
+	})
+
+	t.Run("permanent and retryable failures", func(t *testing.T) {
+		s := &testServer{statusFn: func(r receivedRequest) int {
+			switch r.path {
+			case "/bad":
+				return http.StatusBadRequest
+			case "/throttled":
+				return http.StatusTooManyRequests
+			case "/down":
+				return http.StatusServiceUnavailable
+			}
+			return http.StatusNoContent
+		}}
+		server := httptest.NewServer(s)
+		defer server.Close()
+		sink := &dfv1.HTTPSink{URL: server.URL + "/{{json(payload).path}}"}
+		h, err := NewToHTTP(ctx, newTestVertexInstance(sink), sink)
+		require.NoError(t, err)
+
+		_, errs := h.Write(ctx, newTestMessages(`{"path":"ok"}`, `{"path":"bad"}`, `{"path":"throttled"}`, `{"path":"down"}`, "not json"))
+		require.Len(t, errs, 5)
+		assert.NoError(t, errs[0])
+		assert.True(t, errors.Is(errs[1], &udsink.WriteToFallbackErr))
+		assert.Contains(t, errs[1].Error(), "400")
+		assert.Contains(t, errs[1].Error(), "response")
+		assert.Error(t, errs[2])
+		assert.False(t, errors.Is(errs[2], &udsink.WriteToFallbackErr))
+		assert.Error(t, errs[3])
+		assert.False(t, errors.Is(errs[3], &udsink.WriteToFallbackErr))
+		// the url can't be rendered
+		assert.True(t, errors.Is(errs[4], &udsink.WriteToFallbackErr))
+		assert.Len(t, s.requests, 4)
+	})
+
+	t.Run("connection failure", func(t *testing.T) {
+		server := httptest.NewServer(&testServer{})
+		server.Close()
+		sink := &dfv1.HTTPSink{URL: server.URL}
+		h, err := NewToHTTP(ctx, newTestVertexInstance(sink), sink)
+		require.NoError(t, err)
+
+		_, errs := h.Write(ctx, newTestMessages(`{}`))
+		assert.Error(t, errs[0])
+		assert.False(t, errors.Is(errs[0], &udsink.WriteToFallbackErr))
+	})
+
+	t.Run("authorization", func(t *testing.T) {
+		s := &testServer{}
+		server := httptest.NewServer(s)
+		defer server.Close()
+		sink := &dfv1.HTTPSink{URL: server.URL}
+		h, err := NewToHTTP(ctx, newTestVertexInstance(sink), sink)
+		require.NoError(t, err)
+		h.authorization = "Bearer token"
+
+		_, errs := h.Write(ctx, newTestMessages(`{}`))
+		assert.NoError(t, errs[0])
+		assert.Equal(t, "Bearer token", s.requests[0].headers.Get("Authorization"))
+	})
+
+	t.Run("rotated credentials", func(t *testing.T) {
+		s := &testServer{statusFn: func(r receivedRequest) int {
+			switch r.headers.Get("Authorization") {
+			case "Bearer new":
+				return http.StatusOK
+			case "Bearer forbidden":
+				return http.StatusForbidden
+			}
+			return http.StatusUnauthorized
+		}}
+		server := httptest.NewServer(s)
+		defer server.Close()
+		sink := &dfv1.HTTPSink{URL: server.URL}
+		h, err := NewToHTTP(ctx, newTestVertexInstance(sink), sink)
+		require.NoError(t, err)
+		h.authorization = "Bearer old"
+		var loads int
+		h.loadAuthorization = func() (string, error) {
+			loads++
+			return "Bearer new", nil
+		}
+
+		// the auth failures are retried, and the secret is read again after a 401 response.
+		_, errs := h.Write(ctx, newTestMessages(`{}`, `{}`))
+		for _, err := range errs {
+			assert.Error(t, err)
+			assert.False(t, errors.Is(err, &udsink.WriteToFallbackErr))
+		}
+		assert.Equal(t, 1, loads)
+		_, errs = h.Write(ctx, newTestMessages(`{}`))
+		assert.NoError(t, errs[0])
+		assert.Equal(t, "Bearer new", s.requests[len(s.requests)-1].headers.Get("Authorization"))
+
+		h.authorization = "Bearer forbidden"
+		_, errs = h.Write(ctx, newTestMessages(`{}`))
+		assert.Error(t, errs[0])
+		assert.False(t, errors.Is(errs[0], &udsink.WriteToFallbackErr))
+		assert.Equal(t, 1, loads)
+	})
+
+	t.Run("invalid url template", func(t *testing.T) {
+		sink := &dfv1.HTTPSink{URL: "http://localhost/{{json(payload).id"}
+		_, err := NewToHTTP(ctx, newTestVertexInstance(sink), sink)
+		assert.Error(t, err)
+	})
+}
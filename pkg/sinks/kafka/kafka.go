@@ -19,6 +19,7 @@ package kafka
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/IBM/sarama"
@@ -27,8 +28,10 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/sinks/udsink"
 )
 
 // ToKafka produce the output to a kafka sinks.
@@ -38,8 +41,14 @@ type ToKafka struct {
 	producer     sarama.AsyncProducer
 	connected    bool
 	topic        string
-	kafkaSink    *dfv1.KafkaSink
-	log          *zap.SugaredLogger
+	// allowedTopics is the set of the topics that the topic expression is allowed to route to, empty means any.
+	allowedTopics map[string]struct{}
+	// transactionalID is the transactional ID of the producer, only set when the transaction is enabled.
+	transactionalID string
+	// checkpoint keeps the IDs of the most recently committed messages, only set when the transaction is enabled.
+	checkpoint *checkpoint
+	kafkaSink  *dfv1.KafkaSink
+	log        *zap.SugaredLogger
 }
 
 // NewToKafka returns ToKafka type.
@@ -52,19 +61,48 @@ func NewToKafka(ctx context.Context, vertexInstance *dfv1.VertexInstance) (*ToKa
 	toKafka.pipelineName = vertexInstance.Vertex.Spec.PipelineName
 	toKafka.topic = kafkaSink.Topic
 	toKafka.kafkaSink = kafkaSink
+	toKafka.allowedTopics = make(map[string]struct{}, len(kafkaSink.AllowedTopics))
+	for _, t := range kafkaSink.AllowedTopics {
+		toKafka.allowedTopics[t] = struct{}{}
+	}
+	toKafka.log = logging.FromContext(ctx).With("sinkType", "kafka").With("topic", kafkaSink.Topic)
+	if t := kafkaSink.Transaction; t != nil {
+		prefix := t.TransactionalIDPrefix
+		if prefix == "" {
+			prefix = fmt.Sprintf("%s-%s-%s", vertexInstance.Vertex.Namespace, toKafka.pipelineName, toKafka.name)
+		}
+		toKafka.transactionalID = fmt.Sprintf("%s-%d", prefix, vertexInstance.Replica)
+		toKafka.log = toKafka.log.With("transactionalID", toKafka.transactionalID)
+	}
 
-	producer, err := connect(kafkaSink)
+	producer, err := toKafka.connect()
 	if err != nil {
 		return nil, err
 	}
 	toKafka.producer = producer
 	toKafka.connected = true
 
-	toKafka.log = logging.FromContext(ctx).With("sinkType", "kafka").With("topic", kafkaSink.Topic)
+	if t := kafkaSink.Transaction; t != nil {
+		readBatchSize := uint64(dfv1.DefaultReadBatchSize)
+		if x := vertexInstance.Vertex.Spec.Limits; x != nil && x.ReadBatchSize != nil {
+			readBatchSize = *x.ReadBatchSize
+		}
+		// the checkpoint is loaded after the producer is created, which aborts the ongoing transaction of the
+		// previous producer with the same transactional ID, if any.
+		cp, err := toKafka.loadCheckpoint(t.CheckpointTopic, t.GetDeduplicationWindow(readBatchSize))
+		if err != nil {
+			_ = producer.Close()
+			return nil, fmt.Errorf("failed to load the checkpoint, %w", err)
+		}
+		toKafka.checkpoint = cp
+		toKafka.log.Infow("Loaded the checkpoint", zap.Int("committedIDs", len(cp.ids)))
+	}
 	return toKafka, nil
 }
 
-func connect(kafkaSink *dfv1.KafkaSink) (sarama.AsyncProducer, error) {
+// saramaConfig returns the sarama config of the kafka sink.
+func (tk *ToKafka) saramaConfig() (*sarama.Config, error) {
+	kafkaSink := tk.kafkaSink
 	config, err := util.GetSaramaConfigFromYAMLString(kafkaSink.Config)
 	if err != nil {
 		return nil, err
@@ -84,9 +122,37 @@ func connect(kafkaSink *dfv1.KafkaSink) (sarama.AsyncProducer, error) {
 			config.Net.SASL = *sasl
 		}
 	}
+	switch kafkaSink.GetPartitioner() {
+	case dfv1.KafkaPartitionerHash:
+		config.Producer.Partitioner = sarama.NewHashPartitioner
+	case dfv1.KafkaPartitionerCRC32:
+		config.Producer.Partitioner = sarama.NewConsistentCRCHashPartitioner
+	case dfv1.KafkaPartitionerRandom:
+		config.Producer.Partitioner = sarama.NewRandomPartitioner
+	case dfv1.KafkaPartitionerRoundRobin:
+		config.Producer.Partitioner = sarama.NewRoundRobinPartitioner
+	default:
+		return nil, fmt.Errorf("unsupported kafka partitioner %q", kafkaSink.Partitioner)
+	}
+	if t := kafkaSink.Transaction; t != nil {
+		config.Producer.Partitioner = withCheckpointPartitioner(t.CheckpointTopic, config.Producer.Partitioner)
+		config.Producer.Idempotent = true
+		config.Producer.RequiredAcks = sarama.WaitForAll
+		config.Producer.Transaction.ID = tk.transactionalID
+		config.Net.MaxOpenRequests = 1
+		config.Consumer.IsolationLevel = sarama.ReadCommitted
+	}
 	config.Producer.Return.Successes = true
 	config.Producer.Return.Errors = true
-	producer, err := sarama.NewAsyncProducer(kafkaSink.Brokers, config)
+	return config, nil
+}
+
+func (tk *ToKafka) connect() (sarama.AsyncProducer, error) {
+	config, err := tk.saramaConfig()
+	if err != nil {
+		return nil, err
+	}
+	producer, err := sarama.NewAsyncProducer(tk.kafkaSink.Brokers, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create kafka producer. %w", err)
 	}
@@ -111,7 +177,7 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 		errs[i] = fmt.Errorf("unknown error")
 	}
 	if !tk.connected {
-		producer, err := connect(tk.kafkaSink)
+		producer, err := tk.connect()
 		if err != nil {
 			for i := 0; i < len(errs); i++ {
 				errs[i] = fmt.Errorf("failed to get kafka producer, %w", err)
@@ -121,23 +187,59 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 		tk.producer = producer
 		tk.connected = true
 	}
+	// build the producer messages first, the ones failed to build are not sent.
+	producerMessages := make([]*sarama.ProducerMessage, 0, len(messages))
+	ids := make([]string, 0, len(messages))
+	for index, msg := range messages {
+		if tk.checkpoint != nil && tk.checkpoint.contains(msg.ID.String()) {
+			// the message has been committed before it was redelivered.
+			errs[index] = nil
+			kafkaSinkDuplicates.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
+			continue
+		}
+		message, err := tk.buildProducerMessage(index, msg)
+		if err != nil {
+			errs[index] = err
+			continue
+		}
+		producerMessages = append(producerMessages, message)
+		ids = append(ids, msg.ID.String())
+	}
+	if tk.checkpoint != nil {
+		tk.writeInTxn(producerMessages, ids, errs)
+	} else {
+		tk.produce(producerMessages, errs)
+	}
+	for _, err := range errs {
+		if err != nil {
+			kafkaSinkWriteErrors.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
+		} else {
+			kafkaSinkWriteCount.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
+		}
+	}
+	return nil, errs
+}
+
+// produce sends the producer messages and waits for the results, the result of each message is set to
+// the results at the index of its metadata.
+func (tk *ToKafka) produce(producerMessages []*sarama.ProducerMessage, results []error) {
 	done := make(chan struct{})
 	timeout := time.After(5 * time.Second)
 	go func() {
 		sent := 0
 		for {
-			if sent == len(messages) {
+			if sent == len(producerMessages) {
 				close(done)
 				return
 			}
 			select {
 			case err := <-tk.producer.Errors():
 				idx := err.Msg.Metadata.(int)
-				errs[idx] = err.Err
+				results[idx] = err.Err
 				sent++
 			case m := <-tk.producer.Successes():
 				idx := m.Metadata.(int)
-				errs[idx] = nil
+				results[idx] = nil
 				sent++
 			case <-timeout:
 				// Need to close and recreate later because the successes and errors channels might be unclean
@@ -150,23 +252,61 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 			}
 		}
 	}()
-	for index, msg := range messages {
-		message := &sarama.ProducerMessage{
-			Topic:    tk.topic,
-			Value:    sarama.ByteEncoder(msg.Payload),
-			Metadata: index, // Use metadata to identify if it succeeds or fails in the async return.
-		}
+	for _, message := range producerMessages {
 		tk.producer.Input() <- message
 	}
 	<-done
-	for _, err := range errs {
-		if err != nil {
-			kafkaSinkWriteErrors.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
-		} else {
-			kafkaSinkWriteCount.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
+}
+
+// buildProducerMessage builds the Kafka record from the message, with the key and headers if configured.
+func (tk *ToKafka) buildProducerMessage(index int, msg isb.Message) (*sarama.ProducerMessage, error) {
+	message := &sarama.ProducerMessage{
+		Topic:    tk.topic,
+		Value:    sarama.ByteEncoder(msg.Payload),
+		Metadata: index, // Use metadata to identify if it succeeds or fails in the async return.
+	}
+	if tk.kafkaSink == nil {
+		return message, nil
+	}
+	if tk.kafkaSink.TopicExpression != "" {
+		message.Topic = tk.routeTopic(msg)
+	}
+	if k := tk.kafkaSink.Key; k != nil {
+		if k.Expression != "" {
+			key, err := expr.EvalStrWithMetadata(k.Expression, msg.Payload, msg.Keys, msg.Headers)
+			if err != nil {
+				// the same message always fails to evaluate, there's no point to retry.
+				return nil, fmt.Errorf("failed to evaluate the key expression, %s, %w", err, &udsink.WriteToFallbackErr)
+			}
+			message.Key = sarama.StringEncoder(key)
+		} else if len(msg.Keys) > 0 {
+			message.Key = sarama.StringEncoder(strings.Join(msg.Keys, k.GetSeparator()))
 		}
 	}
-	return nil, errs
+	if tk.kafkaSink.ForwardHeaders && len(msg.Headers) > 0 {
+		message.Headers = make([]sarama.RecordHeader, 0, len(msg.Headers))
+		for k, v := range msg.Headers {
+			message.Headers = append(message.Headers, sarama.RecordHeader{Key: []byte(k), Value: []byte(v)})
+		}
+	}
+	return message, nil
+}
+
+// routeTopic evaluates the topic expression against the message, and returns the topic to write to.
+// The default topic is returned if the expression fails to evaluate, or the result is empty or not allowed.
+func (tk *ToKafka) routeTopic(msg isb.Message) string {
+	topic, err := expr.EvalStrWithMetadata(tk.kafkaSink.TopicExpression, msg.Payload, msg.Keys, msg.Headers)
+	if err != nil {
+		tk.log.Debugw("Failed to evaluate the topic expression, writing to the default topic", zap.Error(err))
+	} else if topic == "" {
+		tk.log.Debug("Topic expression returned an empty topic, writing to the default topic")
+	} else if _, ok := tk.allowedTopics[topic]; len(tk.allowedTopics) > 0 && !ok {
+		tk.log.Debugw("Topic is not allowed, writing to the default topic", zap.String("routedTopic", topic))
+	} else {
+		return topic
+	}
+	kafkaSinkDefaultTopicWrites.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
+	return tk.topic
 }
 
 func (tk *ToKafka) Close() error {
@@ -18,15 +18,18 @@ package kafka
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/IBM/sarama"
 	mock "github.com/IBM/sarama/mocks"
 	"github.com/stretchr/testify/assert"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sinks/udsink"
 )
 
 func TestWriteSuccessToKafka(t *testing.T) {
@@ -117,3 +120,127 @@ func TestWriteFailureToKafka(t *testing.T) {
 	assert.Equal(t, "test1", errs[1].Error())
 
 }
+
+func TestBuildProducerMessage(t *testing.T) {
+	msg := isb.Message{
+		Header: isb.Header{
+			Keys:    []string{"k1", "k2"},
+			Headers: map[string]string{"x-id": "abc"},
+		},
+		Body: isb.Body{Payload: []byte(`{"id": "123"}`)},
+	}
+	toKafka := &ToKafka{topic: "topic-1", log: logging.NewLogger()}
+
+	t.Run("no key", func(t *testing.T) {
+		toKafka.kafkaSink = &dfv1.KafkaSink{}
+		m, err := toKafka.buildProducerMessage(3, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, "topic-1", m.Topic)
+		assert.Equal(t, 3, m.Metadata)
+		assert.Nil(t, m.Key)
+		assert.Nil(t, m.Headers)
+	})
+
+	t.Run("joined keys", func(t *testing.T) {
+		toKafka.kafkaSink = &dfv1.KafkaSink{Key: &dfv1.KafkaSinkKey{}}
+		m, err := toKafka.buildProducerMessage(0, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, sarama.StringEncoder("k1:k2"), m.Key)
+		sep := "-"
+		toKafka.kafkaSink.Key.Separator = &sep
+		m, err = toKafka.buildProducerMessage(0, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, sarama.StringEncoder("k1-k2"), m.Key)
+	})
+
+	t.Run("key expression", func(t *testing.T) {
+		toKafka.kafkaSink = &dfv1.KafkaSink{Key: &dfv1.KafkaSinkKey{Expression: "json(payload).id"}}
+		m, err := toKafka.buildProducerMessage(0, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, sarama.StringEncoder("123"), m.Key)
+		toKafka.kafkaSink.Key.Expression = "headers['x-id'] + keys[1]"
+		m, err = toKafka.buildProducerMessage(0, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, sarama.StringEncoder("abck2"), m.Key)
+		toKafka.kafkaSink.Key.Expression = "json(payload).id +"
+		_, err = toKafka.buildProducerMessage(0, msg)
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, &udsink.WriteToFallbackErr))
+	})
+
+	t.Run("forward headers", func(t *testing.T) {
+		toKafka.kafkaSink = &dfv1.KafkaSink{ForwardHeaders: true}
+		m, err := toKafka.buildProducerMessage(0, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, []sarama.RecordHeader{{Key: []byte("x-id"), Value: []byte("abc")}}, m.Headers)
+	})
+}
+
+func TestWriteWithKeyExpressionFailure(t *testing.T) {
+	toKafka := &ToKafka{
+		name:      "Test",
+		topic:     "topic-1",
+		log:       logging.NewLogger(),
+		kafkaSink: &dfv1.KafkaSink{Key: &dfv1.KafkaSinkKey{Expression: "json(payload).id"}},
+	}
+	conf := mock.NewTestConfig()
+	conf.Producer.Return.Successes = true
+	conf.Producer.Return.Errors = true
+	producer := mock.NewAsyncProducer(t, conf)
+	producer.ExpectInputWithMessageCheckerFunctionAndSucceed(func(m *sarama.ProducerMessage) error {
+		key, _ := m.Key.Encode()
+		if string(key) != "123" {
+			return fmt.Errorf("unexpected key %q", string(key))
+		}
+		return nil
+	})
+	toKafka.producer = producer
+	toKafka.connected = true
+	msgs := []isb.Message{
+		{Body: isb.Body{Payload: []byte(`{"id": "123"}`)}},
+		{Body: isb.Body{Payload: []byte("not a json")}},
+	}
+	_, errs := toKafka.Write(context.Background(), msgs)
+	assert.NoError(t, errs[0])
+	assert.Error(t, errs[1])
+}
+
+func TestRouteTopic(t *testing.T) {
+	toKafka := &ToKafka{
+		topic: "default",
+		log:   logging.NewLogger(),
+		kafkaSink: &dfv1.KafkaSink{
+			Topic:           "default",
+			TopicExpression: "'tenant-' + json(payload).tenant",
+		},
+		allowedTopics: map[string]struct{}{},
+	}
+	newMsg := func(payload string) isb.Message {
+		return isb.Message{Body: isb.Body{Payload: []byte(payload)}}
+	}
+
+	t.Run("any topic allowed", func(t *testing.T) {
+		m, err := toKafka.buildProducerMessage(0, newMsg(`{"tenant": "a"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "tenant-a", m.Topic)
+	})
+
+	t.Run("failed to evaluate", func(t *testing.T) {
+		assert.Equal(t, "default", toKafka.routeTopic(newMsg("not a json")))
+	})
+
+	t.Run("not allowed", func(t *testing.T) {
+		toKafka.allowedTopics = map[string]struct{}{"tenant-a": {}}
+		defer func() { toKafka.allowedTopics = map[string]struct{}{} }()
+		assert.Equal(t, "tenant-a", toKafka.routeTopic(newMsg(`{"tenant": "a"}`)))
+		assert.Equal(t, "default", toKafka.routeTopic(newMsg(`{"tenant": "b"}`)))
+	})
+
+	t.Run("empty topic", func(t *testing.T) {
+		toKafka.kafkaSink.TopicExpression = "headers['x-topic']"
+		assert.Equal(t, "default", toKafka.routeTopic(newMsg(`{}`)))
+		msg := newMsg(`{}`)
+		msg.Headers = map[string]string{"x-topic": "from-header"}
+		assert.Equal(t, "from-header", toKafka.routeTopic(msg))
+	})
+}
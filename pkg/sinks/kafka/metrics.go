@@ -42,3 +42,25 @@ var kafkaSinkWriteTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
 	Name:      "write_timeout_total",
 	Help:      "Total number of write timeouts on NewToKafka",
 }, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// kafkaSinkDefaultTopicWrites is used to indicate the number of messages which can not be routed by the topic expression,
+// and are written to the default topic.
+var kafkaSinkDefaultTopicWrites = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "kafka_sink",
+	Name:      "default_topic_total",
+	Help:      "Total number of messages written to the default topic because the topic expression could not route them",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// kafkaSinkDuplicates is used to indicate the number of redelivered messages which are skipped because they have been committed
+var kafkaSinkDuplicates = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "kafka_sink",
+	Name:      "duplicate_total",
+	Help:      "Total number of redelivered messages skipped by the transactional kafka sink",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// kafkaSinkTxnAborts is used to indicate the number of aborted transactions
+var kafkaSinkTxnAborts = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "kafka_sink",
+	Name:      "transaction_abort_total",
+	Help:      "Total number of aborted transactions of the transactional kafka sink",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
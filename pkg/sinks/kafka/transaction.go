@@ -0,0 +1,238 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// checkpointLoadIdleTimeout is how long to wait for more records when loading the checkpoint, before the record at the
+// high-water mark is received. The tail of a partition written by the transactional producers is a control record, which
+// is not returned to the consumer, so the loading ends either when a record beyond the control record is received, e.g.
+// written by the other replicas, or when there's no more record in the timeout.
+var checkpointLoadIdleTimeout = 3 * time.Second
+
+// checkpoint keeps the IDs of the most recently committed messages in the committed order.
+type checkpoint struct {
+	size int
+	ids  []string
+	set  map[string]struct{}
+}
+
+func newCheckpoint(size int, ids []string) *checkpoint {
+	if len(ids) > size {
+		ids = ids[len(ids)-size:]
+	}
+	c := &checkpoint{size: size, ids: ids, set: make(map[string]struct{}, len(ids))}
+	for _, id := range ids {
+		c.set[id] = struct{}{}
+	}
+	return c
+}
+
+// contains returns true if the message ID has been committed.
+func (c *checkpoint) contains(id string) bool {
+	_, ok := c.set[id]
+	return ok
+}
+
+// extend returns a new checkpoint with the given IDs appended, the oldest IDs are evicted if it exceeds the size.
+// The checkpoint itself is not changed, because the transaction might be aborted.
+func (c *checkpoint) extend(ids []string) *checkpoint {
+	newIDs := make([]string, 0, len(c.ids)+len(ids))
+	newIDs = append(newIDs, c.ids...)
+	for _, id := range ids {
+		if !c.contains(id) {
+			newIDs = append(newIDs, id)
+		}
+	}
+	return newCheckpoint(c.size, newIDs)
+}
+
+// checkpointRecord is the value of the records in the checkpoint topic.
+type checkpointRecord struct {
+	IDs []string `json:"ids"`
+}
+
+// withCheckpointPartitioner always uses the hash partitioner for the checkpoint topic, so that the partition of the
+// checkpoint records can be located by the transactional ID when loading the checkpoint.
+func withCheckpointPartitioner(checkpointTopic string, pc sarama.PartitionerConstructor) sarama.PartitionerConstructor {
+	return func(topic string) sarama.Partitioner {
+		if topic == checkpointTopic {
+			return sarama.NewHashPartitioner(topic)
+		}
+		return pc(topic)
+	}
+}
+
+// loadCheckpoint reads the latest committed checkpoint record of the transactional ID from the checkpoint topic.
+func (tk *ToKafka) loadCheckpoint(topic string, size int) (*checkpoint, error) {
+	config, err := tk.saramaConfig()
+	if err != nil {
+		return nil, err
+	}
+	client, err := sarama.NewClient(tk.kafkaSink.Brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka client, %w", err)
+	}
+	defer func() { _ = client.Close() }()
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka consumer, %w", err)
+	}
+	defer func() { _ = consumer.Close() }()
+	return readCheckpoint(consumer, client, topic, tk.transactionalID, size)
+}
+
+// offsetGetter gets the offsets of the partitions, it is implemented by sarama.Client.
+type offsetGetter interface {
+	GetOffset(topic string, partitionID int32, time int64) (int64, error)
+}
+
+// readCheckpoint reads the partition of the checkpoint topic which the key belongs to, up to the high-water mark at
+// the time it starts, and returns the checkpoint of the last record with the key. The records written after that are
+// not needed, because the records with the key are only written by the caller itself.
+func readCheckpoint(consumer sarama.Consumer, offsets offsetGetter, topic, key string, size int) (*checkpoint, error) {
+	partitions, err := consumer.Partitions(topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the partitions of topic %q, %w", topic, err)
+	}
+	if len(partitions) == 0 {
+		return nil, fmt.Errorf("topic %q has no partitions", topic)
+	}
+	choice, err := sarama.NewHashPartitioner(topic).Partition(&sarama.ProducerMessage{Key: sarama.StringEncoder(key)}, int32(len(partitions)))
+	if err != nil {
+		return nil, err
+	}
+	partition := partitions[choice]
+	oldest, err := offsets.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the oldest offset of the partition %d of topic %q, %w", partition, topic, err)
+	}
+	hwm, err := offsets.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the high-water mark of the partition %d of topic %q, %w", partition, topic, err)
+	}
+	if hwm <= oldest {
+		// the partition is empty.
+		return newCheckpoint(size, nil), nil
+	}
+	pc, err := consumer.ConsumePartition(topic, partition, oldest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume the partition %d of topic %q, %w", partition, topic, err)
+	}
+	defer func() { _ = pc.Close() }()
+	idle := time.NewTimer(checkpointLoadIdleTimeout)
+	defer idle.Stop()
+	var record checkpointRecord
+	for {
+		select {
+		case msg, ok := <-pc.Messages():
+			if !ok {
+				return newCheckpoint(size, record.IDs), nil
+			}
+			if msg.Offset >= hwm {
+				return newCheckpoint(size, record.IDs), nil
+			}
+			if string(msg.Key) == key {
+				if err := json.Unmarshal(msg.Value, &record); err != nil {
+					return nil, fmt.Errorf("failed to unmarshal the checkpoint record at offset %d, %w", msg.Offset, err)
+				}
+			}
+			if msg.Offset >= hwm-1 {
+				return newCheckpoint(size, record.IDs), nil
+			}
+			if !idle.Stop() {
+				<-idle.C
+			}
+			idle.Reset(checkpointLoadIdleTimeout)
+		case err := <-pc.Errors():
+			return nil, err
+		case <-idle.C:
+			return newCheckpoint(size, record.IDs), nil
+		}
+	}
+}
+
+// writeInTxn writes the producer messages and the checkpoint in a transaction, the errors of the messages
+// are set to nil only if the transaction is committed.
+func (tk *ToKafka) writeInTxn(producerMessages []*sarama.ProducerMessage, ids []string, errs []error) {
+	if len(producerMessages) == 0 {
+		return
+	}
+	setErrs := func(err error) {
+		for _, m := range producerMessages {
+			errs[m.Metadata.(int)] = err
+		}
+	}
+	next := tk.checkpoint.extend(ids)
+	value, err := json.Marshal(checkpointRecord{IDs: next.ids})
+	if err != nil {
+		setErrs(fmt.Errorf("failed to marshal the checkpoint, %w", err))
+		return
+	}
+	if err := tk.producer.BeginTxn(); err != nil {
+		setErrs(fmt.Errorf("failed to begin the transaction, %w", err))
+		return
+	}
+	// the result of the checkpoint record is put at the end of the results.
+	results := make([]error, len(errs)+1)
+	for i := range results {
+		results[i] = fmt.Errorf("unknown error")
+	}
+	messages := make([]*sarama.ProducerMessage, 0, len(producerMessages)+1)
+	messages = append(messages, producerMessages...)
+	messages = append(messages, &sarama.ProducerMessage{
+		Topic:    tk.kafkaSink.Transaction.CheckpointTopic,
+		Key:      sarama.StringEncoder(tk.transactionalID),
+		Value:    sarama.ByteEncoder(value),
+		Metadata: len(errs),
+	})
+	tk.produce(messages, results)
+	var txnErr error
+	for _, m := range messages {
+		if err := results[m.Metadata.(int)]; err != nil {
+			txnErr = err
+			break
+		}
+	}
+	if txnErr == nil {
+		txnErr = tk.producer.CommitTxn()
+	}
+	if txnErr != nil {
+		kafkaSinkTxnAborts.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
+		// the producer is closed if it timed out, and the ongoing transaction will be aborted by the next producer.
+		if tk.connected {
+			if err := tk.producer.AbortTxn(); err != nil {
+				tk.log.Errorw("Failed to abort the transaction, recreating the producer", zap.Error(err))
+				_ = tk.producer.Close()
+				tk.connected = false
+			}
+		}
+		setErrs(fmt.Errorf("transaction aborted, %w", txnErr))
+		return
+	}
+	tk.checkpoint = next
+	setErrs(nil)
+}
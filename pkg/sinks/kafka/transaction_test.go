@@ -0,0 +1,208 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	mock "github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+func TestCheckpoint(t *testing.T) {
+	c := newCheckpoint(3, []string{"a", "b"})
+	assert.True(t, c.contains("a"))
+	assert.False(t, c.contains("c"))
+
+	next := c.extend([]string{"b", "c", "d"})
+	// the original checkpoint is not changed.
+	assert.Equal(t, []string{"a", "b"}, c.ids)
+	assert.Equal(t, []string{"b", "c", "d"}, next.ids)
+	assert.False(t, next.contains("a"))
+	assert.True(t, next.contains("d"))
+}
+
+// fakeOffsets returns the oldest offset and the high-water mark of any partition.
+type fakeOffsets struct {
+	oldest, newest int64
+}
+
+func (f fakeOffsets) GetOffset(_ string, _ int32, time int64) (int64, error) {
+	if time == sarama.OffsetOldest {
+		return f.oldest, nil
+	}
+	return f.newest, nil
+}
+
+func TestReadCheckpoint(t *testing.T) {
+	origTimeout := checkpointLoadIdleTimeout
+	checkpointLoadIdleTimeout = 100 * time.Millisecond
+	defer func() { checkpointLoadIdleTimeout = origTimeout }()
+
+	record := func(ids ...string) []byte {
+		b, _ := json.Marshal(checkpointRecord{IDs: ids})
+		return b
+	}
+
+	t.Run("read up to the high-water mark", func(t *testing.T) {
+		consumer := mock.NewConsumer(t, mock.NewTestConfig())
+		consumer.SetTopicMetadata(map[string][]int32{"checkpoint": {0}})
+		consumer.ExpectConsumePartition("checkpoint", 0, 0).
+			YieldMessage(&sarama.ConsumerMessage{Key: []byte("txn-0"), Value: record("a", "b")}).
+			YieldMessage(&sarama.ConsumerMessage{Key: []byte("txn-1"), Value: record("x")}).
+			YieldMessage(&sarama.ConsumerMessage{Key: []byte("txn-0"), Value: record("b", "c")}).
+			// written after the high-water mark.
+			YieldMessage(&sarama.ConsumerMessage{Key: []byte("txn-0"), Value: record("d")})
+
+		start := time.Now()
+		c, err := readCheckpoint(consumer, fakeOffsets{oldest: 0, newest: 3}, "checkpoint", "txn-0", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"b", "c"}, c.ids)
+		assert.Less(t, time.Since(start), checkpointLoadIdleTimeout)
+		assert.NoError(t, consumer.Close())
+	})
+
+	t.Run("records written by others after a control record", func(t *testing.T) {
+		consumer := mock.NewConsumer(t, mock.NewTestConfig())
+		consumer.SetTopicMetadata(map[string][]int32{"checkpoint": {0}})
+		// the offset 2 is a control record, which is not returned to the consumer.
+		consumer.ExpectConsumePartition("checkpoint", 0, 0).
+			YieldMessage(&sarama.ConsumerMessage{Key: []byte("txn-0"), Value: record("a")}).
+			YieldMessage(&sarama.ConsumerMessage{Key: []byte("txn-1"), Value: record("x")}).
+			YieldMessage(&sarama.ConsumerMessage{Key: []byte("txn-1"), Value: record("y")})
+
+		start := time.Now()
+		c, err := readCheckpoint(consumer, fakeOffsets{oldest: 0, newest: 3}, "checkpoint", "txn-0", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a"}, c.ids)
+		assert.Less(t, time.Since(start), checkpointLoadIdleTimeout)
+		assert.NoError(t, consumer.Close())
+	})
+
+	t.Run("control record at the tail", func(t *testing.T) {
+		consumer := mock.NewConsumer(t, mock.NewTestConfig())
+		consumer.SetTopicMetadata(map[string][]int32{"checkpoint": {0}})
+		consumer.ExpectConsumePartition("checkpoint", 0, 0).
+			YieldMessage(&sarama.ConsumerMessage{Key: []byte("txn-0"), Value: record("a")})
+
+		c, err := readCheckpoint(consumer, fakeOffsets{oldest: 0, newest: 2}, "checkpoint", "txn-0", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a"}, c.ids)
+		assert.NoError(t, consumer.Close())
+	})
+
+	t.Run("empty partition", func(t *testing.T) {
+		consumer := mock.NewConsumer(t, mock.NewTestConfig())
+		consumer.SetTopicMetadata(map[string][]int32{"checkpoint": {0}})
+		c, err := readCheckpoint(consumer, fakeOffsets{oldest: 5, newest: 5}, "checkpoint", "txn-0", 10)
+		assert.NoError(t, err)
+		assert.Empty(t, c.ids)
+		assert.NoError(t, consumer.Close())
+	})
+}
+
+func newTestTxnKafka(t *testing.T, cp *checkpoint) (*ToKafka, *mock.AsyncProducer) {
+	conf := mock.NewTestConfig()
+	conf.Version = sarama.V2_1_0_0
+	conf.Producer.Return.Successes = true
+	conf.Producer.Return.Errors = true
+	conf.Producer.Idempotent = true
+	conf.Producer.RequiredAcks = sarama.WaitForAll
+	conf.Producer.Transaction.ID = "txn-0"
+	conf.Net.MaxOpenRequests = 1
+	producer := mock.NewAsyncProducer(t, conf)
+	return &ToKafka{
+		name:            "Test",
+		topic:           "topic-1",
+		log:             logging.NewLogger(),
+		producer:        producer,
+		connected:       true,
+		transactionalID: "txn-0",
+		checkpoint:      cp,
+		kafkaSink: &dfv1.KafkaSink{
+			Topic:       "topic-1",
+			Transaction: &dfv1.KafkaSinkTransaction{CheckpointTopic: "checkpoint"},
+		},
+	}, producer
+}
+
+func newTestMessage(offset string) isb.Message {
+	return isb.Message{
+		Header: isb.Header{ID: isb.MessageID{VertexName: "in", Offset: offset}},
+		Body:   isb.Body{Payload: []byte("welcome")},
+	}
+}
+
+func TestWriteInTxn(t *testing.T) {
+	toKafka, producer := newTestTxnKafka(t, newCheckpoint(10, []string{"in-1-0"}))
+	producer.ExpectInputAndSucceed()
+	producer.ExpectInputWithMessageCheckerFunctionAndSucceed(func(m *sarama.ProducerMessage) error {
+		if m.Topic != "checkpoint" {
+			return fmt.Errorf("unexpected topic %q", m.Topic)
+		}
+		key, _ := m.Key.Encode()
+		if string(key) != "txn-0" {
+			return fmt.Errorf("unexpected key %q", string(key))
+		}
+		value, _ := m.Value.Encode()
+		var record checkpointRecord
+		if err := json.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		if len(record.IDs) != 2 || record.IDs[1] != "in-2-0" {
+			return fmt.Errorf("unexpected checkpoint %v", record.IDs)
+		}
+		return nil
+	})
+
+	// the first message has been committed, only the second one is written.
+	_, errs := toKafka.Write(context.Background(), []isb.Message{newTestMessage("1"), newTestMessage("2")})
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.True(t, toKafka.checkpoint.contains("in-2-0"))
+	assert.Equal(t, sarama.ProducerTxnFlagReady, producer.TxnStatus())
+
+	// all the messages have been committed, nothing is written.
+	_, errs = toKafka.Write(context.Background(), []isb.Message{newTestMessage("1"), newTestMessage("2")})
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.NoError(t, producer.Close())
+}
+
+func TestWriteInTxnAborted(t *testing.T) {
+	toKafka, producer := newTestTxnKafka(t, newCheckpoint(10, nil))
+	producer.ExpectInputAndSucceed()
+	producer.ExpectInputAndFail(fmt.Errorf("test"))
+	producer.ExpectInputAndSucceed()
+
+	_, errs := toKafka.Write(context.Background(), []isb.Message{newTestMessage("1"), newTestMessage("2")})
+	// the message written successfully is also failed, because the transaction is aborted.
+	assert.EqualError(t, errs[0], "transaction aborted, test")
+	assert.EqualError(t, errs[1], "transaction aborted, test")
+	assert.False(t, toKafka.checkpoint.contains("in-1-0"))
+	assert.Equal(t, sarama.ProducerTxnFlagReady, producer.TxnStatus())
+	assert.NoError(t, producer.Close())
+}
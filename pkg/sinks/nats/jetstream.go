@@ -0,0 +1,170 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	natslib "github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sinks/udsink"
+)
+
+// The error codes of the publish failures which can't succeed by retrying.
+const (
+	jsErrCodeMessageExceedsMaximum natslib.ErrorCode = 10054
+	jsErrCodeStreamNotMatch        natslib.ErrorCode = 10060
+)
+
+// ToJetStream publishes the messages to NATS JetStream. A write returns after the messages are acknowledged by the
+// stream. The message ID is set as the "Nats-Msg-Id" header, so that the messages published again after a failure
+// are deduplicated by the stream within its duplicate window.
+type ToJetStream struct {
+	name         string
+	pipelineName string
+	subject      *expr.Template
+	stream       string
+	ackTimeout   time.Duration
+	client       *natsclient.Client
+	js           natslib.JetStreamContext
+	log          *zap.SugaredLogger
+}
+
+// NewToJetStream returns ToJetStream type, the sink spec is passed in as it could be the fallback sink.
+func NewToJetStream(ctx context.Context, vertexInstance *dfv1.VertexInstance, jsSink *dfv1.JetStreamSink) (*ToJetStream, error) {
+	subject, err := expr.ParseTemplate(jsSink.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subject template, %w", err)
+	}
+	opts, err := natsclient.ConnectionOptions(jsSink.TLS, jsSink.Auth)
+	if err != nil {
+		return nil, err
+	}
+	log := logging.FromContext(ctx).With("sinkType", "jetstream")
+	log.Info("Connecting to nats service...")
+	client, err := natsclient.NewClient(ctx, jsSink.URL, opts...)
+	if err != nil {
+		return nil, err
+	}
+	js, err := client.JetStreamContext()
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to create the jetstream context, %w", err)
+	}
+	return &ToJetStream{
+		name:         vertexInstance.Vertex.Spec.Name,
+		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
+		subject:      subject,
+		stream:       jsSink.Stream,
+		ackTimeout:   jsSink.GetAckTimeout(),
+		client:       client,
+		js:           js,
+		log:          log,
+	}, nil
+}
+
+// GetName returns the name.
+func (tj *ToJetStream) GetName() string {
+	return tj.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (tj *ToJetStream) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write publishes the messages asynchronously and waits for their acks. A message whose subject can't be rendered,
+// which is too large, or which is stored by a stream other than the expected one gets an error wrapping
+// udsink.WriteToFallbackErr, so that it's written to the fallback sink, the other failures are retried.
+func (tj *ToJetStream) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	futures := make([]natslib.PubAckFuture, len(messages))
+	for i, msg := range messages {
+		m, err := newMsg(tj.subject, msg)
+		if err != nil {
+			errs[i] = fmt.Errorf("%s, %w", err, &udsink.WriteToFallbackErr)
+			continue
+		}
+		opts := []natslib.PubOpt{natslib.MsgId(msg.ID.String())}
+		if tj.stream != "" {
+			opts = append(opts, natslib.ExpectStream(tj.stream))
+		}
+		if futures[i], err = tj.js.PublishMsgAsync(m, opts...); err != nil {
+			errs[i] = publishError(err)
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, tj.ackTimeout)
+	defer cancel()
+	for i, f := range futures {
+		if f == nil {
+			continue
+		}
+		select {
+		case <-f.Ok():
+		case err := <-f.Err():
+			errs[i] = publishError(err)
+		case <-ctx.Done():
+			errs[i] = fmt.Errorf("failed to wait for the ack of the message, %w", ctx.Err())
+		}
+	}
+	var sent, permanent, retryable int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			sent++
+		case errors.Is(err, &udsink.WriteToFallbackErr):
+			permanent++
+		default:
+			retryable++
+		}
+	}
+	jetstreamSinkWriteCount.With(map[string]string{metrics.LabelVertex: tj.name, metrics.LabelPipeline: tj.pipelineName}).Add(float64(sent))
+	if permanent > 0 {
+		jetstreamSinkWriteErrors.With(map[string]string{metrics.LabelVertex: tj.name, metrics.LabelPipeline: tj.pipelineName, metrics.LabelReason: "permanent"}).Add(float64(permanent))
+	}
+	if retryable > 0 {
+		jetstreamSinkWriteErrors.With(map[string]string{metrics.LabelVertex: tj.name, metrics.LabelPipeline: tj.pipelineName, metrics.LabelReason: "retryable"}).Add(float64(retryable))
+	}
+	return nil, errs
+}
+
+// publishError returns the error of a failed publish, which wraps udsink.WriteToFallbackErr if it's permanent.
+func publishError(err error) error {
+	var apiErr *natslib.APIError
+	if errors.Is(err, natslib.ErrMaxPayload) ||
+		(errors.As(err, &apiErr) && (apiErr.ErrorCode == jsErrCodeMessageExceedsMaximum || apiErr.ErrorCode == jsErrCodeStreamNotMatch)) {
+		return fmt.Errorf("failed to publish the message, %s, %w", err, &udsink.WriteToFallbackErr)
+	}
+	return fmt.Errorf("failed to publish the message, %w", err)
+}
+
+// Close closes the nats client.
+func (tj *ToJetStream) Close() error {
+	tj.client.Close()
+	return nil
+}
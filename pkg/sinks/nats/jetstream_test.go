@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	natslib "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+	"github.com/numaproj/numaflow/pkg/sinks/udsink"
+)
+
+func TestToJetStream_Write(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	server := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, server)
+
+	conn, err := natslib.Connect(server.ClientURL())
+	require.NoError(t, err)
+	defer conn.Close()
+	js, err := conn.JetStream()
+	require.NoError(t, err)
+	_, err = js.AddStream(&natslib.StreamConfig{Name: "ORDERS", Subjects: []string{"orders.>"}})
+	require.NoError(t, err)
+
+	sink := &dfv1.JetStreamSink{URL: server.ClientURL(), Subject: "orders.{{json(payload).region}}", Stream: "ORDERS"}
+	tj, err := NewToJetStream(ctx, newTestVertexInstance(dfv1.AbstractSink{JetStream: sink}), sink)
+	require.NoError(t, err)
+	defer func() { _ = tj.Close() }()
+	assert.Equal(t, "test-sink", tj.GetName())
+
+	messages := newTestMessages(`{"region":"us"}`, `{"region":"eu"}`, `not json`)
+	_, errs := tj.Write(ctx, messages)
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.True(t, errors.Is(errs[2], &udsink.WriteToFallbackErr))
+
+	// The messages written again are deduplicated by their IDs.
+	_, errs = tj.Write(ctx, messages[:2])
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	info, err := js.StreamInfo("ORDERS")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), info.State.Msgs)
+
+	m, err := js.GetMsg("ORDERS", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "orders.us", m.Subject)
+	assert.Equal(t, messages[0].ID.String(), m.Header.Get(natslib.MsgIdHdr))
+	assert.Equal(t, "t1", m.Header.Get("x-tenant"))
+}
+
+func TestToJetStream_WriteFailures(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	server := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, server)
+
+	conn, err := natslib.Connect(server.ClientURL())
+	require.NoError(t, err)
+	defer conn.Close()
+	js, err := conn.JetStream()
+	require.NoError(t, err)
+	_, err = js.AddStream(&natslib.StreamConfig{Name: "ORDERS", Subjects: []string{"orders.>"}})
+	require.NoError(t, err)
+
+	t.Run("stream not match", func(t *testing.T) {
+		sink := &dfv1.JetStreamSink{URL: server.ClientURL(), Subject: "orders.us", Stream: "OTHER"}
+		tj, err := NewToJetStream(ctx, newTestVertexInstance(dfv1.AbstractSink{JetStream: sink}), sink)
+		require.NoError(t, err)
+		defer func() { _ = tj.Close() }()
+		_, errs := tj.Write(ctx, newTestMessages(`{}`))
+		assert.True(t, errors.Is(errs[0], &udsink.WriteToFallbackErr))
+	})
+
+	t.Run("no stream", func(t *testing.T) {
+		sink := &dfv1.JetStreamSink{URL: server.ClientURL(), Subject: "payments.us"}
+		tj, err := NewToJetStream(ctx, newTestVertexInstance(dfv1.AbstractSink{JetStream: sink}), sink)
+		require.NoError(t, err)
+		defer func() { _ = tj.Close() }()
+		_, errs := tj.Write(ctx, newTestMessages(`{}`))
+		assert.Error(t, errs[0])
+		assert.False(t, errors.Is(errs[0], &udsink.WriteToFallbackErr))
+	})
+}
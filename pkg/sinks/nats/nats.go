@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	natslib "github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sinks/udsink"
+)
+
+// ToNats publishes the messages to NATS. A write returns after the server has processed the published messages,
+// however NATS core doesn't persist them, they're only delivered to the subscribers at the moment.
+type ToNats struct {
+	name         string
+	pipelineName string
+	subject      *expr.Template
+	client       *natsclient.Client
+	log          *zap.SugaredLogger
+}
+
+// NewToNats returns ToNats type, the sink spec is passed in as it could be the fallback sink.
+func NewToNats(ctx context.Context, vertexInstance *dfv1.VertexInstance, natsSink *dfv1.NatsSink) (*ToNats, error) {
+	subject, err := expr.ParseTemplate(natsSink.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subject template, %w", err)
+	}
+	opts, err := natsclient.ConnectionOptions(natsSink.TLS, natsSink.Auth)
+	if err != nil {
+		return nil, err
+	}
+	log := logging.FromContext(ctx).With("sinkType", "nats")
+	log.Info("Connecting to nats service...")
+	client, err := natsclient.NewClient(ctx, natsSink.URL, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ToNats{
+		name:         vertexInstance.Vertex.Spec.Name,
+		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
+		subject:      subject,
+		client:       client,
+		log:          log,
+	}, nil
+}
+
+// GetName returns the name.
+func (tn *ToNats) GetName() string {
+	return tn.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (tn *ToNats) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write publishes the messages and flushes them to the server. A message whose subject can't be rendered or which
+// exceeds the max payload gets an error wrapping udsink.WriteToFallbackErr, so that it's written to the fallback sink,
+// the other failures are retried.
+func (tn *ToNats) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	var published []int
+	for i, msg := range messages {
+		m, err := newMsg(tn.subject, msg)
+		if err != nil {
+			errs[i] = fmt.Errorf("%s, %w", err, &udsink.WriteToFallbackErr)
+			continue
+		}
+		if err := tn.client.PublishMsg(m); err != nil {
+			if errors.Is(err, natslib.ErrMaxPayload) {
+				err = fmt.Errorf("%s, %w", err, &udsink.WriteToFallbackErr)
+			}
+			errs[i] = fmt.Errorf("failed to publish the message, %w", err)
+			continue
+		}
+		published = append(published, i)
+	}
+	if len(published) > 0 {
+		if err := tn.client.Flush(ctx); err != nil {
+			for _, i := range published {
+				errs[i] = fmt.Errorf("failed to flush the published messages, %w", err)
+			}
+		}
+	}
+	var sent, permanent, retryable int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			sent++
+		case errors.Is(err, &udsink.WriteToFallbackErr):
+			permanent++
+		default:
+			retryable++
+		}
+	}
+	natsSinkWriteCount.With(map[string]string{metrics.LabelVertex: tn.name, metrics.LabelPipeline: tn.pipelineName}).Add(float64(sent))
+	if permanent > 0 {
+		natsSinkWriteErrors.With(map[string]string{metrics.LabelVertex: tn.name, metrics.LabelPipeline: tn.pipelineName, metrics.LabelReason: "permanent"}).Add(float64(permanent))
+	}
+	if retryable > 0 {
+		natsSinkWriteErrors.With(map[string]string{metrics.LabelVertex: tn.name, metrics.LabelPipeline: tn.pipelineName, metrics.LabelReason: "retryable"}).Add(float64(retryable))
+	}
+	return nil, errs
+}
+
+// newMsg returns the nats message of a message, with the rendered subject and the headers of the message.
+func newMsg(subject *expr.Template, msg isb.Message) (*natslib.Msg, error) {
+	s, err := subject.Render(msg.Payload, msg.Keys, msg.Headers, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render the subject, %w", err)
+	}
+	if err := natsclient.ValidatePublishSubject(s); err != nil {
+		return nil, err
+	}
+	m := natslib.NewMsg(s)
+	m.Data = msg.Payload
+	for k, v := range msg.Headers {
+		m.Header.Set(k, v)
+	}
+	return m, nil
+}
+
+// Close closes the nats client.
+func (tn *ToNats) Close() error {
+	tn.client.Close()
+	return nil
+}
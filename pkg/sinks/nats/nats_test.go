@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	natslib "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+	"github.com/numaproj/numaflow/pkg/sinks/udsink"
+)
+
+func newTestVertexInstance(sink dfv1.AbstractSink) *dfv1.VertexInstance {
+	return &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "test-pipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "test-sink",
+				Sink: &dfv1.Sink{AbstractSink: sink},
+			},
+		}},
+	}
+}
+
+func newTestMessages(payloads ...string) []isb.Message {
+	var messages []isb.Message
+	for i, p := range payloads {
+		messages = append(messages, isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: time.Unix(1636470000, 0)},
+				ID:          isb.MessageID{VertexName: "test", Offset: "0-0", Index: int32(i)},
+				Keys:        []string{"k"},
+				Headers:     map[string]string{"x-tenant": "t1"},
+			},
+			Body: isb.Body{Payload: []byte(p)},
+		})
+	}
+	return messages
+}
+
+func TestToNats_Write(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	server := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, server)
+
+	conn, err := natslib.Connect(server.ClientURL())
+	require.NoError(t, err)
+	defer conn.Close()
+	sub, err := conn.SubscribeSync("orders.>")
+	require.NoError(t, err)
+	require.NoError(t, conn.Flush())
+
+	sink := &dfv1.NatsSink{URL: server.ClientURL(), Subject: "orders.{{json(payload).region}}"}
+	tn, err := NewToNats(ctx, newTestVertexInstance(dfv1.AbstractSink{Nats: sink}), sink)
+	require.NoError(t, err)
+	defer func() { _ = tn.Close() }()
+	assert.Equal(t, "test-sink", tn.GetName())
+
+	_, errs := tn.Write(ctx, newTestMessages(`{"region":"us"}`, `{"region":"a b"}`, `{"region":"eu"}`))
+	assert.NoError(t, errs[0])
+	assert.True(t, errors.Is(errs[1], &udsink.WriteToFallbackErr))
+	assert.NoError(t, errs[2])
+
+	m, err := sub.NextMsg(5 * time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "orders.us", m.Subject)
+	assert.Equal(t, `{"region":"us"}`, string(m.Data))
+	assert.Equal(t, "t1", m.Header.Get("x-tenant"))
+	m, err = sub.NextMsg(5 * time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "orders.eu", m.Subject)
+}
+
+func TestNewToNats_InvalidSubject(t *testing.T) {
+	sink := &dfv1.NatsSink{URL: "nats://127.0.0.1:4222", Subject: "orders.{{json(payload).region"}
+	_, err := NewToNats(context.Background(), newTestVertexInstance(dfv1.AbstractSink{Nats: sink}), sink)
+	assert.Error(t, err)
+}
@@ -38,16 +38,24 @@ import (
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/ratelimit"
+	jsratelimit "github.com/numaproj/numaflow/pkg/shared/ratelimit/jetstream"
+	redisratelimit "github.com/numaproj/numaflow/pkg/shared/ratelimit/redis"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/sinks/blackhole"
+	filesink "github.com/numaproj/numaflow/pkg/sinks/file"
 	sinkforward "github.com/numaproj/numaflow/pkg/sinks/forward"
+	httpsink "github.com/numaproj/numaflow/pkg/sinks/http"
 	kafkasink "github.com/numaproj/numaflow/pkg/sinks/kafka"
 	logsink "github.com/numaproj/numaflow/pkg/sinks/logger"
+	natssink "github.com/numaproj/numaflow/pkg/sinks/nats"
 	"github.com/numaproj/numaflow/pkg/sinks/sinker"
+	sqlsink "github.com/numaproj/numaflow/pkg/sinks/sql"
 	"github.com/numaproj/numaflow/pkg/sinks/udsink"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
+	rediswm "github.com/numaproj/numaflow/pkg/watermark/generic/redis"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
@@ -65,6 +73,7 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		fromVertexWmStores map[string]store.WatermarkStore
 		sinkWmStores       map[string]store.WatermarkStore
 		idleManager        wmb.IdleManager
+		rateLimiter        ratelimit.RateLimiter
 		sinkHandler        *udsink.UDSgRPCBasedUDSink
 		fbSinkHandler      *udsink.UDSgRPCBasedUDSink
 		healthCheckers     = make([]metrics.HealthChecker, 0)
@@ -96,6 +105,34 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 			reader := redisisb.NewBufferRead(ctx, redisClient, bufferPartition, fromGroup, consumer, int32(index), readOptions...)
 			readers = append(readers, reader)
 		}
+
+		if u.VertexInstance.Vertex.Spec.Watermark.Disabled {
+			// use default no op fetcher, publisher, idleManager
+		} else {
+			// build from vertex watermark stores
+			fromVertexWmStores, err = rediswm.BuildFromVertexWatermarkStores(ctx, u.VertexInstance, redisClient)
+			if err != nil {
+				return fmt.Errorf("failed to from vertex watermark stores: %w", err)
+			}
+
+			// create watermark fetcher using watermark stores
+			fetchWatermark = fetch.NewEdgeFetcherSet(ctx, u.VertexInstance, fromVertexWmStores)
+
+			// create watermark stores
+			sinkWmStores, err = rediswm.BuildToVertexWatermarkStores(ctx, u.VertexInstance, redisClient)
+			if err != nil {
+				return fmt.Errorf("failed to to vertex watermark stores: %w", err)
+			}
+
+			// create watermark publisher using watermark stores
+			publishWatermark = generic.BuildPublishersFromStores(ctx, u.VertexInstance, sinkWmStores)
+			// sink vertex has only one toBuffer, so the length is 1
+			idleManager, _ = wmb.NewIdleManager(len(readers), 1)
+		}
+
+		if rateLimiter, err = redisratelimit.BuildRateLimiter(ctx, u.VertexInstance.Vertex, redisClient); err != nil {
+			return fmt.Errorf("failed to build rate limiter: %w", err)
+		}
 	case dfv1.ISBSvcTypeJetStream:
 
 		natsClientPool, err = jsclient.NewClientPool(ctx, jsclient.WithClientPoolSize(2))
@@ -139,11 +176,15 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 			}
 
 			// create watermark publisher using watermark stores
-			publishWatermark = jetstream.BuildPublishersFromStores(ctx, u.VertexInstance, sinkWmStores)
+			publishWatermark = generic.BuildPublishersFromStores(ctx, u.VertexInstance, sinkWmStores)
 			// sink vertex has only one toBuffer, so the length is 1
 			idleManager, _ = wmb.NewIdleManager(len(readers), 1)
 		}
 
+		if rateLimiter, err = jsratelimit.BuildRateLimiter(ctx, u.VertexInstance.Vertex, natsClientPool.NextAvailableClient()); err != nil {
+			return fmt.Errorf("failed to build rate limiter: %w", err)
+		}
+
 	default:
 		return fmt.Errorf("unrecognized isb svc type %q", u.ISBSvcType)
 	}
@@ -216,6 +257,12 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 				forwardOpts = append(forwardOpts, sinkforward.WithReadBatchSize(int64(*x.ReadBatchSize)))
 			}
 		}
+		if rateLimiter != nil {
+			forwardOpts = append(forwardOpts, sinkforward.WithRateLimiter(rateLimiter))
+		}
+		if x := u.VertexInstance.Vertex.Spec.Sink.RetryStrategy; x != nil {
+			forwardOpts = append(forwardOpts, sinkforward.WithRetryStrategy(x))
+		}
 
 		// create the main sink writer
 		sinkWriter, err := u.createSinkWriter(ctx, &u.VertexInstance.Vertex.Spec.Sink.AbstractSink, sinkHandler)
@@ -316,6 +363,20 @@ func (u *SinkProcessor) createSinkWriter(ctx context.Context, abstractSink *dfv1
 		return kafkasink.NewToKafka(ctx, u.VertexInstance)
 	} else if x := abstractSink.Blackhole; x != nil {
 		return blackhole.NewBlackhole(ctx, u.VertexInstance)
+	} else if x := abstractSink.HTTP; x != nil {
+		return httpsink.NewToHTTP(ctx, u.VertexInstance, x)
+	} else if x := abstractSink.File; x != nil {
+		var opts []filesink.Option
+		if abstractSink == u.VertexInstance.Vertex.Spec.Sink.Fallback {
+			opts = append(opts, filesink.WithFallback())
+		}
+		return filesink.NewToFile(ctx, u.VertexInstance, x, opts...)
+	} else if x := abstractSink.Nats; x != nil {
+		return natssink.NewToNats(ctx, u.VertexInstance, x)
+	} else if x := abstractSink.JetStream; x != nil {
+		return natssink.NewToJetStream(ctx, u.VertexInstance, x)
+	} else if x := abstractSink.SQL; x != nil {
+		return sqlsink.NewToSQL(ctx, u.VertexInstance, x)
 	} else if x := abstractSink.UDSink; x != nil {
 		// if the sink is a user-defined sink, then we need to pass the sinkHandler to it which will be used to invoke the user-defined sink
 		return udsink.NewUserDefinedSink(ctx, u.VertexInstance, sinkHandler)
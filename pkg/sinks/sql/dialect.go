@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// dialect is the SQL syntax and the error codes of a database.
+type dialect struct {
+	// driverName is the name of the database/sql driver.
+	driverName string
+	// quote is the character to quote the identifiers.
+	quote string
+	// placeholder returns the placeholder of the nth (1-based) parameter of a statement.
+	placeholder func(n int) string
+	// maxParams is the maximum number of the parameters of a statement.
+	maxParams int
+	// onConflict returns the clause of an upsert, which updates the update columns of the rows conflicting on the key columns.
+	onConflict func(d *dialect, keyColumns, updateColumns []string) string
+	// isDataError returns true if the error is caused by the data of the rows, e.g. a constraint violation
+	// or an invalid value, writing the same rows again fails with the same error.
+	isDataError func(err error) bool
+}
+
+var postgresDialect = &dialect{
+	driverName:  "postgres",
+	quote:       `"`,
+	placeholder: func(n int) string { return "$" + strconv.Itoa(n) },
+	maxParams:   65535,
+	onConflict:  onConflictDoUpdate,
+	isDataError: func(err error) bool {
+		var pqErr *pq.Error
+		if !errors.As(err, &pqErr) {
+			return false
+		}
+		// Class 22 is the data exceptions, class 23 is the integrity constraint violations.
+		switch pqErr.Code.Class() {
+		case "22", "23":
+			return true
+		}
+		return false
+	},
+}
+
+var mysqlDialect = &dialect{
+	driverName:  "mysql",
+	quote:       "`",
+	placeholder: func(int) string { return "?" },
+	maxParams:   65535,
+	onConflict: func(d *dialect, keyColumns, updateColumns []string) string {
+		// The conflict target of MySQL is implied by the unique indexes, updating a key column to itself makes
+		// the statement ignore the conflicting rows when there is no column to update.
+		if len(updateColumns) == 0 {
+			c := d.quoteIdent(keyColumns[0])
+			return " ON DUPLICATE KEY UPDATE " + c + " = " + c
+		}
+		sets := make([]string, len(updateColumns))
+		for i, c := range updateColumns {
+			sets[i] = fmt.Sprintf("%s = VALUES(%s)", d.quoteIdent(c), d.quoteIdent(c))
+		}
+		return " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+	},
+	isDataError: func(err error) bool {
+		var myErr *mysql.MySQLError
+		if !errors.As(err, &myErr) {
+			return false
+		}
+		switch myErr.Number {
+		case 1048, // ER_BAD_NULL_ERROR
+			1062, // ER_DUP_ENTRY
+			1264, // ER_WARN_DATA_OUT_OF_RANGE
+			1265, // WARN_DATA_TRUNCATED
+			1292, // ER_TRUNCATED_WRONG_VALUE
+			1366, // ER_TRUNCATED_WRONG_VALUE_FOR_FIELD
+			1406, // ER_DATA_TOO_LONG
+			1451, // ER_ROW_IS_REFERENCED_2
+			1452, // ER_NO_REFERENCED_ROW_2
+			3819: // ER_CHECK_CONSTRAINT_VIOLATED
+			return true
+		}
+		return false
+	},
+}
+
+func getDialect(driver dfv1.SQLSinkDriver) (*dialect, error) {
+	switch driver {
+	case dfv1.SQLSinkDriverPostgres:
+		return postgresDialect, nil
+	case dfv1.SQLSinkDriverMySQL:
+		return mysqlDialect, nil
+	}
+	return nil, fmt.Errorf("unsupported driver %q", driver)
+}
+
+// onConflictDoUpdate returns the "ON CONFLICT" clause of the upserts, which is supported by PostgreSQL and SQLite.
+func onConflictDoUpdate(d *dialect, keyColumns, updateColumns []string) string {
+	target := make([]string, len(keyColumns))
+	for i, c := range keyColumns {
+		target[i] = d.quoteIdent(c)
+	}
+	clause := " ON CONFLICT (" + strings.Join(target, ", ") + ")"
+	if len(updateColumns) == 0 {
+		return clause + " DO NOTHING"
+	}
+	sets := make([]string, len(updateColumns))
+	for i, c := range updateColumns {
+		sets[i] = fmt.Sprintf("%s = EXCLUDED.%s", d.quoteIdent(c), d.quoteIdent(c))
+	}
+	return clause + " DO UPDATE SET " + strings.Join(sets, ", ")
+}
+
+// quoteIdent quotes an identifier, the quote characters in it are escaped by doubling them.
+func (d *dialect) quoteIdent(s string) string {
+	return d.quote + strings.ReplaceAll(s, d.quote, d.quote+d.quote) + d.quote
+}
+
+// quoteTable quotes a table name, which could be qualified by the schema.
+func (d *dialect) quoteTable(s string) string {
+	parts := strings.Split(s, ".")
+	for i, p := range parts {
+		parts[i] = d.quoteIdent(p)
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,355 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/sinks/udsink"
+)
+
+// savepoint is the name of the savepoint to roll back a failed row when the rows are written one by one.
+const savepoint = "numaflow_row"
+
+// ToSQL writes the messages to a table of a relational database.
+type ToSQL struct {
+	name         string
+	pipelineName string
+	db           *sql.DB
+	dialect      *dialect
+	// fields are the paths of the payload fields of the columns.
+	fields     [][]string
+	keyColumns []string
+	upsert     bool
+	// batchSize is the maximum number of the rows in a statement.
+	batchSize int
+	// insert is the beginning of the statements, up to the "VALUES".
+	insert string
+	// onConflict is the clause appended to the statements of the upserts.
+	onConflict string
+	log        *zap.SugaredLogger
+}
+
+// row is a row to write, with the indexes of the messages written as it. An upsert writes the messages with the same
+// keys in a write as one row, which is the last one of them.
+type row struct {
+	values  []interface{}
+	indexes []int
+}
+
+// NewToSQL returns ToSQL type, the sink spec is passed in as it could be the fallback sink.
+func NewToSQL(ctx context.Context, vertexInstance *dfv1.VertexInstance, sqlSink *dfv1.SQLSink) (*ToSQL, error) {
+	d, err := getDialect(sqlSink.Driver)
+	if err != nil {
+		return nil, err
+	}
+	dsn, err := sharedutil.GetSecretFromVolume(sqlSink.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the dsn, %w", err)
+	}
+	db, err := sql.Open(d.driverName, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the database, %w", err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to connect to the database, %w", err)
+	}
+	return newToSQL(ctx, vertexInstance, sqlSink, db, d), nil
+}
+
+func newToSQL(ctx context.Context, vertexInstance *dfv1.VertexInstance, sqlSink *dfv1.SQLSink, db *sql.DB, d *dialect) *ToSQL {
+	s := &ToSQL{
+		name:         vertexInstance.Vertex.Spec.Name,
+		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
+		db:           db,
+		dialect:      d,
+		keyColumns:   sqlSink.KeyColumns,
+		upsert:       sqlSink.GetMode() == dfv1.SQLSinkModeUpsert,
+		log:          logging.FromContext(ctx).With("sinkType", "sql"),
+	}
+	columns := make([]string, 0, len(sqlSink.KeyColumns)+len(sqlSink.Columns))
+	quoted := make([]string, 0, cap(columns))
+	for _, c := range sqlSink.KeyColumns {
+		columns = append(columns, c)
+		quoted = append(quoted, d.quoteIdent(c))
+	}
+	var updateColumns []string
+	for _, c := range sqlSink.Columns {
+		s.fields = append(s.fields, strings.Split(c.GetField(), "."))
+		columns = append(columns, c.Name)
+		quoted = append(quoted, d.quoteIdent(c.Name))
+		updateColumns = append(updateColumns, c.Name)
+	}
+	s.insert = fmt.Sprintf("INSERT INTO %s (%s) VALUES ", d.quoteTable(sqlSink.Table), strings.Join(quoted, ", "))
+	if s.upsert {
+		s.onConflict = d.onConflict(d, sqlSink.KeyColumns, updateColumns)
+	}
+	s.batchSize = sqlSink.GetBatchSize()
+	// A row has more parameters than a statement allows if maxRows is 0, it's still written in a statement of its own
+	// to get the error of the database, rather than never writing anything.
+	if maxRows := max(d.maxParams/len(columns), 1); s.batchSize > maxRows {
+		s.batchSize = maxRows
+	}
+	return s
+}
+
+// GetName returns the name.
+func (s *ToSQL) GetName() string {
+	return s.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (s *ToSQL) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write writes the messages in a transaction, with the statements of up to batchSize rows. If a statement fails
+// because of the data of a row, the rows are written again one by one in a transaction, so that only the failed
+// messages get errors, which wrap udsink.WriteToFallbackErr to be written to the fallback sink. The other
+// failures, e.g. connection errors, fail all the messages and are retried.
+func (s *ToSQL) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	rows := s.buildRows(messages, errs)
+	if len(rows) > 0 {
+		if err := s.writeBatches(ctx, rows); err != nil {
+			if s.dialect.isDataError(err) {
+				s.log.Warnw("Failed to write the rows in batches, writing them one by one", zap.Error(err))
+				s.writeRows(ctx, rows, errs)
+			} else {
+				setErrors(rows, errs, fmt.Errorf("failed to write the rows, %w", err))
+			}
+		}
+	}
+	var written, permanent, retryable int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			written++
+		case errors.Is(err, &udsink.WriteToFallbackErr):
+			permanent++
+		default:
+			retryable++
+		}
+	}
+	sqlSinkWriteCount.With(map[string]string{metrics.LabelVertex: s.name, metrics.LabelPipeline: s.pipelineName}).Add(float64(written))
+	if permanent > 0 {
+		sqlSinkWriteErrors.With(map[string]string{metrics.LabelVertex: s.name, metrics.LabelPipeline: s.pipelineName, metrics.LabelReason: "permanent"}).Add(float64(permanent))
+	}
+	if retryable > 0 {
+		sqlSinkWriteErrors.With(map[string]string{metrics.LabelVertex: s.name, metrics.LabelPipeline: s.pipelineName, metrics.LabelReason: "retryable"}).Add(float64(retryable))
+	}
+	return nil, errs
+}
+
+// buildRows returns the rows of the messages, the messages which can't be converted to rows get permanent errors.
+func (s *ToSQL) buildRows(messages []isb.Message, errs []error) []row {
+	var rows []row
+	// byKeys is the index of the row of each keys of the upserts.
+	byKeys := make(map[string]int)
+	for i, msg := range messages {
+		values, err := s.rowValues(msg)
+		if err != nil {
+			errs[i] = fmt.Errorf("invalid message, %s, %w", err, &udsink.WriteToFallbackErr)
+			continue
+		}
+		if s.upsert {
+			// Writing the same keys twice in a statement fails, the last message wins.
+			k := strings.Join(msg.Keys[:len(s.keyColumns)], "\x00")
+			if idx, ok := byKeys[k]; ok {
+				rows[idx].values = values
+				rows[idx].indexes = append(rows[idx].indexes, i)
+				continue
+			}
+			byKeys[k] = len(rows)
+		}
+		rows = append(rows, row{values: values, indexes: []int{i}})
+	}
+	return rows
+}
+
+// rowValues returns the values of the key columns followed by the columns of a message.
+func (s *ToSQL) rowValues(msg isb.Message) ([]interface{}, error) {
+	if len(msg.Keys) < len(s.keyColumns) {
+		return nil, fmt.Errorf("expected %d keys, got %d", len(s.keyColumns), len(msg.Keys))
+	}
+	var payload map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(msg.Payload))
+	d.UseNumber()
+	if err := d.Decode(&payload); err != nil {
+		return nil, fmt.Errorf("payload is not a JSON object, %w", err)
+	}
+	values := make([]interface{}, 0, len(s.keyColumns)+len(s.fields))
+	for _, k := range msg.Keys[:len(s.keyColumns)] {
+		values = append(values, k)
+	}
+	for _, f := range s.fields {
+		v, err := columnValue(lookup(payload, f))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of field %q, %w", strings.Join(f, "."), err)
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// lookup returns the value of a field of a JSON object, nil if it doesn't exist.
+func lookup(obj map[string]interface{}, path []string) interface{} {
+	var v interface{} = obj
+	for _, p := range path {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[p]
+	}
+	return v
+}
+
+// columnValue converts a JSON value to a column value. The integers are written as int64, the other numbers are
+// written as strings to keep their precision, and the objects and arrays are written as JSON strings.
+func columnValue(v interface{}) (interface{}, error) {
+	switch x := v.(type) {
+	case nil, string, bool:
+		return x, nil
+	case json.Number:
+		if i, err := x.Int64(); err == nil {
+			return i, nil
+		}
+		return x.String(), nil
+	default:
+		b, err := json.Marshal(x)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	}
+}
+
+// statement returns the statement to write the rows and its arguments.
+func (s *ToSQL) statement(rows []row) (string, []interface{}) {
+	var b strings.Builder
+	b.WriteString(s.insert)
+	args := make([]interface{}, 0, len(rows)*len(rows[0].values))
+	for i, r := range rows {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString("(")
+		for j, v := range r.values {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			args = append(args, v)
+			b.WriteString(s.dialect.placeholder(len(args)))
+		}
+		b.WriteString(")")
+	}
+	b.WriteString(s.onConflict)
+	return b.String(), args
+}
+
+// writeBatches writes the rows in a transaction, with the statements of up to batchSize rows.
+func (s *ToSQL) writeBatches(ctx context.Context, rows []row) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for start := 0; start < len(rows); start += s.batchSize {
+		end := start + s.batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		query, args := s.statement(rows[start:end])
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// writeRows writes the rows one by one in a transaction, a row failed because of its data is rolled back to the
+// savepoint before it and gets a permanent error. The other failures roll back the transaction.
+func (s *ToSQL) writeRows(ctx context.Context, rows []row, errs []error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		setErrors(rows, errs, fmt.Errorf("failed to begin the transaction, %w", err))
+		return
+	}
+	for _, r := range rows {
+		err := s.writeRow(ctx, tx, r)
+		if err == nil {
+			continue
+		}
+		if !s.dialect.isDataError(err) {
+			_ = tx.Rollback()
+			setErrors(rows, errs, fmt.Errorf("failed to write the rows, %w", err))
+			return
+		}
+		for _, i := range r.indexes {
+			errs[i] = fmt.Errorf("failed to write the row, %s, %w", err, &udsink.WriteToFallbackErr)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		setErrors(rows, errs, fmt.Errorf("failed to commit the transaction, %w", err))
+	}
+}
+
+func (s *ToSQL) writeRow(ctx context.Context, tx *sql.Tx, r row) error {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+savepoint); err != nil {
+		return err
+	}
+	query, args := s.statement([]row{r})
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+savepoint); rbErr != nil {
+			return rbErr
+		}
+		return err
+	}
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+savepoint)
+	return err
+}
+
+// setErrors sets the error of the messages of the rows which haven't failed.
+func setErrors(rows []row, errs []error, err error) {
+	for _, r := range rows {
+		for _, i := range r.indexes {
+			if errs[i] == nil {
+				errs[i] = err
+			}
+		}
+	}
+}
+
+func (s *ToSQL) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/sinks/udsink"
+)
+
+// sqliteDialect is used to test the sink against SQLite, which supports the upserts of PostgreSQL.
+var sqliteDialect = &dialect{
+	driverName:  "sqlite",
+	quote:       `"`,
+	placeholder: func(int) string { return "?" },
+	maxParams:   32766,
+	onConflict:  onConflictDoUpdate,
+	isDataError: func(err error) bool {
+		var e *sqlite.Error
+		if !errors.As(err, &e) {
+			return false
+		}
+		switch e.Code() & 0xff {
+		case sqlite3.SQLITE_CONSTRAINT, sqlite3.SQLITE_MISMATCH, sqlite3.SQLITE_TOOBIG:
+			return true
+		}
+		return false
+	},
+}
+
+func newTestVertexInstance() *dfv1.VertexInstance {
+	return &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "test-pipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "test-sink",
+			},
+		}},
+	}
+}
+
+func newTestDB(t *testing.T, ddl string) *sql.DB {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	_, err = db.Exec(ddl)
+	require.NoError(t, err)
+	return db
+}
+
+func newTestMessages(keys [][]string, payloads ...string) []isb.Message {
+	var messages []isb.Message
+	for i, p := range payloads {
+		var k []string
+		if keys != nil {
+			k = keys[i]
+		}
+		messages = append(messages, isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: time.Unix(1636470000, 0)},
+				ID:          isb.MessageID{VertexName: "test", Offset: "0-0", Index: int32(i)},
+				Keys:        k,
+			},
+			Body: isb.Body{Payload: []byte(p)},
+		})
+	}
+	return messages
+}
+
+func TestToSQL_Insert(t *testing.T) {
+	db := newTestDB(t, "CREATE TABLE events (id INTEGER, name TEXT, amount REAL, city TEXT, tags TEXT)")
+	batchSize := uint32(2)
+	sink := &dfv1.SQLSink{
+		Table: "events",
+		Columns: []dfv1.SQLSinkColumn{
+			{Name: "id"}, {Name: "name"}, {Name: "amount"}, {Name: "city", Field: "address.city"}, {Name: "tags"},
+		},
+		BatchSize: &batchSize,
+	}
+	s := newToSQL(context.Background(), newTestVertexInstance(), sink, db, sqliteDialect)
+	defer func() { _ = s.Close() }()
+	assert.Equal(t, "test-sink", s.GetName())
+
+	_, errs := s.Write(context.Background(), newTestMessages(nil,
+		`{"id":1,"name":"a","amount":1.5,"address":{"city":"x"},"tags":["t1","t2"]}`,
+		`not json`,
+		`{"id":2,"name":"b"}`,
+		`{"id":3,"name":"c","amount":10}`,
+	))
+	assert.NoError(t, errs[0])
+	assert.True(t, errors.Is(errs[1], &udsink.WriteToFallbackErr))
+	assert.NoError(t, errs[2])
+	assert.NoError(t, errs[3])
+
+	rows, err := db.Query("SELECT id, name, amount, city, tags FROM events ORDER BY id")
+	require.NoError(t, err)
+	defer func() { _ = rows.Close() }()
+	type event struct {
+		id         int64
+		name       string
+		amount     sql.NullFloat64
+		city, tags sql.NullString
+	}
+	var events []event
+	for rows.Next() {
+		var e event
+		require.NoError(t, rows.Scan(&e.id, &e.name, &e.amount, &e.city, &e.tags))
+		events = append(events, e)
+	}
+	require.NoError(t, rows.Err())
+	require.Len(t, events, 3)
+	assert.Equal(t, event{1, "a", sql.NullFloat64{Float64: 1.5, Valid: true}, sql.NullString{String: "x", Valid: true}, sql.NullString{String: `["t1","t2"]`, Valid: true}}, events[0])
+	assert.Equal(t, event{id: 2, name: "b"}, events[1])
+	assert.Equal(t, 10.0, events[2].amount.Float64)
+}
+
+func TestToSQL_Upsert(t *testing.T) {
+	db := newTestDB(t, "CREATE TABLE counts (tenant TEXT, id TEXT, value INTEGER, PRIMARY KEY (tenant, id))")
+	sink := &dfv1.SQLSink{
+		Table:      "counts",
+		Columns:    []dfv1.SQLSinkColumn{{Name: "value"}},
+		KeyColumns: []string{"tenant", "id"},
+		Mode:       dfv1.SQLSinkModeUpsert,
+	}
+	s := newToSQL(context.Background(), newTestVertexInstance(), sink, db, sqliteDialect)
+	defer func() { _ = s.Close() }()
+
+	_, errs := s.Write(context.Background(), newTestMessages([][]string{{"t1", "a"}, {"t1", "b"}, {"t1", "a"}, {"t1"}},
+		`{"value":1}`, `{"value":2}`, `{"value":3}`, `{"value":4}`))
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.NoError(t, errs[2])
+	assert.True(t, errors.Is(errs[3], &udsink.WriteToFallbackErr))
+
+	_, errs = s.Write(context.Background(), newTestMessages([][]string{{"t1", "b"}, {"t2", "a"}}, `{"value":5}`, `{"value":6}`))
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+
+	counts := make(map[string]int64)
+	rows, err := db.Query("SELECT tenant, id, value FROM counts")
+	require.NoError(t, err)
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var tenant, id string
+		var value int64
+		require.NoError(t, rows.Scan(&tenant, &id, &value))
+		counts[tenant+"/"+id] = value
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, map[string]int64{"t1/a": 3, "t1/b": 5, "t2/a": 6}, counts)
+}
+
+func TestToSQL_WriteFailures(t *testing.T) {
+	t.Run("data errors", func(t *testing.T) {
+		db := newTestDB(t, "CREATE TABLE events (id INTEGER PRIMARY KEY, name TEXT NOT NULL) STRICT")
+		sink := &dfv1.SQLSink{Table: "events", Columns: []dfv1.SQLSinkColumn{{Name: "id"}, {Name: "name"}}}
+		s := newToSQL(context.Background(), newTestVertexInstance(), sink, db, sqliteDialect)
+		defer func() { _ = s.Close() }()
+
+		_, errs := s.Write(context.Background(), newTestMessages(nil,
+			`{"id":1,"name":"a"}`, `{"id":2}`, `{"id":"x","name":"c"}`, `{"id":4,"name":"d"}`, `{"id":1,"name":"e"}`))
+		assert.NoError(t, errs[0])
+		assert.True(t, errors.Is(errs[1], &udsink.WriteToFallbackErr))
+		assert.True(t, errors.Is(errs[2], &udsink.WriteToFallbackErr))
+		assert.NoError(t, errs[3])
+		assert.True(t, errors.Is(errs[4], &udsink.WriteToFallbackErr))
+
+		var count int
+		require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM events").Scan(&count))
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("retryable errors", func(t *testing.T) {
+		db := newTestDB(t, "CREATE TABLE events (id INTEGER)")
+		sink := &dfv1.SQLSink{Table: "missing", Columns: []dfv1.SQLSinkColumn{{Name: "id"}}}
+		s := newToSQL(context.Background(), newTestVertexInstance(), sink, db, sqliteDialect)
+		defer func() { _ = s.Close() }()
+
+		_, errs := s.Write(context.Background(), newTestMessages(nil, `{"id":1}`, `[]`))
+		assert.Error(t, errs[0])
+		assert.False(t, errors.Is(errs[0], &udsink.WriteToFallbackErr))
+		assert.True(t, errors.Is(errs[1], &udsink.WriteToFallbackErr))
+	})
+}
+
+func TestToSQL_Statement(t *testing.T) {
+	sink := &dfv1.SQLSink{
+		Table:      "public.events",
+		Columns:    []dfv1.SQLSinkColumn{{Name: "name"}, {Name: "amount"}},
+		KeyColumns: []string{"id"},
+		Mode:       dfv1.SQLSinkModeUpsert,
+	}
+	rows := []row{{values: []interface{}{"1", "a", 1}}, {values: []interface{}{"2", "b", 2}}}
+
+	s := newToSQL(context.Background(), newTestVertexInstance(), sink, nil, postgresDialect)
+	query, args := s.statement(rows)
+	assert.Equal(t, `INSERT INTO "public"."events" ("id", "name", "amount") VALUES ($1, $2, $3), ($4, $5, $6) ON CONFLICT ("id") DO UPDATE SET "name" = EXCLUDED."name", "amount" = EXCLUDED."amount"`, query)
+	assert.Equal(t, []interface{}{"1", "a", 1, "2", "b", 2}, args)
+
+	s = newToSQL(context.Background(), newTestVertexInstance(), sink, nil, mysqlDialect)
+	query, _ = s.statement(rows)
+	assert.Equal(t, "INSERT INTO `public`.`events` (`id`, `name`, `amount`) VALUES (?, ?, ?), (?, ?, ?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`), `amount` = VALUES(`amount`)", query)
+
+	sink.Columns = nil
+	rows = []row{{values: []interface{}{"1"}}}
+	s = newToSQL(context.Background(), newTestVertexInstance(), sink, nil, postgresDialect)
+	query, _ = s.statement(rows)
+	assert.Equal(t, `INSERT INTO "public"."events" ("id") VALUES ($1) ON CONFLICT ("id") DO NOTHING`, query)
+
+	sink.Mode = dfv1.SQLSinkModeInsert
+	s = newToSQL(context.Background(), newTestVertexInstance(), sink, nil, mysqlDialect)
+	query, _ = s.statement(rows)
+	assert.Equal(t, "INSERT INTO `public`.`events` (`id`) VALUES (?)", query)
+}
+
+func TestDialects(t *testing.T) {
+	tests := []struct {
+		name         string
+		dialect      *dialect
+		placeholders []string
+		quoted       string
+		upsert       string
+		doNothing    string
+		dataErrors   []error
+		otherErrors  []error
+	}{
+		{
+			name:         "postgres",
+			dialect:      postgresDialect,
+			placeholders: []string{"$1", "$2", "$10"},
+			quoted:       `"my""table"`,
+			upsert:       ` ON CONFLICT ("id", "ts") DO UPDATE SET "name" = EXCLUDED."name"`,
+			doNothing:    ` ON CONFLICT ("id", "ts") DO NOTHING`,
+			dataErrors: []error{
+				&pq.Error{Code: "23505"}, // unique_violation
+				&pq.Error{Code: "23502"}, // not_null_violation
+				&pq.Error{Code: "22P02"}, // invalid_text_representation
+				fmt.Errorf("failed to insert, %w", &pq.Error{Code: "22001"}),
+			},
+			otherErrors: []error{
+				&pq.Error{Code: "40001"}, // serialization_failure
+				&pq.Error{Code: "08006"}, // connection_failure
+				&pq.Error{Code: "42P01"}, // undefined_table
+				&mysql.MySQLError{Number: 1062},
+				errors.New("connection refused"),
+			},
+		},
+		{
+			name:         "mysql",
+			dialect:      mysqlDialect,
+			placeholders: []string{"?", "?", "?"},
+			quoted:       "`my``table`",
+			upsert:       " ON DUPLICATE KEY UPDATE `name` = VALUES(`name`)",
+			doNothing:    " ON DUPLICATE KEY UPDATE `id` = `id`",
+			dataErrors: []error{
+				&mysql.MySQLError{Number: 1062}, // ER_DUP_ENTRY
+				&mysql.MySQLError{Number: 1048}, // ER_BAD_NULL_ERROR
+				&mysql.MySQLError{Number: 1406}, // ER_DATA_TOO_LONG
+				fmt.Errorf("failed to insert, %w", &mysql.MySQLError{Number: 3819}),
+			},
+			otherErrors: []error{
+				&mysql.MySQLError{Number: 1213}, // ER_LOCK_DEADLOCK
+				&mysql.MySQLError{Number: 1146}, // ER_NO_SUCH_TABLE
+				&pq.Error{Code: "23505"},
+				errors.New("connection refused"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tt.dialect
+			assert.Equal(t, tt.placeholders, []string{d.placeholder(1), d.placeholder(2), d.placeholder(10)})
+			assert.Equal(t, tt.quoted, d.quoteIdent("my"+d.quote+"table"))
+			assert.Equal(t, tt.upsert, d.onConflict(d, []string{"id", "ts"}, []string{"name"}))
+			assert.Equal(t, tt.doNothing, d.onConflict(d, []string{"id", "ts"}, nil))
+			for _, err := range tt.dataErrors {
+				assert.True(t, d.isDataError(err), err.Error())
+			}
+			for _, err := range tt.otherErrors {
+				assert.False(t, d.isDataError(err), err.Error())
+			}
+		})
+	}
+}
+
+func TestNewToSQL_BatchSize(t *testing.T) {
+	batchSize := uint32(1000)
+	sink := &dfv1.SQLSink{
+		Table:     "events",
+		Columns:   []dfv1.SQLSinkColumn{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+		BatchSize: &batchSize,
+	}
+	d := *sqliteDialect
+	d.maxParams = 300
+	// up to maxParams parameters in a statement.
+	assert.Equal(t, 100, newToSQL(context.Background(), newTestVertexInstance(), sink, nil, &d).batchSize)
+	d.maxParams = 3000
+	assert.Equal(t, 1000, newToSQL(context.Background(), newTestVertexInstance(), sink, nil, &d).batchSize)
+	// a row has more parameters than a statement allows.
+	d.maxParams = 2
+	assert.Equal(t, 1, newToSQL(context.Background(), newTestVertexInstance(), sink, nil, &d).batchSize)
+}
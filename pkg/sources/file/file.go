@@ -0,0 +1,593 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sources/sourcer"
+)
+
+// HeaderFilePath is the header of the messages which contains the path of the file the line is read from.
+const HeaderFilePath = "x-numaflow-file-path"
+
+type fileSource struct {
+	vertexName    string
+	pipelineName  string
+	vertexReplica int32
+	// pattern is the glob pattern of the files to read.
+	pattern      string
+	follow       bool
+	pollInterval time.Duration
+	readTimeout  time.Duration
+	// store is the KV store of the checkpoints, which are the acknowledged offsets of the files.
+	store kvs.KVStorer
+	// checkpoints are the latest checkpoints of the files, keyed by the file path.
+	checkpoints map[string]checkpoint
+	// missingSince is when the files of the checkpoints were first found missing, keyed by the file path.
+	missingSince map[string]time.Time
+	// lock guards the files.
+	lock sync.Mutex
+	// files are the files being read, keyed by the path.
+	files map[string]*tailedFile
+	// eof is set once all the files have been read to the end, it's only used when the files are not followed.
+	eof      bool
+	lastScan time.Time
+	logger   *zap.SugaredLogger
+}
+
+// tailedFile is a file being read.
+type tailedFile struct {
+	path   string
+	file   *os.File
+	reader *bufio.Reader
+	// partial is the incomplete last line read from the file, which is returned once the line is complete.
+	partial []byte
+	// readOffset is the offset of the next line to read.
+	readOffset int64
+	// ackedOffset is the offset up to which the lines have been acknowledged.
+	ackedOffset int64
+	// generation is the generation of the file at the path, see checkpoint.Generation.
+	generation int64
+}
+
+// fingerprintSize is the max number of the bytes at the head of a file used to fingerprint the file.
+const fingerprintSize = 1024
+
+// checkpointRetention is how long the checkpoint of a file is kept after the file is removed. It's longer than the
+// duplicate window of the inter-step buffer, because a file created at the same path after the checkpoint is pruned
+// starts from generation 0 again, and its message IDs might be the same as the ones of the removed file.
+const checkpointRetention = 10 * time.Minute
+
+// checkpoint is the value stored in the KV store for a file. Besides the acknowledged offset, it records the identity
+// of the file, so that a file replaced or truncated while the source is not running is read from the beginning.
+type checkpoint struct {
+	Path   string `json:"path"`
+	Offset int64  `json:"offset"`
+	// Generation is increased every time the file at the path is replaced or truncated, it's part of the message IDs,
+	// so that the lines of a new file are not deduplicated by the inter-step buffer as the ones of the old file.
+	Generation int64 `json:"generation,omitempty"`
+	// Device and Inode identify the file, they are 0 if not available on the platform.
+	Device uint64 `json:"device,omitempty"`
+	Inode  uint64 `json:"inode,omitempty"`
+	// Fingerprint is the hex encoded SHA-1 of the first FingerprintSize bytes of the file.
+	Fingerprint     string `json:"fingerprint,omitempty"`
+	FingerprintSize int64  `json:"fingerprintSize,omitempty"`
+}
+
+// matches returns true if the opened file is the same file as the checkpoint was taken of.
+func (cp checkpoint) matches(file *os.File) (bool, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return false, fmt.Errorf("failed to stat file %q, %w", cp.Path, err)
+	}
+	if dev, ino, ok := fileInode(info); ok && cp.Inode != 0 && (dev != cp.Device || ino != cp.Inode) {
+		return false, nil
+	}
+	if info.Size() < cp.Offset || info.Size() < cp.FingerprintSize {
+		return false, nil
+	}
+	if cp.FingerprintSize == 0 {
+		return true, nil
+	}
+	fp, err := fingerprint(file, cp.FingerprintSize)
+	if err != nil {
+		return false, err
+	}
+	return fp == cp.Fingerprint, nil
+}
+
+// fingerprint returns the hex encoded SHA-1 of the first size bytes of the file.
+func fingerprint(file *os.File, size int64) (string, error) {
+	buf := make([]byte, size)
+	if _, err := file.ReadAt(buf, 0); err != nil {
+		return "", fmt.Errorf("failed to read the head of file %q, %w", file.Name(), err)
+	}
+	h := sha1.Sum(buf)
+	return hex.EncodeToString(h[:]), nil
+}
+
+func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, store kvs.KVStorer, opts ...Option) (sourcer.SourceReader, error) {
+	source := vertexInstance.Vertex.Spec.Source.File
+	fs := &fileSource{
+		vertexName:    vertexInstance.Vertex.Spec.Name,
+		pipelineName:  vertexInstance.Vertex.Spec.PipelineName,
+		vertexReplica: vertexInstance.Replica,
+		pattern:       source.Path,
+		follow:        source.GetFollow(),
+		pollInterval:  source.GetPollInterval(),
+		readTimeout:   1 * time.Second, // default timeout
+		store:         store,
+		checkpoints:   make(map[string]checkpoint),
+		missingSince:  make(map[string]time.Time),
+		files:         make(map[string]*tailedFile),
+		logger:        logging.FromContext(ctx),
+	}
+	for _, o := range opts {
+		if err := o(fs); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := filepath.Match(fs.pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid path %q, %w", fs.pattern, err)
+	}
+	if err := fs.loadCheckpoints(ctx); err != nil {
+		return nil, err
+	}
+	if err := fs.scan(ctx); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+type Option func(*fileSource) error
+
+// WithLogger is used to return logger information
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(o *fileSource) error {
+		o.logger = l
+		return nil
+	}
+}
+
+// WithReadTimeout sets the read timeout
+func WithReadTimeout(t time.Duration) Option {
+	return func(o *fileSource) error {
+		o.readTimeout = t
+		return nil
+	}
+}
+
+// checkpointKey returns the key of the checkpoint of a file, the path is hashed because the KV keys can not
+// contain all the characters of a path.
+func checkpointKey(path string) string {
+	h := sha1.Sum([]byte(path))
+	return hex.EncodeToString(h[:])
+}
+
+// loadCheckpoints loads the acknowledged offsets of the files from the store.
+func (fs *fileSource) loadCheckpoints(ctx context.Context) error {
+	keys, err := fs.store.GetAllKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list the checkpoints, %w", err)
+	}
+	for _, key := range keys {
+		value, err := fs.store.GetValue(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to get the checkpoint %q, %w", key, err)
+		}
+		var cp checkpoint
+		if err := json.Unmarshal(value, &cp); err != nil {
+			return fmt.Errorf("failed to unmarshal the checkpoint %q, %w", key, err)
+		}
+		fs.checkpoints[cp.Path] = cp
+	}
+	fs.logger.Infow("Loaded the checkpoints", zap.Int("count", len(fs.checkpoints)))
+	return nil
+}
+
+// scan looks for the files matching the pattern, it opens the new files from their checkpoints, and closes the
+// files which have been removed.
+func (fs *fileSource) scan(ctx context.Context) error {
+	paths, err := filepath.Glob(fs.pattern)
+	if err != nil {
+		return fmt.Errorf("failed to list the files matching %q, %w", fs.pattern, err)
+	}
+	fs.lastScan = time.Now()
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	matched := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		matched[path] = true
+		if f, ok := fs.files[path]; ok {
+			rotated, err := f.rotated(info)
+			if err != nil {
+				return err
+			}
+			if !rotated {
+				continue
+			}
+			fs.logger.Infow("File is truncated or replaced, reading it from the beginning", zap.String("path", path))
+			_ = f.file.Close()
+			delete(fs.files, path)
+			f, err = openTailedFile(path, 0, f.generation+1)
+			if err != nil {
+				return err
+			}
+			fs.files[path] = f
+			if err := fs.putCheckpoint(ctx, f, 0); err != nil {
+				return err
+			}
+			continue
+		}
+		f, err := fs.openFromCheckpoint(ctx, path)
+		if err != nil {
+			return err
+		}
+		fs.logger.Infow("Start reading a file", zap.String("path", path), zap.Int64("offset", f.readOffset), zap.Int64("generation", f.generation))
+		fs.files[path] = f
+	}
+	for path, f := range fs.files {
+		if !matched[path] {
+			fs.logger.Infow("Stop reading a file which no longer exists", zap.String("path", path))
+			_ = f.file.Close()
+			delete(fs.files, path)
+		}
+	}
+	return fs.pruneCheckpoints(ctx, matched)
+}
+
+// pruneCheckpoints deletes the checkpoints of the files which have been missing for checkpointRetention.
+func (fs *fileSource) pruneCheckpoints(ctx context.Context, matched map[string]bool) error {
+	for path := range fs.missingSince {
+		if matched[path] {
+			delete(fs.missingSince, path)
+		}
+	}
+	for path := range fs.checkpoints {
+		if matched[path] {
+			continue
+		}
+		since, ok := fs.missingSince[path]
+		if !ok {
+			fs.missingSince[path] = fs.lastScan
+			continue
+		}
+		if fs.lastScan.Sub(since) < checkpointRetention {
+			continue
+		}
+		if err := fs.store.DeleteKey(ctx, checkpointKey(path)); err != nil {
+			return fmt.Errorf("failed to delete the checkpoint of file %q, %w", path, err)
+		}
+		fs.logger.Infow("Pruned the checkpoint of a removed file", zap.String("path", path))
+		delete(fs.checkpoints, path)
+		delete(fs.missingSince, path)
+	}
+	return nil
+}
+
+// openFromCheckpoint opens the file from the offset of its checkpoint, or from the beginning if there is no
+// checkpoint, or the file is not the same as the checkpoint was taken of.
+func (fs *fileSource) openFromCheckpoint(ctx context.Context, path string) (*tailedFile, error) {
+	cp, ok := fs.checkpoints[path]
+	if !ok {
+		return openTailedFile(path, 0, 0)
+	}
+	f, err := openTailedFile(path, 0, cp.Generation)
+	if err != nil {
+		return nil, err
+	}
+	same, err := cp.matches(f.file)
+	if err != nil {
+		_ = f.file.Close()
+		return nil, err
+	}
+	if same {
+		if err := f.seek(cp.Offset); err != nil {
+			_ = f.file.Close()
+			return nil, err
+		}
+		return f, nil
+	}
+	fs.logger.Infow("File is truncated or replaced since the last checkpoint, reading it from the beginning", zap.String("path", path))
+	f.generation++
+	if err := fs.putCheckpoint(ctx, f, 0); err != nil {
+		_ = f.file.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+func openTailedFile(path string, offset, generation int64) (*tailedFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %q, %w", path, err)
+	}
+	f := &tailedFile{
+		path:       path,
+		file:       file,
+		generation: generation,
+	}
+	if err := f.seek(offset); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// seek moves the file to the offset to start reading from.
+func (f *tailedFile) seek(offset int64) error {
+	if _, err := f.file.Seek(offset, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to seek file %q to %d, %w", f.path, offset, err)
+	}
+	f.reader = bufio.NewReader(f.file)
+	f.partial = nil
+	f.readOffset = offset
+	f.ackedOffset = offset
+	return nil
+}
+
+// rotated returns true if the file has been truncated, or replaced by a new file.
+func (f *tailedFile) rotated(info os.FileInfo) (bool, error) {
+	current, err := f.file.Stat()
+	if err != nil {
+		return false, fmt.Errorf("failed to stat file %q, %w", f.path, err)
+	}
+	return !os.SameFile(current, info) || info.Size() < f.readOffset+int64(len(f.partial)), nil
+}
+
+// readLine returns the next complete line of the file without the line ending, and the offsets of the line.
+// The incomplete last line is only returned if the file is not followed.
+func (f *tailedFile) readLine(follow bool) (line []byte, start, end int64, ok bool, err error) {
+	data, err := f.reader.ReadBytes('\n')
+	f.partial = append(f.partial, data...)
+	if err != nil {
+		if !errors.Is(err, io.EOF) {
+			return nil, 0, 0, false, fmt.Errorf("failed to read file %q, %w", f.path, err)
+		}
+		if follow || len(f.partial) == 0 {
+			return nil, 0, 0, false, nil
+		}
+	}
+	line = f.partial
+	start = f.readOffset
+	end = start + int64(len(line))
+	f.partial = nil
+	f.readOffset = end
+	return bytes.TrimRight(line, "\r\n"), start, end, true, nil
+}
+
+// putCheckpoint stores the acknowledged offset of a file, together with the identity of the file.
+func (fs *fileSource) putCheckpoint(ctx context.Context, f *tailedFile, offset int64) error {
+	cp := checkpoint{Path: f.path, Offset: offset, Generation: f.generation}
+	info, err := f.file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat file %q, %w", f.path, err)
+	}
+	if dev, ino, ok := fileInode(info); ok {
+		cp.Device, cp.Inode = dev, ino
+	}
+	// only the acknowledged bytes are fingerprinted, because they can't be changed without truncating the file.
+	if cp.FingerprintSize = min(offset, fingerprintSize); cp.FingerprintSize > 0 {
+		if prev, ok := fs.checkpoints[f.path]; ok && prev.Generation == cp.Generation && prev.FingerprintSize == cp.FingerprintSize {
+			cp.Fingerprint = prev.Fingerprint
+		} else if cp.Fingerprint, err = fingerprint(f.file, cp.FingerprintSize); errors.Is(err, io.EOF) {
+			// the file is truncated after the lines were read, the next scan reads it from the beginning.
+			cp.Fingerprint, cp.FingerprintSize = "", 0
+		} else if err != nil {
+			return err
+		}
+	}
+	value, err := json.Marshal(cp)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the checkpoint of file %q, %w", f.path, err)
+	}
+	if err := fs.store.PutKV(ctx, checkpointKey(f.path), value); err != nil {
+		return fmt.Errorf("failed to checkpoint file %q at %d, %w", f.path, offset, err)
+	}
+	fs.checkpoints[f.path] = cp
+	return nil
+}
+
+func (fs *fileSource) GetName() string {
+	return fs.vertexName
+}
+
+// Partitions returns the partitions associated with this source.
+func (fs *fileSource) Partitions(context.Context) []int32 {
+	return []int32{fs.vertexReplica}
+}
+
+// Read reads up to count lines from the files, it waits for the poll interval or the read timeout, whichever is
+// shorter, when there is no new line.
+func (fs *fileSource) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	if fs.follow && time.Since(fs.lastScan) >= fs.pollInterval {
+		if err := fs.scan(ctx); err != nil {
+			return nil, err
+		}
+	}
+	msgs, err := fs.readLines(count)
+	if err != nil || len(msgs) > 0 {
+		return msgs, err
+	}
+	wait := fs.pollInterval
+	if fs.readTimeout > 0 && fs.readTimeout < wait {
+		wait = fs.readTimeout
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(wait):
+	}
+	return nil, nil
+}
+
+func (fs *fileSource) readLines(count int64) ([]*isb.ReadMessage, error) {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	paths := make([]string, 0, len(fs.files))
+	for path := range fs.files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	var msgs []*isb.ReadMessage
+	for _, path := range paths {
+		f := fs.files[path]
+		for int64(len(msgs)) < count {
+			line, start, end, ok, err := f.readLine(fs.follow)
+			if err != nil {
+				return msgs, err
+			}
+			if !ok {
+				break
+			}
+			msgs = append(msgs, fs.newReadMessage(f, line, start, end))
+		}
+	}
+	if !fs.follow && len(msgs) == 0 {
+		fs.eof = true
+	}
+	fileSourceReadCount.With(map[string]string{metrics.LabelVertex: fs.vertexName, metrics.LabelPipeline: fs.pipelineName}).Add(float64(len(msgs)))
+	return msgs, nil
+}
+
+func (fs *fileSource) newReadMessage(f *tailedFile, line []byte, start, end int64) *isb.ReadMessage {
+	path := f.path
+	readOffset := &fileOffset{path: path, offset: end, partitionIdx: fs.vertexReplica, file: f}
+	return &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: time.Now()},
+				ID: isb.MessageID{
+					VertexName: fs.vertexName,
+					// the start offset is used, so that the ID is unique for the empty lines, and the generation is used,
+					// so that the ID is unique after the file is truncated or replaced.
+					Offset: fmt.Sprintf("%s:%d:%d", path, f.generation, start),
+					Index:  readOffset.PartitionIdx(),
+				},
+				Headers: map[string]string{HeaderFilePath: path},
+			},
+			Body: isb.Body{
+				Payload: line,
+			},
+		},
+		ReadOffset: readOffset,
+	}
+}
+
+// IsExhausted returns true if the files are not followed, and all of them have been read to the end and acknowledged.
+func (fs *fileSource) IsExhausted() bool {
+	if fs.follow {
+		return false
+	}
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	if !fs.eof {
+		return false
+	}
+	for _, f := range fs.files {
+		if f.ackedOffset < f.readOffset {
+			return false
+		}
+	}
+	return true
+}
+
+// Pending returns the number of bytes of the files which have not been acknowledged.
+func (fs *fileSource) Pending(_ context.Context) (int64, error) {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	var pending int64
+	for _, f := range fs.files {
+		info, err := f.file.Stat()
+		if err != nil {
+			return isb.PendingNotAvailable, fmt.Errorf("failed to stat file %q, %w", f.path, err)
+		}
+		if info.Size() > f.ackedOffset {
+			pending += info.Size() - f.ackedOffset
+		}
+	}
+	fileSourcePendingBytes.With(map[string]string{metrics.LabelVertex: fs.vertexName, metrics.LabelPipeline: fs.pipelineName}).Set(float64(pending))
+	return pending, nil
+}
+
+// Ack checkpoints the largest acknowledged offset of each file in the KV store.
+func (fs *fileSource) Ack(ctx context.Context, offsets []isb.Offset) []error {
+	errs := make([]error, len(offsets))
+	latest := make(map[string]int64)
+	latestFiles := make(map[string]*tailedFile)
+	for _, o := range offsets {
+		offset := o.(*fileOffset)
+		if offset.offset > latest[offset.path] {
+			latest[offset.path] = offset.offset
+			latestFiles[offset.path] = offset.file
+		}
+	}
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	failed := make(map[string]error)
+	for path, offset := range latest {
+		f := latestFiles[path]
+		if fs.files[path] != f || offset <= f.ackedOffset {
+			// the file has been removed or rotated after the lines were read.
+			continue
+		}
+		if err := fs.putCheckpoint(ctx, f, offset); err != nil {
+			failed[path] = err
+			continue
+		}
+		f.ackedOffset = offset
+	}
+	for i, o := range offsets {
+		errs[i] = failed[o.(*fileOffset).path]
+	}
+	return errs
+}
+
+func (fs *fileSource) Close() error {
+	fs.logger.Info("Shutting down file source...")
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	for _, f := range fs.files {
+		_ = f.file.Close()
+	}
+	fs.logger.Info("File source shutdown")
+	return nil
+}
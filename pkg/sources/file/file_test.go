@@ -0,0 +1,312 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/kvs/inmem"
+	"github.com/numaproj/numaflow/pkg/sources/sourcer"
+)
+
+func newTestVertexInstance(source *dfv1.FileSource) *dfv1.VertexInstance {
+	return &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pl-in", Namespace: "test-ns"},
+			Spec: dfv1.VertexSpec{
+				PipelineName: "test-pl",
+				AbstractVertex: dfv1.AbstractVertex{
+					Name:   "in",
+					Source: &dfv1.Source{File: source},
+				},
+			},
+		},
+		Replica: 0,
+	}
+}
+
+func newTestStore(t *testing.T, ctx context.Context) kvs.KVStorer {
+	store, err := inmem.NewKVInMemKVStore(ctx, "test-checkpoint")
+	require.NoError(t, err)
+	return store
+}
+
+func writeFile(t *testing.T, path, content string) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(content)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func payloads(msgs []*isb.ReadMessage) []string {
+	var result []string
+	for _, m := range msgs {
+		result = append(result, string(m.Payload))
+	}
+	return result
+}
+
+func offsets(msgs []*isb.ReadMessage) []isb.Offset {
+	var result []isb.Offset
+	for _, m := range msgs {
+		result = append(result, m.ReadOffset)
+	}
+	return result
+}
+
+func TestFileSource_ReadAndResume(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a.log")
+	writeFile(t, path, "line1\nline2\r\nline3\npartial")
+	store := newTestStore(t, ctx)
+	source := &dfv1.FileSource{Path: filepath.Join(dir, "*.log"), PollInterval: &metav1.Duration{Duration: 10 * time.Millisecond}}
+
+	fs, err := New(ctx, newTestVertexInstance(source), store, WithReadTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+	msgs, err := fs.Read(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line1", "line2"}, payloads(msgs))
+	assert.Equal(t, path, msgs[0].Headers[HeaderFilePath])
+	assert.Equal(t, path+":0:0", msgs[0].ID.Offset)
+	assert.Equal(t, []int32{0}, fs.Partitions(ctx))
+	for _, err := range fs.Ack(ctx, offsets(msgs)) {
+		assert.NoError(t, err)
+	}
+	pending, err := fs.Pending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("line3\npartial")), pending)
+
+	// the partial line is not read until it's complete.
+	msgs, err = fs.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line3"}, payloads(msgs))
+	writeFile(t, path, "\n")
+	msgs, err = fs.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"partial"}, payloads(msgs))
+	require.NoError(t, fs.Close())
+
+	// line3 and partial are not acknowledged, they are read again after restarting.
+	fs, err = New(ctx, newTestVertexInstance(source), store, WithReadTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+	msgs, err = fs.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line3", "partial"}, payloads(msgs))
+	for _, err := range fs.Ack(ctx, offsets(msgs)) {
+		assert.NoError(t, err)
+	}
+	pending, err = fs.Pending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), pending)
+
+	// new files matching the path are picked up.
+	writeFile(t, filepath.Join(dir, "b.log"), "b1\n")
+	writeFile(t, filepath.Join(dir, "c.txt"), "c1\n")
+	time.Sleep(20 * time.Millisecond)
+	msgs, err = fs.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b1"}, payloads(msgs))
+	require.NoError(t, fs.Close())
+}
+
+func TestFileSource_Truncated(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a.log")
+	writeFile(t, path, "line1\nline2\n")
+	store := newTestStore(t, ctx)
+	source := &dfv1.FileSource{Path: path, PollInterval: &metav1.Duration{Duration: 10 * time.Millisecond}}
+
+	fs, err := New(ctx, newTestVertexInstance(source), store, WithReadTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+	msgs, err := fs.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line1", "line2"}, payloads(msgs))
+	require.NoError(t, os.Truncate(path, 0))
+	writeFile(t, path, "new1\n")
+	// the acknowledgement of the lines of the file before the truncation is ignored.
+	for _, err := range fs.Ack(ctx, offsets(msgs)) {
+		assert.NoError(t, err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	msgs, err = fs.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"new1"}, payloads(msgs))
+	// the lines of the truncated file get new IDs.
+	assert.Equal(t, path+":1:0", msgs[0].ID.Offset)
+	for _, err := range fs.Ack(ctx, offsets(msgs)) {
+		assert.NoError(t, err)
+	}
+	require.NoError(t, fs.Close())
+
+	// the checkpoint is larger than the file after restarting.
+	require.NoError(t, os.Truncate(path, 0))
+	writeFile(t, path, "x\n")
+	fs, err = New(ctx, newTestVertexInstance(source), store, WithReadTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+	msgs, err = fs.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"x"}, payloads(msgs))
+	assert.Equal(t, path+":2:0", msgs[0].ID.Offset)
+	require.NoError(t, fs.Close())
+}
+
+func TestFileSource_ReplacedWhileStopped(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a.log")
+	writeFile(t, path, "line1\nline2\n")
+	store := newTestStore(t, ctx)
+	source := &dfv1.FileSource{Path: path, PollInterval: &metav1.Duration{Duration: 10 * time.Millisecond}}
+
+	fs, err := New(ctx, newTestVertexInstance(source), store, WithReadTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+	msgs, err := fs.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line1", "line2"}, payloads(msgs))
+	for _, err := range fs.Ack(ctx, offsets(msgs)) {
+		assert.NoError(t, err)
+	}
+	require.NoError(t, fs.Close())
+
+	// the file is replaced by a larger file with different content, which is not detected by the size.
+	newPath := filepath.Join(dir, "a.log.new")
+	writeFile(t, newPath, "other1\nother2\nother3\n")
+	require.NoError(t, os.Rename(newPath, path))
+
+	fs, err = New(ctx, newTestVertexInstance(source), store, WithReadTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+	msgs, err = fs.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"other1", "other2", "other3"}, payloads(msgs))
+	assert.Equal(t, path+":1:0", msgs[0].ID.Offset)
+	for _, err := range fs.Ack(ctx, offsets(msgs)) {
+		assert.NoError(t, err)
+	}
+	require.NoError(t, fs.Close())
+
+	// the same file resumes from the checkpoint.
+	writeFile(t, path, "other4\n")
+	fs, err = New(ctx, newTestVertexInstance(source), store, WithReadTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+	msgs, err = fs.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"other4"}, payloads(msgs))
+	assert.Equal(t, path+":1:21", msgs[0].ID.Offset)
+	require.NoError(t, fs.Close())
+}
+
+func TestFileSource_PruneCheckpoints(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	dir := t.TempDir()
+	pathA, pathB := filepath.Join(dir, "a.log"), filepath.Join(dir, "b.log")
+	writeFile(t, pathA, "a1\n")
+	writeFile(t, pathB, "b1\n")
+	store := newTestStore(t, ctx)
+	source := &dfv1.FileSource{Path: filepath.Join(dir, "*.log"), PollInterval: &metav1.Duration{Duration: 10 * time.Millisecond}}
+
+	r, err := New(ctx, newTestVertexInstance(source), store, WithReadTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+	fs := r.(*fileSource)
+	defer func() { _ = fs.Close() }()
+	msgs, err := fs.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a1", "b1"}, payloads(msgs))
+	for _, err := range fs.Ack(ctx, offsets(msgs)) {
+		assert.NoError(t, err)
+	}
+	keys, err := store.GetAllKeys(ctx)
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	// the checkpoint of a removed file is kept until the retention is over.
+	require.NoError(t, os.Remove(pathB))
+	require.NoError(t, fs.scan(ctx))
+	assert.Contains(t, fs.checkpoints, pathB)
+	assert.Contains(t, fs.missingSince, pathB)
+	fs.missingSince[pathB] = fs.missingSince[pathB].Add(-checkpointRetention)
+	require.NoError(t, fs.scan(ctx))
+	assert.NotContains(t, fs.checkpoints, pathB)
+	assert.NotContains(t, fs.missingSince, pathB)
+	keys, err = store.GetAllKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{checkpointKey(pathA)}, keys)
+
+	// a file back before the retention is over keeps its checkpoint.
+	require.NoError(t, os.Remove(pathA))
+	require.NoError(t, fs.scan(ctx))
+	assert.Contains(t, fs.missingSince, pathA)
+	writeFile(t, pathA, "a1\n")
+	require.NoError(t, fs.scan(ctx))
+	assert.NotContains(t, fs.missingSince, pathA)
+	assert.Contains(t, fs.checkpoints, pathA)
+}
+
+func TestFileSource_NoFollow(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a.log"), "a1\na2")
+	source := &dfv1.FileSource{Path: filepath.Join(dir, "*.log"), Follow: ptr.To[bool](false), PollInterval: &metav1.Duration{Duration: 10 * time.Millisecond}}
+
+	fs, err := New(ctx, newTestVertexInstance(source), newTestStore(t, ctx), WithReadTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+	writeFile(t, filepath.Join(dir, "b.log"), "b1\n")
+	time.Sleep(20 * time.Millisecond)
+	msgs, err := fs.Read(ctx, 10)
+	require.NoError(t, err)
+	// the incomplete last line is read, and the files created after the startup are not.
+	assert.Equal(t, []string{"a1", "a2"}, payloads(msgs))
+	bounded := fs.(sourcer.BoundedSourceReader)
+	// not exhausted before reaching the end of the files.
+	assert.False(t, bounded.IsExhausted())
+	empty, err := fs.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+	// not exhausted before the lines are acknowledged.
+	assert.False(t, bounded.IsExhausted())
+	for _, err := range fs.Ack(ctx, offsets(msgs)) {
+		require.NoError(t, err)
+	}
+	assert.True(t, bounded.IsExhausted())
+	require.NoError(t, fs.Close())
+}
+
+func TestNew_InvalidPath(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := New(ctx, newTestVertexInstance(&dfv1.FileSource{Path: "/tmp/[a"}), newTestStore(t, ctx))
+	assert.Error(t, err)
+}
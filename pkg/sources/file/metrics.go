@@ -0,0 +1,38 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// fileSourceReadCount is used to indicate the number of lines read by the file source vertex
+var fileSourceReadCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "file_source",
+	Name:      "read_total",
+	Help:      "Total number of lines read",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// fileSourcePendingBytes is used to indicate the number of bytes of the files which are not acknowledged yet
+var fileSourcePendingBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "file_source",
+	Name:      "pending_bytes",
+	Help:      "Number of bytes of the files not acknowledged yet",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import "fmt"
+
+// fileOffset implements isb.Offset, it's the position in the file right after the line.
+type fileOffset struct {
+	path         string
+	offset       int64
+	partitionIdx int32
+	// file is the file the line is read from, it tells if the file has been rotated when the offset is acknowledged.
+	file *tailedFile
+}
+
+func (f *fileOffset) String() string {
+	return fmt.Sprintf("%s:%d", f.path, f.offset)
+}
+
+func (f *fileOffset) Sequence() (int64, error) {
+	return f.offset, nil
+}
+
+// AckIt acking is taken care by the source, which checkpoints the offsets.
+func (f *fileOffset) AckIt() error {
+	// NOOP
+	return nil
+}
+
+func (f *fileOffset) NoAck() error {
+	return nil
+}
+
+func (f *fileOffset) PartitionIdx() int32 {
+	return f.partitionIdx
+}
+
+func (f *fileOffset) Path() string {
+	return f.path
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	jetstreamkv "github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
+	rediskv "github.com/numaproj/numaflow/pkg/shared/kvs/redis"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+)
+
+// BuildJetStreamCheckpointStore returns the JetStream KV store of the checkpoints of the source vertex. The KV is
+// created if it doesn't exist, with the same replicas as the offset timeline KV of the vertex.
+func BuildJetStreamCheckpointStore(ctx context.Context, vertex *dfv1.Vertex, client *jsclient.Client) (kvs.KVStorer, error) {
+	bucket := dfv1.GenerateSourceBucketName(vertex.Namespace, vertex.Spec.PipelineName, vertex.Spec.Name)
+	kvName := isbsvc.JetStreamSourceCheckpointKVName(bucket)
+	js, err := client.JetStreamContext()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a js context from nats connection, %w", err)
+	}
+	if _, err := js.KeyValue(kvName); err != nil {
+		if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return nil, fmt.Errorf("failed to query information of bucket %q, %w", kvName, err)
+		}
+		replicas := 1
+		if otKV, err := js.KeyValue(store.JetStreamOTKVName(bucket)); err == nil {
+			if status, err := otKV.Status(); err == nil {
+				if s, ok := status.(*nats.KeyValueBucketStatus); ok {
+					replicas = s.StreamInfo().Config.Replicas
+				}
+			}
+		}
+		if _, err := js.CreateKeyValue(&nats.KeyValueConfig{
+			Bucket:   kvName,
+			History:  1,
+			Storage:  nats.FileStorage,
+			Replicas: replicas,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to create checkpoint KV %q, %w", kvName, err)
+		}
+	}
+	return jetstreamkv.NewKVJetStreamKVStore(ctx, kvName, client)
+}
+
+// BuildRedisCheckpointStore returns the Redis KV store of the checkpoints of the source vertex.
+func BuildRedisCheckpointStore(ctx context.Context, vertex *dfv1.Vertex, client *redisclient.RedisClient) (kvs.KVStorer, error) {
+	bucket := dfv1.GenerateSourceBucketName(vertex.Namespace, vertex.Spec.PipelineName, vertex.Spec.Name)
+	return rediskv.NewKVRedisStore(ctx, isbsvc.RedisSourceCheckpointKVName(bucket), client)
+}
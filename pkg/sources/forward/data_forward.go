@@ -32,6 +32,8 @@ import (
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/ratelimit"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
 	"github.com/numaproj/numaflow/pkg/watermark/entity"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
@@ -61,6 +63,7 @@ type DataForward struct {
 	watermarkConfig      dfv1.Watermark
 	idleManager          wmb.IdleManager // idleManager manages the idle watermark status.
 	srcIdleHandler       *idlehandler.SourceIdleHandler
+	exhausted            bool // exhausted is set once the final watermark is published.
 	Shutdown
 }
 
@@ -193,7 +196,7 @@ func (df *DataForward) forwardAChunk(ctx context.Context) {
 	// There is a chance that we have read the message and the container got forcefully terminated before processing. To provide
 	// at-least-once semantics for reading, during the restart we will have to reprocess all unacknowledged messages. It is the
 	// responsibility of the Read function to do that.
-	readMessages, err := df.reader.Read(ctx, df.opts.readBatchSize)
+	readMessages, err := ratelimit.ReadWithLimit(ctx, df.opts.rateLimiter, df.opts.readBatchSize, df.reader.Read)
 	if err != nil {
 		df.opts.logger.Warnw("failed to read from source", zap.Error(err))
 		metrics.ReadMessagesError.With(map[string]string{
@@ -207,6 +210,19 @@ func (df *DataForward) forwardAChunk(ctx context.Context) {
 
 	// if there are no read messages, we return early.
 	if len(readMessages) == 0 {
+		// the source may still report the watermarks of its partitions, e.g. for the idle ones.
+		if wmReader, ok := df.reader.(sourcer.WatermarkedSourceReader); ok {
+			if watermarks := wmReader.Watermarks(); len(watermarks) > 0 {
+				df.srcWMPublisher.PublishWatermarks(watermarks)
+			}
+		}
+
+		// the source is exhausted, publish the final watermark instead of the idle watermark.
+		if df.isExhausted() {
+			df.publishFinalWatermark(ctx)
+			return
+		}
+
 		// not idling, so nothing much to do
 		if !df.srcIdleHandler.IsSourceIdling() {
 			return
@@ -370,7 +386,7 @@ func (df *DataForward) forwardAChunk(ctx context.Context) {
 	}
 
 	// publish source watermark
-	df.srcWMPublisher.PublishSourceWatermarks(transformedReadMessages)
+	df.publishSourceWatermarks(transformedReadMessages)
 	// update the watermark configs for lastTimestampSrcWMUpdated, lastFetchedSrcWatermark and lastTimestampIdleWMFound.
 	// fetch the source watermark again, we might not get the latest watermark because of publishing delay,
 	// but ideally we should use the latest to determine the IsLate attribute.
@@ -511,6 +527,63 @@ func (df *DataForward) forwardAChunk(ctx context.Context) {
 	}).Observe(float64(time.Since(start).Microseconds()))
 }
 
+// isExhausted returns true if the source reader is bounded, and all of its input has been read and acknowledged.
+func (df *DataForward) isExhausted() bool {
+	reader, ok := df.reader.(sourcer.BoundedSourceReader)
+	return ok && reader.IsExhausted()
+}
+
+// publishSourceWatermarks publishes the source watermarks inferred from the event times of the messages, except for
+// the partitions whose watermarks are reported by the source itself, which are published as they are.
+func (df *DataForward) publishSourceWatermarks(readMessages []*isb.ReadMessage) {
+	wmReader, ok := df.reader.(sourcer.WatermarkedSourceReader)
+	if !ok {
+		df.srcWMPublisher.PublishSourceWatermarks(readMessages)
+		return
+	}
+	watermarks := wmReader.Watermarks()
+	if len(watermarks) == 0 {
+		df.srcWMPublisher.PublishSourceWatermarks(readMessages)
+		return
+	}
+	var inferred []*isb.ReadMessage
+	for _, m := range readMessages {
+		if _, ok := watermarks[m.ReadOffset.PartitionIdx()]; !ok {
+			inferred = append(inferred, m)
+		}
+	}
+	df.srcWMPublisher.PublishSourceWatermarks(inferred)
+	df.srcWMPublisher.PublishWatermarks(watermarks)
+}
+
+// publishFinalWatermark publishes the final watermark to the source and all the toBuffers, so that the downstream
+// vertices close all the windows. Publishing the same watermark again is a no-op, so it's called until the forwarder stops.
+func (df *DataForward) publishFinalWatermark(ctx context.Context) {
+	if !df.exhausted {
+		df.opts.logger.Infow("Source is exhausted, publishing the final watermark", zap.String("sourceFrom", df.reader.GetName()))
+		df.exhausted = true
+	}
+	partitions := df.reader.Partitions(df.ctx)
+	df.srcWMPublisher.PublishIdleWatermarks(time.Time(wmb.FinalWatermark), partitions)
+	for toVertexName, toVertexBuffers := range df.toBuffers {
+		vertexPublishers, ok := df.toVertexWMPublishers[toVertexName]
+		if !ok {
+			continue
+		}
+		for _, toBuffer := range toVertexBuffers {
+			for _, sp := range partitions {
+				var publisher, ok = vertexPublishers[sp]
+				if !ok {
+					publisher = df.createToVertexWatermarkPublisher(toVertexName, sp)
+					vertexPublishers[sp] = publisher
+				}
+				idlehandler.PublishIdleWatermark(ctx, wmb.PARTITION_0, toBuffer, publisher, df.idleManager,
+					df.opts.logger, df.vertexName, df.pipelineName, dfv1.VertexTypeSource, df.vertexReplica, wmb.FinalWatermark)
+			}
+		}
+	}
+}
+
 func (df *DataForward) ackFromSource(ctx context.Context, offsets []isb.Offset) error {
 	// for all the sources, we either ack all offsets or none.
 	// when a batch ack fails, the source Ack() function populate the error array with the same error;
@@ -672,6 +745,16 @@ func (df *DataForward) concurrentApplyTransformer(ctx context.Context, readMessa
 			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)),
 			metrics.LabelPartitionName:      df.reader.GetName(),
 		}).Add(float64(len(writeMessages)))
+		for _, m := range writeMessages {
+			if sharedutil.StringSliceContains(m.Tags, dfv1.MessageTagConversionFailed) {
+				metrics.SourceTransformerConversionError.With(map[string]string{
+					metrics.LabelVertex:             df.vertexName,
+					metrics.LabelPipeline:           df.pipelineName,
+					metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)),
+					metrics.LabelPartitionName:      df.reader.GetName(),
+				}).Inc()
+			}
+		}
 
 		message.WriteMessages = append(message.WriteMessages, writeMessages...)
 		message.Err = err
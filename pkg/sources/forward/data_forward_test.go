@@ -900,6 +900,10 @@ func (p TestSourceWatermarkPublisher) PublishSourceWatermarks([]*isb.ReadMessage
 	// PublishSourceWatermarks is not tested in data_forwarder_test.go
 }
 
+func (p TestSourceWatermarkPublisher) PublishWatermarks(map[int32]time.Time) {
+	// PublishWatermarks is not tested in data_forwarder_test.go
+}
+
 func TestDataForwardSinglePartition(t *testing.T) {
 	fromStep := NewSimpleSource(simplebuffer.NewInMemoryBuffer("from", 25, 0))
 	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
@@ -954,6 +958,233 @@ func TestDataForwardSinglePartition(t *testing.T) {
 	<-stopped
 }
 
+// exhaustedSource is a SimpleSource which is always exhausted.
+type exhaustedSource struct {
+	*SimpleSource
+}
+
+func (s *exhaustedSource) IsExhausted() bool {
+	return true
+}
+
+// recordingSourceWatermarkPublisher records the idle watermarks published to the source.
+type recordingSourceWatermarkPublisher struct {
+	TestSourceWatermarkPublisher
+	lock   sync.Mutex
+	idleWM time.Time
+}
+
+func (p *recordingSourceWatermarkPublisher) PublishIdleWatermarks(wm time.Time, _ []int32) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.idleWM = wm
+}
+
+func (p *recordingSourceWatermarkPublisher) getIdleWatermark() time.Time {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.idleWM
+}
+
+func TestDataForwardExhaustedSource(t *testing.T) {
+	fromStep := &exhaustedSource{NewSimpleSource(simplebuffer.NewInMemoryBuffer("from", 25, 0))}
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "receivingVertex",
+		},
+	}}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex:  vertex,
+		Replica: 0,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	toVertexStores := buildToVertexWatermarkStores(toSteps)
+	srcPublisher := &recordingSourceWatermarkPublisher{}
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+	f, err := NewDataForward(vertexInstance, fromStep, toSteps, mySourceForwardTest{}, &testForwardFetcher{}, srcPublisher, toVertexStores, idleManager, WithReadBatchSize(5))
+	assert.NoError(t, err)
+	stopped := f.Start()
+
+	// the final watermark is published to the source and the toBuffer, along with a ctrl message.
+	for {
+		otKeys, _ := toVertexStores["to1"].OffsetTimelineStore().GetAllKeys(ctx)
+		if len(otKeys) > 0 {
+			otValue, _ := toVertexStores["to1"].OffsetTimelineStore().GetValue(ctx, otKeys[0])
+			otDecode, _ := wmb.DecodeToWMB(otValue)
+			if otDecode.Watermark == wmb.FinalWatermark.UnixMilli() {
+				assert.True(t, otDecode.Idle)
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal("expected the final watermark to be published", ctx.Err())
+		default:
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	assert.True(t, wmb.Watermark(srcPublisher.getIdleWatermark()).IsFinal())
+	readMessages, err := to1.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 1)
+	assert.Equal(t, isb.WMB, readMessages[0].Kind)
+
+	f.Stop()
+	<-stopped
+}
+
+// watermarkedSource is a source which reports the watermark of its partition.
+type watermarkedSource struct {
+	*SimpleSource
+	watermark time.Time
+}
+
+func (s *watermarkedSource) Watermarks() map[int32]time.Time {
+	return map[int32]time.Time{0: s.watermark}
+}
+
+// watermarksRecordingPublisher records the source watermarks published.
+type watermarksRecordingPublisher struct {
+	TestSourceWatermarkPublisher
+	lock       sync.Mutex
+	inferred   int
+	watermarks map[int32]time.Time
+}
+
+func (p *watermarksRecordingPublisher) PublishSourceWatermarks(readMessages []*isb.ReadMessage) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.inferred += len(readMessages)
+}
+
+func (p *watermarksRecordingPublisher) PublishWatermarks(watermarks map[int32]time.Time) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.watermarks = watermarks
+}
+
+func (p *watermarksRecordingPublisher) get() (int, map[int32]time.Time) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.inferred, p.watermarks
+}
+
+func TestDataForwardSourceWatermarks(t *testing.T) {
+	fromStep := &watermarkedSource{SimpleSource: NewSimpleSource(simplebuffer.NewInMemoryBuffer("from", 25, 0)), watermark: testSourceWatermark}
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "receivingVertex",
+		},
+	}}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex:  vertex,
+		Replica: 0,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	writeMessages := testutils.BuildTestWriteMessages(int64(5), testStartTime, nil, "testVertex")
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, len(writeMessages)), errs)
+
+	srcPublisher := &watermarksRecordingPublisher{}
+	toVertexStores := buildNoOpToVertexStores(toSteps)
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+	f, err := NewDataForward(vertexInstance, fromStep, toSteps, mySourceForwardTest{}, &testForwardFetcher{}, srcPublisher, toVertexStores, idleManager, WithReadBatchSize(5))
+	assert.NoError(t, err)
+	stopped := f.Start()
+
+	readMessages, err := to1.Read(ctx, 5)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 5)
+	// the watermark reported by the source is published, instead of the one inferred from the event times.
+	inferred, watermarks := srcPublisher.get()
+	assert.Equal(t, 0, inferred)
+	assert.Equal(t, map[int32]time.Time{0: testSourceWatermark}, watermarks)
+
+	f.Stop()
+	<-stopped
+}
+
+// fixedRateLimiter allows a fixed number of messages for every read, and records the bytes read.
+type fixedRateLimiter struct {
+	allowed int64
+	lock    sync.Mutex
+	bytes   int64
+}
+
+func (rl *fixedRateLimiter) Acquire(_ context.Context, n int64) (int64, error) {
+	return min(n, rl.allowed), nil
+}
+
+func (rl *fixedRateLimiter) Release(_ context.Context, _ int64, bytes int64) {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+	rl.bytes += bytes
+}
+
+func (rl *fixedRateLimiter) getBytes() int64 {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+	return rl.bytes
+}
+
+func TestDataForwardRateLimited(t *testing.T) {
+	fromStep := NewSimpleSource(simplebuffer.NewInMemoryBuffer("from", 25, 0))
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "testPipeline",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "receivingVertex",
+		},
+	}}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex:  vertex,
+		Replica: 0,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	writeMessages := testutils.BuildTestWriteMessages(int64(5), testStartTime, nil, "testVertex")
+	var payloadSize int64
+	for _, m := range writeMessages {
+		payloadSize += int64(len(m.Payload))
+	}
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, len(writeMessages)), errs)
+
+	rateLimiter := &fixedRateLimiter{allowed: 2}
+	toVertexStores := buildNoOpToVertexStores(toSteps)
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+	f, err := NewDataForward(vertexInstance, fromStep, toSteps, mySourceForwardTest{}, &testForwardFetcher{}, TestSourceWatermarkPublisher{}, toVertexStores, idleManager, WithReadBatchSize(5), WithRateLimiter(rateLimiter))
+	assert.NoError(t, err)
+	stopped := f.Start()
+
+	// all the messages are forwarded in batches of 2, and the bytes read are reported to the rate limiter.
+	readMessages, err := to1.Read(ctx, 5)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 5)
+	assert.Equal(t, payloadSize, rateLimiter.getBytes())
+
+	f.Stop()
+	<-stopped
+}
+
 func TestDataForwardMultiplePartition(t *testing.T) {
 	fromStep := NewSimpleSource(simplebuffer.NewInMemoryBuffer("from", 25, 0))
 	to11 := simplebuffer.NewInMemoryBuffer("to1-0", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
@@ -24,6 +24,7 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/ratelimit"
 	"github.com/numaproj/numaflow/pkg/sources/forward/applier"
 )
 
@@ -41,6 +42,8 @@ type options struct {
 	logger *zap.SugaredLogger
 	// cbPublisher is the callback publisher for the vertex.
 	cbPublisher *callback.Uploader
+	// rateLimiter limits the throughput of the vertex, it is nil if the vertex is not rate limited.
+	rateLimiter ratelimit.RateLimiter
 }
 
 type Option func(*options) error
@@ -102,3 +105,11 @@ func WithCallbackUploader(cp *callback.Uploader) Option {
 		return nil
 	}
 }
+
+// WithRateLimiter sets the rate limiter shared by the replicas of the vertex
+func WithRateLimiter(rl ratelimit.RateLimiter) Option {
+	return func(o *options) error {
+		o.rateLimiter = rl
+		return nil
+	}
+}
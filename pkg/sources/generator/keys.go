@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"fmt"
+	"math/rand"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// keyPicker returns the index of the key of the i-th message generated in a tick.
+type keyPicker func(rnd *rand.Rand, i int) int32
+
+// newKeyPicker returns the key picker of the distribution over keyCount keys.
+func newKeyPicker(kd *dfv1.GeneratorKeyDistribution, keyCount int32, rnd *rand.Rand) (keyPicker, error) {
+	if keyCount <= 1 || kd == nil {
+		return roundRobinPicker(keyCount), nil
+	}
+	switch kd.GetType() {
+	case dfv1.GeneratorKeyDistributionRoundRobin:
+		return roundRobinPicker(keyCount), nil
+	case dfv1.GeneratorKeyDistributionUniform:
+		return func(rnd *rand.Rand, _ int) int32 {
+			return rnd.Int31n(keyCount)
+		}, nil
+	case dfv1.GeneratorKeyDistributionZipf:
+		s, err := kd.GetZipfExponent()
+		if err != nil {
+			return nil, err
+		}
+		zipf := rand.NewZipf(rnd, s, 1, uint64(keyCount-1))
+		return func(*rand.Rand, int) int32 {
+			return int32(zipf.Uint64())
+		}, nil
+	case dfv1.GeneratorKeyDistributionHotKey:
+		hotKeyCount := kd.GetHotKeyCount()
+		if hotKeyCount <= 0 || hotKeyCount > keyCount {
+			return nil, fmt.Errorf("invalid hot key count %d, it must be between 1 and the key count %d", hotKeyCount, keyCount)
+		}
+		percentage := int(kd.GetHotKeyPercentage())
+		return func(rnd *rand.Rand, _ int) int32 {
+			if hotKeyCount == keyCount || rnd.Intn(100) < percentage {
+				return rnd.Int31n(hotKeyCount)
+			}
+			return hotKeyCount + rnd.Int31n(keyCount-hotKeyCount)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key distribution type %q", kd.Type)
+	}
+}
+
+func roundRobinPicker(keyCount int32) keyPicker {
+	return func(_ *rand.Rand, i int) int32 {
+		if keyCount <= 1 {
+			return 0
+		}
+		return int32(i % int(keyCount))
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// placeholderRegex matches the placeholders such as "{{int 1 100}}" in the string values of a template.
+var placeholderRegex = regexp.MustCompile(`\{\{\s*([^{}]*?)\s*\}\}`)
+
+const alphanumerics = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// genContext contains the values of a message which can be used in a template.
+type genContext struct {
+	rnd       *rand.Rand
+	key       string
+	eventTime time.Time
+}
+
+// valueFunc generates a value of a template.
+type valueFunc func(gc *genContext) interface{}
+
+// payloadTemplate generates the JSON payloads from a template.
+type payloadTemplate struct {
+	root valueFunc
+}
+
+// parseTemplate parses a JSON template, in which a string value that is a placeholder is replaced by a random value
+// of the type, and the placeholders in the other string values are replaced by the string form of the random values.
+func parseTemplate(tmpl string) (*payloadTemplate, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(tmpl), &v); err != nil {
+		return nil, fmt.Errorf("invalid template, %w", err)
+	}
+	root, err := compileValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return &payloadTemplate{root: root}, nil
+}
+
+// generate returns a payload generated from the template.
+func (pt *payloadTemplate) generate(gc *genContext) ([]byte, error) {
+	return json.Marshal(pt.root(gc))
+}
+
+func compileValue(v interface{}) (valueFunc, error) {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		fields := make(map[string]valueFunc, len(x))
+		for k, child := range x {
+			f, err := compileValue(child)
+			if err != nil {
+				return nil, err
+			}
+			fields[k] = f
+		}
+		return func(gc *genContext) interface{} {
+			result := make(map[string]interface{}, len(fields))
+			for k, f := range fields {
+				result[k] = f(gc)
+			}
+			return result
+		}, nil
+	case []interface{}:
+		items := make([]valueFunc, 0, len(x))
+		for _, child := range x {
+			f, err := compileValue(child)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, f)
+		}
+		return func(gc *genContext) interface{} {
+			result := make([]interface{}, 0, len(items))
+			for _, f := range items {
+				result = append(result, f(gc))
+			}
+			return result
+		}, nil
+	case string:
+		return compileString(x)
+	default:
+		return func(*genContext) interface{} { return x }, nil
+	}
+}
+
+func compileString(s string) (valueFunc, error) {
+	locs := placeholderRegex.FindAllStringSubmatchIndex(s, -1)
+	if len(locs) == 0 {
+		return func(*genContext) interface{} { return s }, nil
+	}
+	// the string is a single placeholder, the value keeps its type.
+	if len(locs) == 1 && locs[0][0] == 0 && locs[0][1] == len(s) {
+		return compilePlaceholder(s[locs[0][2]:locs[0][3]])
+	}
+	var parts []valueFunc
+	last := 0
+	for _, loc := range locs {
+		literal := s[last:loc[0]]
+		parts = append(parts, func(*genContext) interface{} { return literal })
+		f, err := compilePlaceholder(s[loc[2]:loc[3]])
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, f)
+		last = loc[1]
+	}
+	literal := s[last:]
+	parts = append(parts, func(*genContext) interface{} { return literal })
+	return func(gc *genContext) interface{} {
+		var sb strings.Builder
+		for _, f := range parts {
+			sb.WriteString(fmt.Sprint(f(gc)))
+		}
+		return sb.String()
+	}, nil
+}
+
+// compilePlaceholder returns the function of a placeholder, which is a type followed by its arguments.
+func compilePlaceholder(expr string) (valueFunc, error) {
+	fields := strings.Fields(expr)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("empty placeholder in the template")
+	}
+	name, args := fields[0], fields[1:]
+	switch name {
+	case "int":
+		if len(args) != 2 {
+			return nil, fmt.Errorf("placeholder %q requires the min and max arguments", expr)
+		}
+		lo, err1 := strconv.ParseInt(args[0], 10, 64)
+		hi, err2 := strconv.ParseInt(args[1], 10, 64)
+		if err1 != nil || err2 != nil || lo > hi {
+			return nil, fmt.Errorf("invalid arguments of placeholder %q", expr)
+		}
+		// the span is computed as unsigned, so that it doesn't overflow for the ranges wider than math.MaxInt64.
+		span := uint64(hi) - uint64(lo)
+		return func(gc *genContext) interface{} { return int64(uint64(lo) + uint64n(gc.rnd, span)) }, nil
+	case "float":
+		if len(args) != 2 {
+			return nil, fmt.Errorf("placeholder %q requires the min and max arguments", expr)
+		}
+		lo, err1 := strconv.ParseFloat(args[0], 64)
+		hi, err2 := strconv.ParseFloat(args[1], 64)
+		if err1 != nil || err2 != nil || lo > hi {
+			return nil, fmt.Errorf("invalid arguments of placeholder %q", expr)
+		}
+		return func(gc *genContext) interface{} { return lo + gc.rnd.Float64()*(hi-lo) }, nil
+	case "bool":
+		return func(gc *genContext) interface{} { return gc.rnd.Intn(2) == 1 }, nil
+	case "enum":
+		if len(args) == 0 {
+			return nil, fmt.Errorf("placeholder %q requires at least one value", expr)
+		}
+		return func(gc *genContext) interface{} { return args[gc.rnd.Intn(len(args))] }, nil
+	case "string":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("placeholder %q requires the length argument", expr)
+		}
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid arguments of placeholder %q", expr)
+		}
+		return func(gc *genContext) interface{} {
+			b := make([]byte, n)
+			for i := range b {
+				b[i] = alphanumerics[gc.rnd.Intn(len(alphanumerics))]
+			}
+			return string(b)
+		}, nil
+	case "uuid":
+		return func(*genContext) interface{} { return uuid.NewString() }, nil
+	case "timestamp":
+		return func(gc *genContext) interface{} { return gc.eventTime.UTC().Format(time.RFC3339Nano) }, nil
+	case "unixMillis":
+		return func(gc *genContext) interface{} { return gc.eventTime.UnixMilli() }, nil
+	case "key":
+		return func(gc *genContext) interface{} { return gc.key }, nil
+	default:
+		return nil, fmt.Errorf("unknown placeholder %q in the template", expr)
+	}
+}
+
+// uint64n returns a uniformly distributed random number in [0, max].
+func uint64n(rnd *rand.Rand, max uint64) uint64 {
+	if max == math.MaxUint64 {
+		return rnd.Uint64()
+	}
+	n := max + 1
+	// rejects the numbers in the last incomplete interval of n, so that the result isn't biased.
+	limit := math.MaxUint64 - math.MaxUint64%n
+	for {
+		if v := rnd.Uint64(); v < limit {
+			return v % n
+		}
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadTemplate(t *testing.T) {
+	tmpl, err := parseTemplate(`{
+		"id": "{{uuid}}",
+		"amount": "{{int 1 100}}",
+		"price": "{{float 0.5 1.5}}",
+		"status": "{{enum new paid shipped}}",
+		"vip": "{{bool}}",
+		"ts": "{{timestamp}}",
+		"ms": "{{unixMillis}}",
+		"user": "user-{{key}}-{{string 4}}",
+		"items": [{"sku": "{{enum a b}}", "qty": 1}],
+		"static": "value"
+	}`)
+	require.NoError(t, err)
+	et := time.Unix(1700000000, 123000000)
+	gc := &genContext{rnd: rand.New(rand.NewSource(1)), key: "key-0-1", eventTime: et}
+	for i := 0; i < 20; i++ {
+		b, err := tmpl.generate(gc)
+		require.NoError(t, err)
+		var p map[string]interface{}
+		require.NoError(t, json.Unmarshal(b, &p))
+		_, err = uuid.Parse(p["id"].(string))
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, p["amount"].(float64), float64(1))
+		assert.LessOrEqual(t, p["amount"].(float64), float64(100))
+		assert.GreaterOrEqual(t, p["price"].(float64), 0.5)
+		assert.Less(t, p["price"].(float64), 1.5)
+		assert.Contains(t, []string{"new", "paid", "shipped"}, p["status"])
+		assert.IsType(t, true, p["vip"])
+		assert.Equal(t, "2023-11-14T22:13:20.123Z", p["ts"])
+		assert.Equal(t, float64(1700000000123), p["ms"])
+		assert.Regexp(t, "^user-key-0-1-[a-zA-Z0-9]{4}$", p["user"])
+		assert.Contains(t, []string{"a", "b"}, p["items"].([]interface{})[0].(map[string]interface{})["sku"])
+		assert.Equal(t, "value", p["static"])
+	}
+}
+
+func TestIntPlaceholder_FullRange(t *testing.T) {
+	gc := &genContext{rnd: rand.New(rand.NewSource(1))}
+	for _, tc := range []struct {
+		expr   string
+		lo, hi int64
+	}{
+		{"int -9223372036854775808 9223372036854775807", math.MinInt64, math.MaxInt64},
+		{"int -9223372036854775808 0", math.MinInt64, 0},
+		{"int -1 9223372036854775807", -1, math.MaxInt64},
+		{"int 7 7", 7, 7},
+	} {
+		f, err := compilePlaceholder(tc.expr)
+		require.NoError(t, err)
+		for i := 0; i < 100; i++ {
+			v := f(gc).(int64)
+			assert.GreaterOrEqual(t, v, tc.lo, tc.expr)
+			assert.LessOrEqual(t, v, tc.hi, tc.expr)
+		}
+	}
+}
+
+func TestParseTemplate_Invalid(t *testing.T) {
+	for _, tmpl := range []string{
+		`{"a": `,
+		`{"a": "{{unknown}}"}`,
+		`{"a": "{{int 1}}"}`,
+		`{"a": "{{int 5 1}}"}`,
+		`{"a": "{{float a b}}"}`,
+		`{"a": "{{enum}}"}`,
+		`{"a": "x-{{string -1}}"}`,
+	} {
+		_, err := parseTemplate(tmpl)
+		assert.Error(t, err, tmpl)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	rand2 "math/rand"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -90,6 +91,13 @@ type memGen struct {
 	readTimeout    time.Duration                               // read timeout for the reader
 	vertexInstance *dfv1.VertexInstance                        // vertex instance
 	jitter         time.Duration
+	template       *payloadTemplate        // template generates the payloads if it's set
+	pickKey        keyPicker               // pickKey picks the key of each message
+	lateData       *dfv1.GeneratorLateData // lateData sets the event time of a percentage of the messages back
+	rnd            *rand2.Rand             // rnd is only used by the worker goroutine
+	maxMessages    int64                   // maxMessages is the number of messages to generate, unlimited if it's 0
+	generated      int64                   // generated is the number of messages generated, only used by the worker goroutine
+	exhausted      atomic.Bool             // exhausted is set once maxMessages messages have been generated
 	logger         *zap.SugaredLogger
 }
 
@@ -151,6 +159,26 @@ func NewMemGen(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ..
 		}
 	}
 
+	var template *payloadTemplate
+	if x := vertexInstance.Vertex.Spec.Source.Generator.Template; x != nil {
+		logger.Info("Template was set, generating the payloads from the template")
+		var err error
+		if template, err = parseTemplate(*x); err != nil {
+			return nil, fmt.Errorf("error parsing the template, cannot initialize generator, %w", err)
+		}
+	}
+
+	rnd := rand2.New(rand2.NewSource(time.Now().UnixNano()))
+	pickKey, err := newKeyPicker(vertexInstance.Vertex.Spec.Source.Generator.KeyDistribution, keyCount, rnd)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the key distribution, cannot initialize generator, %w", err)
+	}
+
+	var maxMessages int64
+	if x := vertexInstance.Vertex.Spec.Source.Generator.MaxMessages; x != nil {
+		maxMessages = *x
+	}
+
 	genSrc := &memGen{
 		rpu:            rpu,
 		keyCount:       keyCount,
@@ -164,6 +192,11 @@ func NewMemGen(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ..
 		srcChan:        make(chan record, rpu*int(keyCount)*5),
 		readTimeout:    3 * time.Second, // default timeout
 		jitter:         jitter,
+		template:       template,
+		pickKey:        pickKey,
+		lateData:       vertexInstance.Vertex.Spec.Source.Generator.LateData,
+		rnd:            rnd,
+		maxMessages:    maxMessages,
 		logger:         logger,
 	}
 
@@ -198,7 +231,14 @@ loop:
 		// since the Read call is blocking, and runs in an infinite loop,
 		// we implement Read With Wait semantics
 		select {
-		case r := <-mg.srcChan:
+		case r, ok := <-mg.srcChan:
+			if !ok {
+				// the worker has exited, wait for the timeout to avoid a busy loop of empty reads.
+				if len(msgs) == 0 {
+					<-timeout
+				}
+				break loop
+			}
 			tickgenSourceReadCount.With(map[string]string{metrics.LabelVertex: mg.vertexName, metrics.LabelPipeline: mg.pipelineName}).Inc()
 			msgs = append(msgs, mg.newReadMessage(r.key, r.data, r.offset, r.ts))
 		case <-timeout:
@@ -209,6 +249,12 @@ loop:
 	return msgs, nil
 }
 
+// IsExhausted returns true once all the messages have been generated and read, the generated messages don't need
+// to be acknowledged.
+func (mg *memGen) IsExhausted() bool {
+	return mg.exhausted.Load() && len(mg.srcChan) == 0
+}
+
 func (mg *memGen) Pending(_ context.Context) (int64, error) {
 	return isb.PendingNotAvailable, nil
 }
@@ -242,25 +288,30 @@ func (mg *memGen) newWorker(ctx context.Context, rate int) func(chan time.Time,
 				return
 			case ts := <-tickChan:
 				tickgenSourceCount.With(map[string]string{metrics.LabelVertex: mg.vertexName, metrics.LabelPipeline: mg.pipelineName})
-				// we would generate all the keys in a round robin fashion
+				// by default, we would generate all the keys in a round robin fashion
 				// even if there are multiple pods, all the pods will generate same keys in the same order.
 				// TODO: alternatively, we could also think about generating a subset of keys per pod.
 				t := ts.UnixNano()
-				for i := 0; i < rate; i++ {
-					for k := int32(0); k < mg.keyCount; k++ {
-						key := fmt.Sprintf("key-%d-%d", mg.vertexInstance.Replica, k)
-						d, err := mg.genFn(mg.msgSize, mg.value, t)
-						if err != nil {
-							mg.logger.Errorw("Error while generating the record, skipping the record", zap.Error(err))
-							continue
-						}
-						r := record{data: d, offset: time.Now().UTC().UnixNano(), key: key, ts: t}
-						select {
-						case <-ctx.Done():
-							mg.logger.Info("Context.Done is called. returning from the inner function")
-							return
-						case mg.srcChan <- r:
-						}
+				for i := 0; i < rate*int(mg.keyCount); i++ {
+					key := fmt.Sprintf("key-%d-%d", mg.vertexInstance.Replica, mg.pickKey(mg.rnd, i))
+					et := mg.eventTime(t)
+					d, err := mg.generate(key, t, et)
+					if err != nil {
+						mg.logger.Errorw("Error while generating the record, skipping the record", zap.Error(err))
+						continue
+					}
+					r := record{data: d, offset: time.Now().UTC().UnixNano(), key: key, ts: et.UnixNano()}
+					select {
+					case <-ctx.Done():
+						mg.logger.Info("Context.Done is called. returning from the inner function")
+						return
+					case mg.srcChan <- r:
+					}
+					mg.generated++
+					if mg.maxMessages > 0 && mg.generated >= mg.maxMessages {
+						mg.logger.Infow("Generated all the messages, stopping the generator", zap.Int64("maxMessages", mg.maxMessages))
+						mg.exhausted.Store(true)
+						return
 					}
 				}
 			}
@@ -296,18 +347,41 @@ func (mg *memGen) generator(ctx context.Context, rate int, timeunit time.Duratio
 			mg.logger.Info("Context.Done is called. exiting generator loop.")
 			<-doneChan
 			return
+		case <-doneChan:
+			mg.logger.Info("Worker has exited. exiting generator loop.")
+			return
 		case ts := <-ticker.C:
-			tickChan <- ts
+			select {
+			case tickChan <- ts:
+			case <-doneChan:
+			}
 		}
 	}
 }
 
+// generate returns the payload of a message, from the template if it's set.
+func (mg *memGen) generate(key string, createdTS int64, eventTime time.Time) ([]byte, error) {
+	if mg.template != nil {
+		return mg.template.generate(&genContext{rnd: mg.rnd, key: key, eventTime: eventTime})
+	}
+	return mg.genFn(mg.msgSize, mg.value, createdTS)
+}
+
+// eventTime returns the event time of a message created at the given time, with the jitter and the late data applied.
+func (mg *memGen) eventTime(createdTS int64) time.Time {
+	et := timeFromNanos(createdTS, mg.jitter)
+	if x := mg.lateData; x != nil && mg.rnd.Intn(100) < int(x.Percentage) {
+		et = et.Add(-x.Delay.Duration)
+	}
+	return et
+}
+
 func (mg *memGen) newReadMessage(key string, payload []byte, offset int64, et int64) *isb.ReadMessage {
 	readOffset := isb.NewSimpleIntPartitionOffset(offset, mg.vertexInstance.Replica)
 	msg := isb.Message{
 		Header: isb.Header{
 			// TODO: insert the right time based on the generator
-			MessageInfo: isb.MessageInfo{EventTime: timeFromNanos(et, mg.jitter)},
+			MessageInfo: isb.MessageInfo{EventTime: time.Unix(0, et)},
 			ID: isb.MessageID{
 				VertexName: mg.vertexName,
 				Offset:     readOffset.String(),
@@ -19,13 +19,19 @@ package generator
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/rand"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/sources/sourcer"
 )
 
 func TestRead(t *testing.T) {
@@ -109,3 +115,121 @@ func TestReadProvidedMessageBlob(t *testing.T) {
 		assert.Equal(t, testVal, msg.Body.Payload)
 	}
 }
+
+func TestReadTemplateAndLateData(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						Template: ptr.To[string](`{"key": "{{key}}", "ts": "{{unixMillis}}"}`),
+						KeyCount: ptr.To[int32](3),
+						LateData: &dfv1.GeneratorLateData{Percentage: 100, Delay: v1.Duration{Duration: time.Hour}},
+					},
+				},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestRead",
+		Replica:  0,
+	}
+
+	mGen, err := NewMemGen(ctx, m, WithReadTimeout(3*time.Second))
+	require.NoError(t, err)
+	messages, err := mGen.Read(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(messages))
+	for i, msg := range messages {
+		var p map[string]interface{}
+		require.NoError(t, json.Unmarshal(msg.Payload, &p))
+		// keys are round robin by default.
+		assert.Equal(t, []string{p["key"].(string)}, msg.Keys)
+		assert.Equal(t, fmt.Sprintf("key-0-%d", i), p["key"])
+		assert.Equal(t, msg.EventTime.UnixMilli(), int64(p["ts"].(float64)))
+		assert.True(t, msg.EventTime.Before(time.Now().Add(-50*time.Minute)))
+	}
+}
+
+func TestReadMaxMessages(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						RPU:         ptr.To[int64](5),
+						Duration:    &v1.Duration{Duration: 100 * time.Millisecond},
+						MaxMessages: ptr.To[int64](7),
+					},
+				},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestRead",
+		Replica:  0,
+	}
+
+	mGen, err := NewMemGen(ctx, m, WithReadTimeout(time.Second))
+	require.NoError(t, err)
+	bounded, ok := mGen.(sourcer.BoundedSourceReader)
+	require.True(t, ok)
+	messages, err := mGen.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Len(t, messages, 7)
+	assert.True(t, bounded.IsExhausted())
+	messages, err = mGen.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func TestNewKeyPicker(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	count := func(kd *dfv1.GeneratorKeyDistribution, keyCount int32) map[int32]int {
+		pick, err := newKeyPicker(kd, keyCount, rnd)
+		require.NoError(t, err)
+		counts := make(map[int32]int)
+		for i := 0; i < 10000; i++ {
+			k := pick(rnd, i)
+			assert.True(t, k >= 0 && k < keyCount)
+			counts[k]++
+		}
+		return counts
+	}
+
+	counts := count(nil, 4)
+	assert.Equal(t, map[int32]int{0: 2500, 1: 2500, 2: 2500, 3: 2500}, counts)
+
+	counts = count(&dfv1.GeneratorKeyDistribution{Type: dfv1.GeneratorKeyDistributionUniform}, 4)
+	assert.Len(t, counts, 4)
+
+	counts = count(&dfv1.GeneratorKeyDistribution{Type: dfv1.GeneratorKeyDistributionZipf, ZipfExponent: "2"}, 10)
+	assert.Greater(t, counts[0], counts[1])
+	assert.Greater(t, counts[1], counts[5])
+
+	counts = count(&dfv1.GeneratorKeyDistribution{Type: dfv1.GeneratorKeyDistributionHotKey, HotKeyPercentage: ptr.To[uint32](90)}, 10)
+	assert.InDelta(t, 9000, counts[0], 300)
+
+	_, err := newKeyPicker(&dfv1.GeneratorKeyDistribution{Type: dfv1.GeneratorKeyDistributionZipf, ZipfExponent: "1"}, 10, rnd)
+	assert.Error(t, err)
+	_, err = newKeyPicker(&dfv1.GeneratorKeyDistribution{Type: dfv1.GeneratorKeyDistributionHotKey, HotKeyCount: ptr.To[int32](11)}, 10, rnd)
+	assert.Error(t, err)
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// batchElement is an element of a batch request.
+type batchElement struct {
+	// ID is used to dedup, if it's not provided, it is generated from the x-numaflow-id header of the request and
+	// the index of the element, or a random UUID if the header is not provided either.
+	ID string `json:"id,omitempty"`
+	// Keys of the message.
+	Keys []string `json:"keys,omitempty"`
+	// EventTime is the number of milliseconds elapsed since January 1, 1970 UTC,
+	// defaults to the event time of the request.
+	EventTime *int64 `json:"eventTime,omitempty"`
+	// Payload of the message, a JSON string is used as the payload as is, and any other JSON value is used in the
+	// JSON format.
+	Payload json.RawMessage `json:"payload"`
+}
+
+// batchResult is the result of an element in a batch request.
+type batchResult struct {
+	ID     string `json:"id,omitempty"`
+	Status int    `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// batchResponse is the response body of a batch request.
+type batchResponse struct {
+	Results []batchResult `json:"results"`
+}
+
+// handleBatch handles the request of a batch of messages, the request body is either a JSON array or newline delimited
+// JSON of the elements. The response reports the result of each element in the same order.
+func (h *httpSource) handleBatch(w http.ResponseWriter, r *http.Request) {
+	if !h.accept(w, r) {
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	_ = r.Body.Close()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	elements, err := splitBatch(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	batchID := r.Header.Get(dfv1.KeyMetaID)
+	eventTime, headers, err := requestMetadata(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	results := make([]batchResult, len(elements))
+	messages := make([]*isb.ReadMessage, len(elements))
+	for i, raw := range elements {
+		m, err := h.decodeBatchElement(raw, batchID, i, eventTime, headers)
+		if err != nil {
+			results[i] = batchResult{Status: http.StatusBadRequest, Error: err.Error()}
+			continue
+		}
+		messages[i] = m
+		results[i].ID = m.ID.Offset
+	}
+
+	if h.synchronous {
+		h.writeBatchSync(r, messages, results)
+	} else {
+		for i, m := range messages {
+			if m == nil {
+				continue
+			}
+			if !h.enqueue(m) {
+				results[i] = batchResult{ID: m.ID.Offset, Status: http.StatusServiceUnavailable, Error: "http source is closed"}
+				continue
+			}
+			results[i].Status = http.StatusNoContent
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(batchResponse{Results: results})
+}
+
+// writeBatchSync sends all the messages to be read first, and then waits for them to be acked before the same deadline.
+func (h *httpSource) writeBatchSync(r *http.Request, messages []*isb.ReadMessage, results []batchResult) {
+	ackedChs := make([]chan struct{}, len(messages))
+	for i, m := range messages {
+		if m == nil {
+			continue
+		}
+		acked, status, err := h.enqueueSync(m)
+		if err != nil {
+			results[i].Status, results[i].Error = status, err.Error()
+			continue
+		}
+		ackedChs[i] = acked
+	}
+	deadline, stop := h.syncDeadline()
+	defer stop()
+	for i, m := range messages {
+		if ackedChs[i] == nil {
+			continue
+		}
+		status, err := h.waitSync(r.Context(), m, ackedChs[i], deadline)
+		results[i].Status = status
+		if err != nil {
+			results[i].Error = err.Error()
+		}
+	}
+}
+
+// splitBatch splits the request body into the raw JSON elements.
+func splitBatch(body []byte) ([]json.RawMessage, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return nil, fmt.Errorf("empty batch")
+	}
+	if trimmed[0] == '[' {
+		var elements []json.RawMessage
+		if err := json.Unmarshal(trimmed, &elements); err != nil {
+			return nil, fmt.Errorf("failed to decode the JSON array, %w", err)
+		}
+		return elements, nil
+	}
+	var elements []json.RawMessage
+	for _, line := range bytes.Split(trimmed, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		elements = append(elements, line)
+	}
+	return elements, nil
+}
+
+// decodeBatchElement decodes the raw JSON element to a message.
+func (h *httpSource) decodeBatchElement(raw json.RawMessage, batchID string, index int, eventTime time.Time, headers map[string]string) (*isb.ReadMessage, error) {
+	var e batchElement
+	if err := json.Unmarshal(raw, &e); err != nil {
+		return nil, fmt.Errorf("failed to decode the element, %w", err)
+	}
+	if len(e.Payload) == 0 || string(e.Payload) == "null" {
+		return nil, fmt.Errorf("payload is missing")
+	}
+	payload := []byte(e.Payload)
+	if e.Payload[0] == '"' {
+		var str string
+		if err := json.Unmarshal(e.Payload, &str); err != nil {
+			return nil, fmt.Errorf("failed to decode the payload, %w", err)
+		}
+		payload = []byte(str)
+	}
+	id := e.ID
+	if id == "" {
+		if batchID != "" {
+			id = fmt.Sprintf("%s-%d", batchID, index)
+		} else {
+			id = uuid.New().String()
+		}
+	}
+	if e.EventTime != nil {
+		eventTime = time.UnixMilli(*e.EventTime)
+	}
+	// each message gets its own copy of the request headers, as the headers of a message might be changed downstream.
+	return h.newReadMessage(id, eventTime, e.Keys, maps.Clone(headers), payload), nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+func Test_splitBatch(t *testing.T) {
+	elements, err := splitBatch([]byte(` [{"payload": 1}, {"payload": "a"}] `))
+	assert.NoError(t, err)
+	assert.Len(t, elements, 2)
+
+	elements, err = splitBatch([]byte("{\"payload\": 1}\n\n{\"payload\": \"a\"}\nnot a json\n"))
+	assert.NoError(t, err)
+	assert.Len(t, elements, 3)
+
+	_, err = splitBatch([]byte(`[{"payload": 1}`))
+	assert.Error(t, err)
+
+	_, err = splitBatch([]byte(" \n"))
+	assert.Error(t, err)
+}
+
+func Test_decodeBatchElement(t *testing.T) {
+	h := &httpSource{vertexName: "test-v", vertexReplica: 1}
+	now := time.UnixMilli(1000)
+
+	m, err := h.decodeBatchElement([]byte(`{"id": "a", "keys": ["k1"], "eventTime": 2000, "payload": {"x": 1}}`), "", 0, now, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", m.ID.Offset)
+	assert.Equal(t, []string{"k1"}, m.Keys)
+	assert.Equal(t, time.UnixMilli(2000), m.EventTime)
+	assert.Equal(t, `{"x": 1}`, string(m.Payload))
+	assert.Equal(t, "a-1", m.ReadOffset.String())
+
+	headers := map[string]string{"h": "v"}
+	m, err = h.decodeBatchElement([]byte(`{"payload": "hello"}`), "batch", 3, now, headers)
+	assert.NoError(t, err)
+	assert.Equal(t, "batch-3", m.ID.Offset)
+	assert.Equal(t, now, m.EventTime)
+	assert.Equal(t, "hello", string(m.Payload))
+	assert.Equal(t, headers, m.Headers)
+	// the headers are copied per message.
+	m.Headers["h"] = "changed"
+	assert.Equal(t, "v", headers["h"])
+
+	_, err = h.decodeBatchElement([]byte(`{"id": "a"}`), "", 0, now, nil)
+	assert.EqualError(t, err, "payload is missing")
+
+	_, err = h.decodeBatchElement([]byte(`not a json`), "", 0, now, nil)
+	assert.Error(t, err)
+}
+
+func Test_handleBatch(t *testing.T) {
+	t.Run("async", func(t *testing.T) {
+		h := newTestSyncSource(10, time.Minute)
+		h.synchronous = false
+		h.ready.Store(true)
+		r := httptest.NewRequest(http.MethodPost, "/vertices/test-v/batch", strings.NewReader("{\"id\": \"a\", \"payload\": 1}\n{\"id\": \"b\"}\n"))
+		r.Header.Set(dfv1.KeyMetaEventTime, "1000")
+		w := httptest.NewRecorder()
+		h.handleBatch(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp batchResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, []batchResult{
+			{ID: "a", Status: http.StatusNoContent},
+			{Status: http.StatusBadRequest, Error: "payload is missing"},
+		}, resp.Results)
+		assert.Len(t, h.messages, 1)
+		m := <-h.messages
+		assert.Equal(t, time.UnixMilli(1000), m.EventTime)
+		_, ok := m.Headers[dfv1.KeyMetaEventTime]
+		assert.False(t, ok)
+	})
+
+	t.Run("sync acked", func(t *testing.T) {
+		h := newTestSyncSource(10, time.Minute)
+		h.ready.Store(true)
+		go func() {
+			for acked := 0; acked < 2; {
+				msgs, _ := h.Read(context.Background(), 2)
+				for _, m := range msgs {
+					h.Ack(context.Background(), []isb.Offset{m.ReadOffset})
+					acked++
+				}
+			}
+		}()
+		r := httptest.NewRequest(http.MethodPost, "/vertices/test-v/batch", strings.NewReader(`[{"id": "a", "payload": 1}, {"id": "b", "payload": 2}]`))
+		w := httptest.NewRecorder()
+		h.handleBatch(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp batchResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, []batchResult{
+			{ID: "a", Status: http.StatusOK},
+			{ID: "b", Status: http.StatusOK},
+		}, resp.Results)
+		assert.Empty(t, h.pendingAcks)
+	})
+
+	t.Run("sync full and timed out", func(t *testing.T) {
+		h := newTestSyncSource(1, 50*time.Millisecond)
+		h.ready.Store(true)
+		r := httptest.NewRequest(http.MethodPost, "/vertices/test-v/batch", strings.NewReader(`[{"id": "a", "payload": 1}, {"id": "b", "payload": 2}]`))
+		w := httptest.NewRecorder()
+		h.handleBatch(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp batchResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, []batchResult{
+			{ID: "a", Status: http.StatusServiceUnavailable, Error: "timed out waiting for the message to be written"},
+			{ID: "b", Status: http.StatusTooManyRequests, Error: "http source is full"},
+		}, resp.Results)
+		assert.Empty(t, h.pendingAcks)
+	})
+
+	t.Run("not authorized", func(t *testing.T) {
+		h := newTestSyncSource(2, time.Minute)
+		h.ready.Store(true)
+		h.auth = "token"
+		w := httptest.NewRecorder()
+		h.handleBatch(w, httptest.NewRequest(http.MethodPost, "/vertices/test-v/batch", strings.NewReader(`[]`)))
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
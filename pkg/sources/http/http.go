@@ -19,12 +19,14 @@ package http
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -50,8 +52,24 @@ type httpSource struct {
 	messages      chan *isb.ReadMessage
 	logger        *zap.SugaredLogger
 	shutdown      func(context.Context) error
+	// auth is the bearer token to authorize the requests, empty means no auth.
+	auth string
+	// synchronous indicates whether the requests wait for the messages to be acked,
+	// which means the messages have been written to the inter-step buffer.
+	synchronous bool
+	syncTimeout time.Duration
+	// pendingAcks is the channels to notify the synchronous requests, keyed by the read offset of the message.
+	pendingAcks map[string]chan struct{}
+	pendingLock sync.Mutex
+	// stopCh is closed when the source is closed, to release the pending requests and the reads.
+	// The messages channel is never closed, because the requests being handled might still send to it.
+	stopCh chan struct{}
 }
 
+// shutdownTimeout is how long to wait for the requests being handled to complete when closing the source, the requests
+// still blocked after that are released with a 503 response.
+var shutdownTimeout = 10 * time.Second
+
 type Option func(*httpSource) error
 
 // WithReadTimeout is used to set the read timeout for the from buffer
@@ -79,6 +97,10 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 		bufferSize:    1000,            // default size
 		readTimeout:   1 * time.Second, // default timeout
 		logger:        logging.FromContext(ctx),
+		synchronous:   vertexInstance.Vertex.Spec.Source.HTTP.Synchronous,
+		syncTimeout:   vertexInstance.Vertex.Spec.Source.HTTP.GetSyncTimeout(),
+		pendingAcks:   make(map[string]chan struct{}),
+		stopCh:        make(chan struct{}),
 	}
 
 	for _, o := range opts {
@@ -89,12 +111,11 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 
 	h.messages = make(chan *isb.ReadMessage, h.bufferSize)
 
-	auth := ""
 	if x := vertexInstance.Vertex.Spec.Source.HTTP.Auth; x != nil && x.Token != nil {
 		if s, err := sharedutil.GetSecretFromVolume(x.Token); err != nil {
 			return nil, fmt.Errorf("failed to get auth token, %w", err)
 		} else {
-			auth = s
+			h.auth = s
 		}
 	}
 	mux := http.NewServeMux()
@@ -113,66 +134,8 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 		}
 		w.WriteHeader(http.StatusNoContent)
 	})
-	mux.HandleFunc("/vertices/"+vertexInstance.Vertex.Spec.Name, func(w http.ResponseWriter, r *http.Request) {
-		if auth != "" && r.Header.Get("Authorization") != "Bearer "+auth {
-			http.Error(w, "request not authorized", http.StatusForbidden)
-			return
-		}
-		if !h.ready.Load() {
-			http.Error(w, "http source not ready", http.StatusServiceUnavailable)
-			return
-		}
-		msg, err := io.ReadAll(r.Body)
-		_ = r.Body.Close()
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-
-		id := r.Header.Get(dfv1.KeyMetaID)
-		if id == "" {
-			id = uuid.New().String()
-		}
-		eventTime := time.Now()
-		if x := r.Header.Get(dfv1.KeyMetaEventTime); x != "" {
-			i, err := strconv.ParseInt(x, 10, 64)
-			if err != nil {
-				http.Error(w, err.Error(), http.StatusBadRequest)
-				return
-			}
-			eventTime = time.UnixMilli(i)
-		}
-
-		// we don't need to consider event time in the header
-		r.Header.Del(dfv1.KeyMetaEventTime)
-		// TODO(security): Auth headers will be passed
-		// https://github.com/numaproj/numaflow/issues/1583
-		headers := make(map[string]string, len(r.Header))
-		for k, v := range r.Header {
-			// multi-value headers are joined with ","
-			headers[k] = strings.Join(v, ",")
-		}
-
-		m := &isb.ReadMessage{
-			Message: isb.Message{
-				Header: isb.Header{
-					MessageInfo: isb.MessageInfo{EventTime: eventTime},
-					ID: isb.MessageID{
-						VertexName: h.vertexName,
-						Offset:     id,
-						Index:      h.vertexReplica,
-					},
-					Headers: headers,
-				},
-				Body: isb.Body{
-					Payload: msg,
-				},
-			},
-			ReadOffset: isb.NewSimpleStringPartitionOffset(id, vertexInstance.Replica),
-		}
-		h.messages <- m
-		w.WriteHeader(http.StatusNoContent)
-	})
+	mux.HandleFunc("/vertices/"+vertexInstance.Vertex.Spec.Name, h.handleMessage)
+	mux.HandleFunc("/vertices/"+vertexInstance.Vertex.Spec.Name+"/batch", h.handleBatch)
 	cer, err := sharedtls.GenerateX509KeyPair()
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate cert: %w", err)
@@ -194,6 +157,96 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 	return h, nil
 }
 
+// accept checks if the request is authorized and the source is ready, it responds the error if not.
+func (h *httpSource) accept(w http.ResponseWriter, r *http.Request) bool {
+	if h.auth != "" && r.Header.Get("Authorization") != "Bearer "+h.auth {
+		http.Error(w, "request not authorized", http.StatusForbidden)
+		return false
+	}
+	if !h.ready.Load() {
+		http.Error(w, "http source not ready", http.StatusServiceUnavailable)
+		return false
+	}
+	return true
+}
+
+// handleMessage handles the request of a single message, the request body is the payload.
+func (h *httpSource) handleMessage(w http.ResponseWriter, r *http.Request) {
+	if !h.accept(w, r) {
+		return
+	}
+	msg, err := io.ReadAll(r.Body)
+	_ = r.Body.Close()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id := r.Header.Get(dfv1.KeyMetaID)
+	if id == "" {
+		id = uuid.New().String()
+	}
+	eventTime, headers, err := requestMetadata(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	m := h.newReadMessage(id, eventTime, nil, headers, msg)
+	if h.synchronous {
+		h.writeSync(w, r, m)
+		return
+	}
+	if !h.enqueue(m) {
+		http.Error(w, "http source is closed", http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// requestMetadata returns the event time and the headers of the request.
+func requestMetadata(r *http.Request) (time.Time, map[string]string, error) {
+	eventTime := time.Now()
+	if x := r.Header.Get(dfv1.KeyMetaEventTime); x != "" {
+		i, err := strconv.ParseInt(x, 10, 64)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		eventTime = time.UnixMilli(i)
+	}
+
+	// we don't need to consider event time in the header
+	r.Header.Del(dfv1.KeyMetaEventTime)
+	// TODO(security): Auth headers will be passed
+	// https://github.com/numaproj/numaflow/issues/1583
+	headers := make(map[string]string, len(r.Header))
+	for k, v := range r.Header {
+		// multi-value headers are joined with ","
+		headers[k] = strings.Join(v, ",")
+	}
+	return eventTime, headers, nil
+}
+
+func (h *httpSource) newReadMessage(id string, eventTime time.Time, keys []string, headers map[string]string, payload []byte) *isb.ReadMessage {
+	return &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+				ID: isb.MessageID{
+					VertexName: h.vertexName,
+					Offset:     id,
+					Index:      h.vertexReplica,
+				},
+				Keys:    keys,
+				Headers: headers,
+			},
+			Body: isb.Body{
+				Payload: payload,
+			},
+		},
+		ReadOffset: isb.NewSimpleStringPartitionOffset(id, h.vertexReplica),
+	}
+}
+
 // GetName returns the name of the source.
 func (h *httpSource) GetName() string {
 	return h.vertexName
@@ -216,6 +269,8 @@ loop:
 		case <-timeout:
 			h.logger.Debugw("Timed out waiting for messages to read.", zap.Duration("waited", h.readTimeout), zap.Int("read", len(msgs)))
 			break loop
+		case <-h.stopCh:
+			break loop
 		}
 	}
 	h.logger.Debugf("Read %d messages.", len(msgs))
@@ -227,13 +282,130 @@ func (h *httpSource) Pending(_ context.Context) (int64, error) {
 }
 
 func (h *httpSource) Ack(_ context.Context, offsets []isb.Offset) []error {
+	if h.synchronous {
+		h.pendingLock.Lock()
+		for _, o := range offsets {
+			if acked, ok := h.pendingAcks[o.String()]; ok {
+				delete(h.pendingAcks, o.String())
+				close(acked)
+			}
+		}
+		h.pendingLock.Unlock()
+	}
 	return make([]error, len(offsets))
 }
 
+// syncResponse is the response body of a synchronous request.
+type syncResponse struct {
+	ID string `json:"id"`
+}
+
+// writeSync sends the message to be read, and responds after the message is acked, which means it has been written
+// to the inter-step buffer. It responds 429 without waiting if the messages channel is full.
+func (h *httpSource) writeSync(w http.ResponseWriter, r *http.Request, m *isb.ReadMessage) {
+	acked, status, err := h.enqueueSync(m)
+	if err == nil {
+		deadline, stop := h.syncDeadline()
+		defer stop()
+		status, err = h.waitSync(r.Context(), m, acked, deadline)
+	}
+	if err != nil {
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "1")
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(syncResponse{ID: m.ID.Offset})
+}
+
+// enqueue sends the message to be read, it returns false if the source is closed.
+func (h *httpSource) enqueue(m *isb.ReadMessage) bool {
+	select {
+	case <-h.stopCh:
+		return false
+	case h.messages <- m:
+		return true
+	}
+}
+
+// enqueueSync registers the pending ack of the message and sends it to be read without blocking,
+// it returns the channel which is closed when the message is acked, or the status code and the error if it fails.
+func (h *httpSource) enqueueSync(m *isb.ReadMessage) (chan struct{}, int, error) {
+	key := m.ReadOffset.String()
+	acked := make(chan struct{})
+	h.pendingLock.Lock()
+	if _, ok := h.pendingAcks[key]; ok {
+		h.pendingLock.Unlock()
+		return nil, http.StatusConflict, fmt.Errorf("message with id %q is being processed", m.ID.Offset)
+	}
+	h.pendingAcks[key] = acked
+	h.pendingLock.Unlock()
+
+	select {
+	case <-h.stopCh:
+		h.removePendingAck(key)
+		return nil, http.StatusServiceUnavailable, fmt.Errorf("http source is closed")
+	case h.messages <- m:
+		return acked, 0, nil
+	default:
+		h.removePendingAck(key)
+		httpSourceRejectedCount.With(map[string]string{metrics.LabelVertex: h.vertexName, metrics.LabelPipeline: h.pipelineName, metrics.LabelReason: "full"}).Inc()
+		return nil, http.StatusTooManyRequests, fmt.Errorf("http source is full")
+	}
+}
+
+// waitSync waits for the message to be acked until the deadline, it returns the status code, and the error if it
+// is not acked.
+func (h *httpSource) waitSync(ctx context.Context, m *isb.ReadMessage, acked chan struct{}, deadline <-chan struct{}) (int, error) {
+	key := m.ReadOffset.String()
+	// check the ack first, in case the deadline has passed when waiting for the other messages of a batch.
+	select {
+	case <-acked:
+		return http.StatusOK, nil
+	default:
+	}
+	select {
+	case <-acked:
+		return http.StatusOK, nil
+	case <-deadline:
+		h.removePendingAck(key)
+		httpSourceRejectedCount.With(map[string]string{metrics.LabelVertex: h.vertexName, metrics.LabelPipeline: h.pipelineName, metrics.LabelReason: "timeout"}).Inc()
+		// the message might still be written later, the client could retry with the same id to avoid duplicates.
+		return http.StatusServiceUnavailable, fmt.Errorf("timed out waiting for the message to be written")
+	case <-h.stopCh:
+		h.removePendingAck(key)
+		return http.StatusServiceUnavailable, fmt.Errorf("http source is closed")
+	case <-ctx.Done():
+		h.removePendingAck(key)
+		return http.StatusServiceUnavailable, fmt.Errorf("request cancelled, %w", ctx.Err())
+	}
+}
+
+// syncDeadline returns a channel which is closed after the sync timeout, and a function to stop the timer.
+func (h *httpSource) syncDeadline() (<-chan struct{}, func()) {
+	deadline := make(chan struct{})
+	timer := time.AfterFunc(h.syncTimeout, func() { close(deadline) })
+	return deadline, func() { timer.Stop() }
+}
+
+func (h *httpSource) removePendingAck(key string) {
+	h.pendingLock.Lock()
+	delete(h.pendingAcks, key)
+	h.pendingLock.Unlock()
+}
+
 func (h *httpSource) Close() error {
 	h.logger.Info("Shutting down http source server...")
-	close(h.messages)
-	if err := h.shutdown(context.Background()); err != nil {
+	h.ready.Store(false)
+	// shut the server down before closing stopCh, so that the requests being handled get the chance to complete.
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	err := h.shutdown(ctx)
+	close(h.stopCh)
+	if err != nil {
 		return err
 	}
 	h.logger.Info("HTTP source server shutdown")
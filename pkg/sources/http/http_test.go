@@ -18,12 +18,16 @@ package http
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
 
 func TestWithBufferSize(t *testing.T) {
@@ -67,3 +71,111 @@ func Test_NewHTTP(t *testing.T) {
 	assert.NotNil(t, h.(*httpSource).shutdown)
 	assert.True(t, h.(*httpSource).ready.Load())
 }
+
+func newTestSyncSource(bufferSize int, syncTimeout time.Duration) *httpSource {
+	return &httpSource{
+		vertexName:  "test-v",
+		messages:    make(chan *isb.ReadMessage, bufferSize),
+		readTimeout: time.Second,
+		logger:      logging.NewLogger(),
+		synchronous: true,
+		syncTimeout: syncTimeout,
+		pendingAcks: make(map[string]chan struct{}),
+		stopCh:      make(chan struct{}),
+	}
+}
+
+func newTestReadMessage(id string) *isb.ReadMessage {
+	return &isb.ReadMessage{
+		Message:    isb.Message{Header: isb.Header{ID: isb.MessageID{VertexName: "test-v", Offset: id}}},
+		ReadOffset: isb.NewSimpleStringPartitionOffset(id, 0),
+	}
+}
+
+func Test_writeSync(t *testing.T) {
+	t.Run("acked", func(t *testing.T) {
+		h := newTestSyncSource(10, time.Minute)
+		go func() {
+			msgs, _ := h.Read(context.Background(), 1)
+			h.Ack(context.Background(), []isb.Offset{msgs[0].ReadOffset})
+		}()
+		w := httptest.NewRecorder()
+		h.writeSync(w, httptest.NewRequest(http.MethodPost, "/vertices/test-v", nil), newTestReadMessage("id-1"))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"id":"id-1"}`, w.Body.String())
+		assert.Empty(t, h.pendingAcks)
+	})
+
+	t.Run("full", func(t *testing.T) {
+		h := newTestSyncSource(1, time.Minute)
+		h.messages <- newTestReadMessage("id-0")
+		w := httptest.NewRecorder()
+		h.writeSync(w, httptest.NewRequest(http.MethodPost, "/vertices/test-v", nil), newTestReadMessage("id-1"))
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "1", w.Header().Get("Retry-After"))
+		assert.Empty(t, h.pendingAcks)
+	})
+
+	t.Run("timed out", func(t *testing.T) {
+		h := newTestSyncSource(10, 50*time.Millisecond)
+		w := httptest.NewRecorder()
+		h.writeSync(w, httptest.NewRequest(http.MethodPost, "/vertices/test-v", nil), newTestReadMessage("id-1"))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Empty(t, h.pendingAcks)
+		// a late ack is ignored.
+		assert.NoError(t, h.Ack(context.Background(), []isb.Offset{isb.NewSimpleStringPartitionOffset("id-1", 0)})[0])
+	})
+
+	t.Run("duplicate id in flight", func(t *testing.T) {
+		h := newTestSyncSource(10, time.Minute)
+		h.pendingAcks[newTestReadMessage("id-1").ReadOffset.String()] = make(chan struct{})
+		w := httptest.NewRecorder()
+		h.writeSync(w, httptest.NewRequest(http.MethodPost, "/vertices/test-v", nil), newTestReadMessage("id-1"))
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		h := newTestSyncSource(10, time.Minute)
+		go func() {
+			_, _ = h.Read(context.Background(), 1)
+			close(h.stopCh)
+		}()
+		w := httptest.NewRecorder()
+		h.writeSync(w, httptest.NewRequest(http.MethodPost, "/vertices/test-v", nil), newTestReadMessage("id-1"))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Empty(t, h.pendingAcks)
+	})
+}
+
+func TestClose(t *testing.T) {
+	h := newTestSyncSource(1, time.Minute)
+	h.synchronous = false
+	h.ready.Store(true)
+	shutdownCalled := make(chan struct{})
+	h.shutdown = func(context.Context) error {
+		close(shutdownCalled)
+		return nil
+	}
+	h.messages <- newTestReadMessage("id-0")
+
+	// the request is blocked because the messages channel is full.
+	w := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.handleMessage(w, httptest.NewRequest(http.MethodPost, "/vertices/test-v", nil))
+	}()
+
+	assert.NoError(t, h.Close())
+	<-shutdownCalled
+	<-done
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.False(t, h.ready.Load())
+
+	// reading after closing neither panics nor waits for the read timeout.
+	start := time.Now()
+	msgs, err := h.Read(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, len(msgs), 1)
+	assert.Less(t, time.Since(start), h.readTimeout)
+}
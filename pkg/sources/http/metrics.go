@@ -29,3 +29,10 @@ var httpSourceReadCount = promauto.NewCounterVec(prometheus.CounterOpts{
 	Name:      "read_total",
 	Help:      "Total number of messages Read",
 }, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// httpSourceRejectedCount is used to indicate the number of synchronous requests rejected by the http source vertex
+var httpSourceRejectedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "http_source",
+	Name:      "rejected_total",
+	Help:      "Total number of synchronous requests rejected because the source is full or timed out",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelReason})
@@ -30,8 +30,8 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/metrics"
+	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
-	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
 )
 
@@ -87,44 +87,11 @@ func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Optio
 			n.logger.Info("Nats reconnected")
 		}),
 	}
-	if source.TLS != nil {
-		if c, err := sharedutil.GetTLSConfig(source.TLS); err != nil {
-			return nil, err
-		} else {
-			opt = append(opt, natslib.Secure(c))
-		}
-	}
-
-	if source.Auth != nil {
-		switch {
-		case source.Auth.Basic != nil && source.Auth.Basic.User != nil && source.Auth.Basic.Password != nil:
-			username, err := sharedutil.GetSecretFromVolume(source.Auth.Basic.User)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get basic auth user, %w", err)
-			}
-			password, err := sharedutil.GetSecretFromVolume(source.Auth.Basic.Password)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get basic auth password, %w", err)
-			}
-			opt = append(opt, natslib.UserInfo(username, password))
-		case source.Auth.Token != nil:
-			token, err := sharedutil.GetSecretFromVolume(source.Auth.Token)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get auth token, %w", err)
-			}
-			opt = append(opt, natslib.Token(token))
-		case source.Auth.NKey != nil:
-			nkeyFile, err := sharedutil.GetSecretVolumePath(source.Auth.NKey)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get configured nkey file, %w", err)
-			}
-			o, err := natslib.NkeyOptionFromSeed(nkeyFile)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get NKey, %w", err)
-			}
-			opt = append(opt, o)
-		}
+	connOpts, err := natsclient.ConnectionOptions(source.TLS, source.Auth)
+	if err != nil {
+		return nil, err
 	}
+	opt = append(opt, connOpts...)
 
 	n.logger.Info("Connecting to nats service...")
 	conn, err := natslib.Connect(source.URL, opt...)
@@ -139,13 +106,7 @@ func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Optio
 		return nil, fmt.Errorf("creating jetstream instance for the NATS server %q: %w", source.URL, err)
 	}
 
-	consumerName := fmt.Sprintf("numaflow-%s-%s-%s", n.pipelineName, n.vertexName, source.Stream)
-	consumer, err := stream.CreateOrUpdateConsumer(ctx, source.Stream, jetstreamlib.ConsumerConfig{
-		Durable:       consumerName,
-		Description:   "Numaflow JetStream consumer",
-		DeliverPolicy: jetstreamlib.DeliverAllPolicy,
-		AckPolicy:     jetstreamlib.AckExplicitPolicy,
-	})
+	consumer, err := stream.CreateOrUpdateConsumer(ctx, source.Stream, consumerConfig(source, n.pipelineName, n.vertexName))
 	if err != nil {
 		n.natsConn.Close()
 		return nil, fmt.Errorf("creating jetstream consumer for stream %q: %w", source.Stream, err)
@@ -238,6 +199,41 @@ func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Optio
 	return n, nil
 }
 
+// consumerConfig returns the config of the durable consumer of the source.
+func consumerConfig(source *dfv1.JetStreamSource, pipelineName, vertexName string) jetstreamlib.ConsumerConfig {
+	config := jetstreamlib.ConsumerConfig{
+		Durable:       source.GetDurable(pipelineName, vertexName),
+		Description:   "Numaflow JetStream consumer",
+		DeliverPolicy: jetstreamlib.DeliverAllPolicy,
+		AckPolicy:     jetstreamlib.AckExplicitPolicy,
+	}
+	switch source.DeliverPolicy {
+	case dfv1.JetStreamDeliverNew:
+		config.DeliverPolicy = jetstreamlib.DeliverNewPolicy
+	case dfv1.JetStreamDeliverByStartTime:
+		config.DeliverPolicy = jetstreamlib.DeliverByStartTimePolicy
+		if source.StartTime != nil {
+			startTime := source.StartTime.Time
+			config.OptStartTime = &startTime
+		}
+	case dfv1.JetStreamDeliverByStartSequence:
+		config.DeliverPolicy = jetstreamlib.DeliverByStartSequencePolicy
+		config.OptStartSeq = source.StartSequence
+	}
+	switch len(source.FilterSubjects) {
+	case 0:
+	case 1:
+		// a single filter subject is also supported by the servers older than v2.10.
+		config.FilterSubject = source.FilterSubjects[0]
+	default:
+		config.FilterSubjects = source.FilterSubjects
+	}
+	if source.AckWait != nil {
+		config.AckWait = source.AckWait.Duration
+	}
+	return config
+}
+
 type Option func(*jsSource) error
 
 // WithLogger is used to return logger information
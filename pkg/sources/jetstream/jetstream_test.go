@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jetstream
+
+import (
+	"testing"
+	"time"
+
+	jetstreamlib "github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestConsumerConfig(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		config := consumerConfig(&dfv1.JetStreamSource{Stream: "s"}, "p", "v")
+		assert.Equal(t, "numaflow-p-v-s", config.Durable)
+		assert.Equal(t, jetstreamlib.DeliverAllPolicy, config.DeliverPolicy)
+		assert.Equal(t, jetstreamlib.AckExplicitPolicy, config.AckPolicy)
+		assert.Empty(t, config.FilterSubject)
+		assert.Empty(t, config.FilterSubjects)
+		assert.Zero(t, config.AckWait)
+	})
+
+	t.Run("by start time", func(t *testing.T) {
+		startTime := metav1.NewTime(time.Unix(1700000000, 0))
+		config := consumerConfig(&dfv1.JetStreamSource{
+			Stream:         "s",
+			Durable:        "my-consumer",
+			DeliverPolicy:  dfv1.JetStreamDeliverByStartTime,
+			StartTime:      &startTime,
+			FilterSubjects: []string{"orders.>"},
+			AckWait:        &metav1.Duration{Duration: time.Minute},
+		}, "p", "v")
+		assert.Equal(t, "my-consumer", config.Durable)
+		assert.Equal(t, jetstreamlib.DeliverByStartTimePolicy, config.DeliverPolicy)
+		assert.Equal(t, startTime.Time, *config.OptStartTime)
+		assert.Equal(t, "orders.>", config.FilterSubject)
+		assert.Equal(t, time.Minute, config.AckWait)
+	})
+
+	t.Run("by start sequence", func(t *testing.T) {
+		config := consumerConfig(&dfv1.JetStreamSource{
+			Stream:         "s",
+			DeliverPolicy:  dfv1.JetStreamDeliverByStartSequence,
+			StartSequence:  100,
+			FilterSubjects: []string{"orders.us", "orders.eu"},
+		}, "p", "v")
+		assert.Equal(t, jetstreamlib.DeliverByStartSequencePolicy, config.DeliverPolicy)
+		assert.Equal(t, uint64(100), config.OptStartSeq)
+		assert.Empty(t, config.FilterSubject)
+		assert.Equal(t, []string{"orders.us", "orders.eu"}, config.FilterSubjects)
+	})
+
+	t.Run("new", func(t *testing.T) {
+		config := consumerConfig(&dfv1.JetStreamSource{Stream: "s", DeliverPolicy: dfv1.JetStreamDeliverNew}, "p", "v")
+		assert.Equal(t, jetstreamlib.DeliverNewPolicy, config.DeliverPolicy)
+	})
+}
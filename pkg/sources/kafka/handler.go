@@ -33,6 +33,8 @@ type ConsumerHandler struct {
 	messages     chan *sarama.ConsumerMessage
 	sess         sarama.ConsumerGroupSession
 	logger       *zap.SugaredLogger
+	// initOffsets is called at the beginning of a new session to reset the offsets of the claims.
+	initOffsets func(sess sarama.ConsumerGroupSession) error
 }
 
 // NewConsumerHandler creates new handler and initializes the channel for passing messages
@@ -54,6 +56,11 @@ func NewConsumerHandler(readChanSize int) *ConsumerHandler {
 // Setup is run at the beginning of a new session, before ConsumeClaim
 func (consumer *ConsumerHandler) Setup(sess sarama.ConsumerGroupSession) error {
 	consumer.sess = sess
+	if consumer.initOffsets != nil {
+		if err := consumer.initOffsets(sess); err != nil {
+			return err
+		}
+	}
 	consumer.readyCloser.Do(func() {
 		close(consumer.ready)
 	})
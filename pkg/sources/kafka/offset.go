@@ -6,12 +6,13 @@ import "fmt"
 // we need topic information to ack the message
 type kafkaOffset struct {
 	offset       int64
-	partitionIdx int32
+	partition    int32 // partition number in the topic
+	partitionIdx int32 // partition index of the source, see partitionIndex
 	topic        string
 }
 
 func (k *kafkaOffset) String() string {
-	return fmt.Sprintf("%s:%d:%d", k.topic, k.offset, k.partitionIdx)
+	return fmt.Sprintf("%s:%d:%d", k.topic, k.offset, k.partition)
 }
 
 func (k *kafkaOffset) Sequence() (int64, error) {
@@ -32,6 +33,10 @@ func (k *kafkaOffset) PartitionIdx() int32 {
 	return k.partitionIdx
 }
 
+func (k *kafkaOffset) Partition() int32 {
+	return k.partition
+}
+
 func (k *kafkaOffset) Topic() string {
 	return k.topic
 }
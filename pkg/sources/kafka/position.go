@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// offsetGetter gets the offset of a partition by timestamp, it is implemented by sarama.Client.
+type offsetGetter interface {
+	GetOffset(topic string, partitionID int32, time int64) (int64, error)
+}
+
+// initialOffset returns the sarama initial offset of the start position, which is used for the partitions without
+// a committed offset or an offset resolved by resolveStartOffsets.
+func initialOffset(sp *dfv1.KafkaStartPosition) int64 {
+	if sp.Type == dfv1.KafkaStartPositionLatest {
+		return sarama.OffsetNewest
+	}
+	return sarama.OffsetOldest
+}
+
+// rewindMetadata returns the metadata committed along with the offsets, it records the rewind that has been applied
+// to the consumer group, so that the rewind is not applied again after restarting.
+func rewindMetadata(rewind *dfv1.KafkaRewind) string {
+	if rewind == nil {
+		return ""
+	}
+	return "rewind:" + rewind.Timestamp.UTC().Format(time.RFC3339Nano)
+}
+
+// rewindGeneration returns the generation of the rewind, which is mixed into the message IDs, so that the messages read
+// again after a rewind are not deduplicated by the inter-step buffer as the ones read before.
+func rewindGeneration(rewind *dfv1.KafkaRewind) string {
+	if rewind == nil {
+		return ""
+	}
+	return strconv.FormatInt(rewind.Timestamp.Unix(), 10)
+}
+
+// resolveStartOffsets returns the offsets to reset to for the partitions. A partition is rewound if the metadata of
+// its committed offset is not the current rewind, otherwise it starts from the start position if it has no committed offset.
+func resolveStartOffsets(client offsetGetter, source *dfv1.KafkaSource, topic string, committed *sarama.OffsetFetchResponse, partitions []int32) (map[int32]int64, error) {
+	metadata := rewindMetadata(source.Rewind)
+	offsets := make(map[int32]int64)
+	for _, partition := range partitions {
+		hasCommitted := false
+		if committed != nil {
+			if block := committed.GetBlock(topic, partition); block != nil && block.Offset >= 0 {
+				hasCommitted = true
+				if metadata != "" && block.Metadata == metadata {
+					continue
+				}
+			}
+		}
+		if source.Rewind != nil {
+			offset, err := timestampOffset(client, topic, partition, source.Rewind.Timestamp.Time)
+			if err != nil {
+				return nil, err
+			}
+			offsets[partition] = offset
+			continue
+		}
+		if hasCommitted || source.StartPosition == nil {
+			continue
+		}
+		switch sp := source.StartPosition; sp.Type {
+		case dfv1.KafkaStartPositionTimestamp:
+			if sp.Timestamp == nil {
+				return nil, fmt.Errorf("timestamp is required for start position type %q", sp.Type)
+			}
+			offset, err := timestampOffset(client, topic, partition, sp.Timestamp.Time)
+			if err != nil {
+				return nil, err
+			}
+			offsets[partition] = offset
+		case dfv1.KafkaStartPositionOffsets:
+			if offset, ok := sp.Offsets[strconv.Itoa(int(partition))]; ok {
+				offsets[partition] = offset
+			}
+		}
+	}
+	return offsets, nil
+}
+
+// timestampOffset returns the offset of the first message with a timestamp greater than or equal to the given time,
+// or the newest offset if there is no such message.
+func timestampOffset(client offsetGetter, topic string, partition int32, t time.Time) (int64, error) {
+	offset, err := client.GetOffset(topic, partition, t.UnixMilli())
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the offset of topic %q, partition %d at %s, %w", topic, partition, t, err)
+	}
+	if offset >= 0 {
+		return offset, nil
+	}
+	if offset, err = client.GetOffset(topic, partition, sarama.OffsetNewest); err != nil {
+		return 0, fmt.Errorf("failed to get the newest offset of topic %q, partition %d, %w", topic, partition, err)
+	}
+	return offset, nil
+}
+
+// initOffsets resets the offsets of the claimed partitions according to the start position and the rewind,
+// it is called before the session starts consuming the claims.
+func (ks *kafkaSource) initOffsets(sess sarama.ConsumerGroupSession) error {
+	source := ks.source
+	if source.StartPosition == nil && source.Rewind == nil {
+		return nil
+	}
+	claims := sess.Claims()
+	if len(claims) == 0 {
+		return nil
+	}
+	committed, err := ks.adminClient.ListConsumerGroupOffsets(ks.groupName, claims)
+	if err != nil {
+		return fmt.Errorf("failed to list consumer group offsets, %w", err)
+	}
+	reset := false
+	for topic, partitions := range claims {
+		offsets, err := resolveStartOffsets(ks.saramaClient, source, topic, committed, partitions)
+		if err != nil {
+			return err
+		}
+		for partition, offset := range offsets {
+			ks.logger.Infow("Resetting the offset of the consumer group", zap.String("topic", topic), zap.Int32("partition", partition), zap.Int64("offset", offset))
+			// MarkOffset only moves the offset forward and ResetOffset only moves it backward, calling both sets it exactly.
+			sess.MarkOffset(topic, partition, offset, ks.offsetMetadata)
+			sess.ResetOffset(topic, partition, offset, ks.offsetMetadata)
+			reset = true
+		}
+	}
+	if reset {
+		sess.Commit()
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// fakeOffsetGetter returns the offsets by partition, the newest offset is 100 for all the partitions.
+type fakeOffsetGetter struct {
+	offsets map[int32]int64
+}
+
+func (f *fakeOffsetGetter) GetOffset(_ string, partition int32, t int64) (int64, error) {
+	if t == sarama.OffsetNewest {
+		return 100, nil
+	}
+	if o, ok := f.offsets[partition]; ok {
+		return o, nil
+	}
+	return -1, nil
+}
+
+func newTestCommitted(topic string, offsets map[int32]int64, metadata string) *sarama.OffsetFetchResponse {
+	resp := &sarama.OffsetFetchResponse{}
+	for p, o := range offsets {
+		resp.AddBlock(topic, p, &sarama.OffsetFetchResponseBlock{Offset: o, Metadata: metadata})
+	}
+	return resp
+}
+
+func TestInitialOffset(t *testing.T) {
+	assert.Equal(t, sarama.OffsetNewest, initialOffset(&dfv1.KafkaStartPosition{Type: dfv1.KafkaStartPositionLatest}))
+	assert.Equal(t, sarama.OffsetOldest, initialOffset(&dfv1.KafkaStartPosition{Type: dfv1.KafkaStartPositionEarliest}))
+	assert.Equal(t, sarama.OffsetOldest, initialOffset(&dfv1.KafkaStartPosition{Type: dfv1.KafkaStartPositionOffsets}))
+}
+
+func TestResolveStartOffsets(t *testing.T) {
+	ts := metav1.NewTime(time.Unix(1700000000, 0))
+	client := &fakeOffsetGetter{offsets: map[int32]int64{0: 10, 1: 20}}
+	partitions := []int32{0, 1, 2}
+
+	t.Run("no start position", func(t *testing.T) {
+		offsets, err := resolveStartOffsets(client, &dfv1.KafkaSource{}, "topic", nil, partitions)
+		assert.NoError(t, err)
+		assert.Empty(t, offsets)
+	})
+
+	t.Run("timestamp", func(t *testing.T) {
+		source := &dfv1.KafkaSource{StartPosition: &dfv1.KafkaStartPosition{Type: dfv1.KafkaStartPositionTimestamp, Timestamp: &ts}}
+		// partition 1 has a committed offset, and no message of partition 2 is after the timestamp.
+		offsets, err := resolveStartOffsets(client, source, "topic", newTestCommitted("topic", map[int32]int64{0: -1, 1: 5}, ""), partitions)
+		assert.NoError(t, err)
+		assert.Equal(t, map[int32]int64{0: 10, 2: 100}, offsets)
+	})
+
+	t.Run("offsets", func(t *testing.T) {
+		source := &dfv1.KafkaSource{StartPosition: &dfv1.KafkaStartPosition{Type: dfv1.KafkaStartPositionOffsets, Offsets: map[string]int64{"0": 3, "1": 4}}}
+		offsets, err := resolveStartOffsets(client, source, "topic", newTestCommitted("topic", map[int32]int64{1: 5}, ""), partitions)
+		assert.NoError(t, err)
+		assert.Equal(t, map[int32]int64{0: 3}, offsets)
+	})
+
+	t.Run("rewind", func(t *testing.T) {
+		source := &dfv1.KafkaSource{
+			StartPosition: &dfv1.KafkaStartPosition{Type: dfv1.KafkaStartPositionEarliest},
+			Rewind:        &dfv1.KafkaRewind{Timestamp: ts},
+		}
+		committed := newTestCommitted("topic", map[int32]int64{0: 50, 1: 60}, "")
+		committed.AddBlock("topic", 1, &sarama.OffsetFetchResponseBlock{Offset: 60, Metadata: rewindMetadata(source.Rewind)})
+		// partition 1 has been rewound.
+		offsets, err := resolveStartOffsets(client, source, "topic", committed, partitions)
+		assert.NoError(t, err)
+		assert.Equal(t, map[int32]int64{0: 10, 2: 100}, offsets)
+	})
+}
+
+func TestRewindMetadata(t *testing.T) {
+	assert.Equal(t, "", rewindMetadata(nil))
+	assert.Equal(t, "rewind:2023-11-14T22:13:20Z", rewindMetadata(&dfv1.KafkaRewind{Timestamp: metav1.NewTime(time.Unix(1700000000, 0))}))
+}
+
+func TestRewindGeneration(t *testing.T) {
+	assert.Equal(t, "", rewindGeneration(nil))
+	assert.Equal(t, "1700000000", rewindGeneration(&dfv1.KafkaRewind{Timestamp: metav1.NewTime(time.Unix(1700000000, 0))}))
+}
+
+func TestToReadMessageRewound(t *testing.T) {
+	ks := &kafkaSource{vertexName: "testVertex"}
+	msg := &sarama.ConsumerMessage{Topic: "topic", Partition: 1, Offset: 10, Value: []byte("value")}
+	assert.Equal(t, "topic:10:1", ks.toReadMessage(msg).ID.Offset)
+
+	// the same record gets a different ID after a rewind, while the read offset stays the same.
+	ks.rewindGeneration = rewindGeneration(&dfv1.KafkaRewind{Timestamp: metav1.NewTime(time.Unix(1700000000, 0))})
+	m := ks.toReadMessage(msg)
+	assert.Equal(t, "topic:10:1:r1700000000", m.ID.Offset)
+	assert.Equal(t, "topic:10:1", m.ReadOffset.String())
+}
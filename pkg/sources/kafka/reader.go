@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"sync"
 	"time"
@@ -36,20 +37,28 @@ import (
 )
 
 type kafkaSource struct {
-	vertexName    string              // name of the source vertex
-	pipelineName  string              // name of the pipeline
-	groupName     string              // group name for the source vertex
-	topic         string              // topic to consume messages from
-	brokers       []string            // kafka brokers
-	lifecycleCtx  context.Context     // lifecycle context
-	handler       *ConsumerHandler    // handler for a kafka consumer group
-	config        *sarama.Config      // sarama config for kafka consumer group
-	logger        *zap.SugaredLogger  // logger
-	stopCh        chan struct{}       // channel to indicate that we are done
-	handlerBuffer int                 // size of the buffer that holds consumed but yet to be forwarded messages
-	readTimeout   time.Duration       // read timeout for the from buffer
-	adminClient   sarama.ClusterAdmin // client used to calculate pending messages
-	saramaClient  sarama.Client       // sarama client
+	vertexName           string              // name of the source vertex
+	pipelineName         string              // name of the pipeline
+	groupName            string              // group name for the source vertex
+	topics               []string            // topics of the current consumer group session
+	topicsLock           sync.RWMutex        // lock for the topics and the partition indexes
+	partitionIdxs        partitionIdxMap     // indexes of the partitions of the topics when consuming more than one topic
+	topicPattern         *regexp.Regexp      // pattern of the topics to consume, nil if the topics are static
+	multiTopic           bool                // whether consuming more than one topic
+	topicRefreshInterval time.Duration       // how often to look for the topics matching the pattern
+	brokers              []string            // kafka brokers
+	lifecycleCtx         context.Context     // lifecycle context
+	handler              *ConsumerHandler    // handler for a kafka consumer group
+	config               *sarama.Config      // sarama config for kafka consumer group
+	logger               *zap.SugaredLogger  // logger
+	stopCh               chan struct{}       // channel to indicate that we are done
+	handlerBuffer        int                 // size of the buffer that holds consumed but yet to be forwarded messages
+	readTimeout          time.Duration       // read timeout for the from buffer
+	adminClient          sarama.ClusterAdmin // client used to calculate pending messages
+	saramaClient         sarama.Client       // sarama client
+	source               *dfv1.KafkaSource   // kafka source spec
+	offsetMetadata       string              // metadata committed along with the offsets
+	rewindGeneration     string              // generation of the rewind mixed into the message IDs, empty if no rewind
 }
 
 // NewKafkaSource returns a kafkaSource reader based on Kafka Consumer Group.
@@ -57,14 +66,19 @@ func NewKafkaSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, ha
 
 	source := vertexInstance.Vertex.Spec.Source.Kafka
 	ks := &kafkaSource{
-		vertexName:    vertexInstance.Vertex.Spec.Name,
-		pipelineName:  vertexInstance.Vertex.Spec.PipelineName,
-		topic:         source.Topic,
-		brokers:       source.Brokers,
-		readTimeout:   1 * time.Second, // default timeout
-		handlerBuffer: 100,             // default buffer size for kafka reads
-		handler:       handler,
-		logger:        logging.FromContext(ctx), // default logger
+		vertexName:           vertexInstance.Vertex.Spec.Name,
+		pipelineName:         vertexInstance.Vertex.Spec.PipelineName,
+		topics:               source.GetTopics(),
+		multiTopic:           source.TopicPattern != "" || len(source.GetTopics()) > 1,
+		topicRefreshInterval: source.GetTopicRefreshInterval(),
+		brokers:              source.Brokers,
+		source:               source,
+		offsetMetadata:       rewindMetadata(source.Rewind),
+		rewindGeneration:     rewindGeneration(source.Rewind),
+		readTimeout:          1 * time.Second, // default timeout
+		handlerBuffer:        100,             // default buffer size for kafka reads
+		handler:              handler,
+		logger:               logging.FromContext(ctx), // default logger
 	}
 
 	for _, o := range opts {
@@ -73,6 +87,14 @@ func NewKafkaSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, ha
 		}
 	}
 
+	if source.TopicPattern != "" {
+		pattern, err := regexp.Compile(source.TopicPattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid topic pattern %q, %w", source.TopicPattern, err)
+		}
+		ks.topicPattern = pattern
+	}
+
 	sarama.NewConfig()
 
 	config, err := configFromOpts(source.Config)
@@ -98,6 +120,10 @@ func NewKafkaSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, ha
 
 	sarama.Logger = zap.NewStdLog(ks.logger.Desugar())
 
+	if source.StartPosition != nil {
+		config.Consumer.Offsets.Initial = initialOffset(source.StartPosition)
+	}
+
 	// return errors from the underlying kafka client using the Errors channel
 	config.Consumer.Return.Errors = true
 	ks.config = config
@@ -123,6 +149,7 @@ func NewKafkaSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, ha
 		ks.adminClient = adminClient
 	}
 
+	ks.handler.initOffsets = ks.initOffsets
 	go ks.startConsumer()
 	// wait for the consumer to setup.
 	<-ks.handler.ready
@@ -135,14 +162,15 @@ func (ks *kafkaSource) GetName() string {
 	return ks.vertexName
 }
 
-// Partitions returns the partitions from which the source is reading.
+// Partitions returns the indexes of the partitions from which the source is reading, across all the topics.
 func (ks *kafkaSource) Partitions(context.Context) []int32 {
-	for topic, partitions := range ks.handler.sess.Claims() {
-		if topic == ks.topic {
-			return partitions
+	partitions := make([]int32, 0)
+	for topic, claimed := range ks.handler.sess.Claims() {
+		for _, partition := range claimed {
+			partitions = append(partitions, ks.partitionIndex(topic, partition))
 		}
 	}
-	return []int32{}
+	return partitions
 }
 
 func (ks *kafkaSource) Read(_ context.Context, count int64) ([]*isb.ReadMessage, error) {
@@ -175,7 +203,7 @@ func (ks *kafkaSource) Ack(_ context.Context, offsets []isb.Offset) []error {
 	defer close(ks.handler.inflightAcks)
 
 	for _, offset := range offsets {
-		topic := offset.(*kafkaOffset).Topic()
+		ko := offset.(*kafkaOffset)
 
 		pOffset, err := offset.Sequence()
 		if err != nil {
@@ -184,7 +212,7 @@ func (ks *kafkaSource) Ack(_ context.Context, offsets []isb.Offset) []error {
 			continue
 		}
 		// we need to mark the offset of the next message to read
-		ks.handler.sess.MarkOffset(topic, offset.PartitionIdx(), pOffset+1, "")
+		ks.handler.sess.MarkOffset(ko.Topic(), ko.Partition(), pOffset+1, ks.offsetMetadata)
 		kafkaSourceAckCount.With(map[string]string{metrics.LabelVertex: ks.vertexName, metrics.LabelPipeline: ks.pipelineName}).Inc()
 
 	}
@@ -210,12 +238,16 @@ func (ks *kafkaSource) Pending(_ context.Context) (int64, error) {
 	if ks.adminClient == nil || ks.saramaClient == nil {
 		return isb.PendingNotAvailable, nil
 	}
-	partitions, err := ks.saramaClient.Partitions(ks.topic)
-	if err != nil {
-		return isb.PendingNotAvailable, fmt.Errorf("failed to get partitions, %w", err)
+	topicPartitions := make(map[string][]int32)
+	for _, topic := range ks.getTopics() {
+		partitions, err := ks.saramaClient.Partitions(topic)
+		if err != nil {
+			return isb.PendingNotAvailable, fmt.Errorf("failed to get partitions of topic %q, %w", topic, err)
+		}
+		topicPartitions[topic] = partitions
 	}
 	totalPending := int64(0)
-	rep, err := ks.adminClient.ListConsumerGroupOffsets(ks.groupName, map[string][]int32{ks.topic: partitions})
+	rep, err := ks.adminClient.ListConsumerGroupOffsets(ks.groupName, topicPartitions)
 	if err != nil {
 		err := ks.refreshAdminClient()
 		if err != nil {
@@ -223,21 +255,25 @@ func (ks *kafkaSource) Pending(_ context.Context) (int64, error) {
 		}
 		return isb.PendingNotAvailable, fmt.Errorf("failed to list consumer group offsets, %w", err)
 	}
-	for _, partition := range partitions {
-		block := rep.GetBlock(ks.topic, partition)
-		if block.Offset == -1 {
-			// Note: if there is no offset associated with the partition under the consumer group, offset fetch sets the offset field to -1.
-			// This is not an error and usually means that there has been no data published to this particular partition yet.
-			// In this case, we can safely skip this partition from the pending calculation.
-			continue
-		}
-		partitionOffset, err := ks.saramaClient.GetOffset(ks.topic, partition, sarama.OffsetNewest)
-		if err != nil {
-			return isb.PendingNotAvailable, fmt.Errorf("failed to get offset of topic %q, partition %v, %w", ks.topic, partition, err)
+	for topic, partitions := range topicPartitions {
+		topicPending := int64(0)
+		for _, partition := range partitions {
+			block := rep.GetBlock(topic, partition)
+			if block == nil || block.Offset == -1 {
+				// Note: if there is no offset associated with the partition under the consumer group, offset fetch sets the offset field to -1.
+				// This is not an error and usually means that there has been no data published to this particular partition yet.
+				// In this case, we can safely skip this partition from the pending calculation.
+				continue
+			}
+			partitionOffset, err := ks.saramaClient.GetOffset(topic, partition, sarama.OffsetNewest)
+			if err != nil {
+				return isb.PendingNotAvailable, fmt.Errorf("failed to get offset of topic %q, partition %v, %w", topic, partition, err)
+			}
+			topicPending += partitionOffset - block.Offset
 		}
-		totalPending += partitionOffset - block.Offset
+		kafkaPending.WithLabelValues(ks.vertexName, ks.pipelineName, topic, ks.groupName).Set(float64(topicPending))
+		totalPending += topicPending
 	}
-	kafkaPending.WithLabelValues(ks.vertexName, ks.pipelineName, ks.topic, ks.groupName).Set(float64(totalPending))
 	return totalPending, nil
 }
 
@@ -268,7 +304,7 @@ func configFromOpts(yamlConfig string) (*sarama.Config, error) {
 
 func (ks *kafkaSource) startConsumer() {
 	consumerGroup, err := sarama.NewConsumerGroupFromClient(ks.groupName, ks.saramaClient)
-	ks.logger.Infow("creating NewConsumerGroup", zap.String("topic", ks.topic), zap.String("consumerGroupName", ks.groupName), zap.Strings("brokers", ks.brokers))
+	ks.logger.Infow("creating NewConsumerGroup", zap.Strings("topics", ks.getTopics()), zap.String("topicPattern", ks.source.TopicPattern), zap.String("consumerGroupName", ks.groupName), zap.Strings("brokers", ks.brokers))
 	if err != nil {
 		ks.logger.Panicw("Problem initializing sarama consumerGroup", zap.Error(err))
 	}
@@ -294,7 +330,34 @@ func (ks *kafkaSource) startConsumer() {
 			// `Consume` should be called inside an infinite loop; when a
 			// server-side re-balance happens, the consumer session will need to be
 			// recreated to get the new claims
-			if conErr := consumerGroup.Consume(ks.lifecycleCtx, []string{ks.topic}, ks.handler); conErr != nil {
+			topics, err := ks.listTopics()
+			if err != nil || len(topics) == 0 {
+				ks.logger.Warnw("No topics to consume, retrying later", zap.Error(err))
+				select {
+				case <-ks.lifecycleCtx.Done():
+					return
+				case <-time.After(ks.topicRefreshInterval):
+					continue
+				}
+			}
+			partitions, err := ks.listPartitions(topics)
+			if err != nil {
+				ks.logger.Warnw("Failed to list the partitions of the topics, retrying later", zap.Error(err))
+				select {
+				case <-ks.lifecycleCtx.Done():
+					return
+				case <-time.After(ks.topicRefreshInterval):
+					continue
+				}
+			}
+			ks.setTopics(topics, partitions)
+			sessionCtx, cancel := context.WithCancel(ks.lifecycleCtx)
+			if ks.topicPattern != nil {
+				go ks.watchTopics(sessionCtx, cancel, topics)
+			}
+			conErr := consumerGroup.Consume(sessionCtx, topics, ks.handler)
+			cancel()
+			if conErr != nil {
 				if errors.Is(err, sarama.ErrClosedConsumerGroup) {
 					return
 				}
@@ -317,10 +380,16 @@ func (ks *kafkaSource) startConsumer() {
 func (ks *kafkaSource) toReadMessage(m *sarama.ConsumerMessage) *isb.ReadMessage {
 	readOffset := &kafkaOffset{
 		offset:       m.Offset,
-		partitionIdx: m.Partition,
+		partition:    m.Partition,
+		partitionIdx: ks.partitionIndex(m.Topic, m.Partition),
 		topic:        m.Topic,
 	}
 
+	id := readOffset.String()
+	if ks.rewindGeneration != "" {
+		id += ":r" + ks.rewindGeneration
+	}
+
 	var body = isb.Body{Payload: m.Value}
 	var headers = make(map[string]string, len(m.Headers))
 	for _, header := range m.Headers {
@@ -332,7 +401,7 @@ func (ks *kafkaSource) toReadMessage(m *sarama.ConsumerMessage) *isb.ReadMessage
 			MessageInfo: isb.MessageInfo{EventTime: m.Timestamp},
 			ID: isb.MessageID{
 				VertexName: ks.vertexName,
-				Offset:     readOffset.String(),
+				Offset:     id,
 				Index:      readOffset.PartitionIdx(),
 			},
 			Keys:    []string{string(m.Key)},
@@ -0,0 +1,190 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// topicPartition identifies a partition of a topic.
+type topicPartition struct {
+	topic     string
+	partition int32
+}
+
+// partitionIdxMap is the indexes of the partitions of the topics.
+type partitionIdxMap map[topicPartition]int32
+
+// hashPartitionIndex returns the index of a topic partition, which is a non-negative FNV-1a hash of the topic name and
+// the partition number, so that it doesn't change when the other topics or their partitions are changed.
+func hashPartitionIndex(tp topicPartition) int32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(tp.topic))
+	_ = binary.Write(h, binary.BigEndian, tp.partition)
+	return int32(h.Sum32() & math.MaxInt32)
+}
+
+// newPartitionIdxMap assigns the hash indexes to the partitions of the topics. A partition whose hash collides with a
+// partition visited before gets the next free index, the partitions are visited in the order of the topic names and
+// then the partition numbers, so that the replicas consuming the same topics get the same indexes. It returns the
+// partitions whose indexes are not their hashes.
+func newPartitionIdxMap(partitions map[string][]int32) (partitionIdxMap, []topicPartition) {
+	topics := make([]string, 0, len(partitions))
+	for t := range partitions {
+		topics = append(topics, t)
+	}
+	sort.Strings(topics)
+	indexes := make(partitionIdxMap)
+	used := make(map[int32]struct{})
+	var collided []topicPartition
+	for _, t := range topics {
+		ps := slices.Clone(partitions[t])
+		slices.Sort(ps)
+		for _, p := range ps {
+			tp := topicPartition{topic: t, partition: p}
+			idx := hashPartitionIndex(tp)
+			if _, ok := used[idx]; ok {
+				collided = append(collided, tp)
+				for {
+					idx = (idx + 1) & math.MaxInt32
+					if _, ok := used[idx]; !ok {
+						break
+					}
+				}
+			}
+			used[idx] = struct{}{}
+			indexes[tp] = idx
+		}
+	}
+	return indexes, collided
+}
+
+// partitionIndex returns the index of a topic partition, which is used as the partition of the source watermark.
+// It's the partition number when consuming a single topic, otherwise it's the hash index assigned to the partition
+// when the current consumer group session started, or the hash of a partition not known by the current session, e.g.
+// a message of a previous session.
+func (ks *kafkaSource) partitionIndex(topic string, partition int32) int32 {
+	if !ks.multiTopic {
+		return partition
+	}
+	tp := topicPartition{topic: topic, partition: partition}
+	ks.topicsLock.RLock()
+	defer ks.topicsLock.RUnlock()
+	if idx, ok := ks.partitionIdxs[tp]; ok {
+		return idx
+	}
+	return hashPartitionIndex(tp)
+}
+
+// matchTopics returns the sorted topics matching the pattern, the internal topics starting with "__" are excluded.
+func matchTopics(topics []string, pattern *regexp.Regexp) []string {
+	var matched []string
+	for _, t := range topics {
+		if strings.HasPrefix(t, "__") || !pattern.MatchString(t) {
+			continue
+		}
+		matched = append(matched, t)
+	}
+	sort.Strings(matched)
+	return matched
+}
+
+// listTopics returns the topics to consume, the topics matching the pattern are listed from the brokers.
+func (ks *kafkaSource) listTopics() ([]string, error) {
+	if ks.topicPattern == nil {
+		return ks.source.GetTopics(), nil
+	}
+	if err := ks.saramaClient.RefreshMetadata(); err != nil {
+		return nil, fmt.Errorf("failed to refresh the metadata, %w", err)
+	}
+	topics, err := ks.saramaClient.Topics()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the topics, %w", err)
+	}
+	return matchTopics(topics, ks.topicPattern), nil
+}
+
+// listPartitions returns the partitions of the topics, it's only needed when consuming more than one topic.
+func (ks *kafkaSource) listPartitions(topics []string) (map[string][]int32, error) {
+	if !ks.multiTopic {
+		return nil, nil
+	}
+	partitions := make(map[string][]int32, len(topics))
+	for _, t := range topics {
+		ps, err := ks.saramaClient.Partitions(t)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the partitions of topic %q, %w", t, err)
+		}
+		partitions[t] = ps
+	}
+	return partitions, nil
+}
+
+// getTopics returns the topics of the current consumer group session.
+func (ks *kafkaSource) getTopics() []string {
+	ks.topicsLock.RLock()
+	defer ks.topicsLock.RUnlock()
+	return ks.topics
+}
+
+// setTopics sets the topics of the new consumer group session, and the indexes of their partitions.
+func (ks *kafkaSource) setTopics(topics []string, partitions map[string][]int32) {
+	ks.topicsLock.Lock()
+	defer ks.topicsLock.Unlock()
+	ks.topics = topics
+	var collided []topicPartition
+	ks.partitionIdxs, collided = newPartitionIdxMap(partitions)
+	for _, tp := range collided {
+		// the index might change when the colliding partitions are changed.
+		ks.logger.Warnw("The hash index of the partition collides with another partition, the next free index is used", zap.String("topic", tp.topic), zap.Int32("partition", tp.partition), zap.Int32("index", ks.partitionIdxs[tp]))
+	}
+}
+
+// watchTopics looks for the topics matching the pattern periodically, and cancels the consumer group session
+// when they are changed, so that a new session is started with the new topics.
+func (ks *kafkaSource) watchTopics(ctx context.Context, cancel context.CancelFunc, topics []string) {
+	ticker := time.NewTicker(ks.topicRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			newTopics, err := ks.listTopics()
+			if err != nil {
+				ks.logger.Errorw("Failed to list the topics matching the pattern", zap.Error(err))
+				continue
+			}
+			if !slices.Equal(topics, newTopics) {
+				ks.logger.Infow("Topics matching the pattern changed, restarting the consumer group session", zap.Strings("topics", newTopics))
+				cancel()
+				return
+			}
+		}
+	}
+}
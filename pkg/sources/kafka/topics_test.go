@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionIndex(t *testing.T) {
+	ks := &kafkaSource{}
+	assert.Equal(t, int32(3), ks.partitionIndex("topic-a", 3))
+
+	ks.multiTopic = true
+	ks.setTopics([]string{"topic-b", "topic-a"}, map[string][]int32{
+		"topic-b": {1, 0},
+		"topic-a": {0, 2048, 1},
+	})
+	indexes := make(map[int32]struct{})
+	for tp, idx := range ks.partitionIdxs {
+		// the indexes are the hashes of the partitions.
+		assert.Equal(t, hashPartitionIndex(tp), idx)
+		assert.Equal(t, idx, ks.partitionIndex(tp.topic, tp.partition))
+		assert.GreaterOrEqual(t, idx, int32(0))
+		indexes[idx] = struct{}{}
+	}
+	assert.Len(t, indexes, 5)
+	// an unknown partition gets its hash.
+	assert.Equal(t, hashPartitionIndex(topicPartition{topic: "topic-c", partition: 1}), ks.partitionIndex("topic-c", 1))
+
+	// the indexes don't change when the topics are changed.
+	idx := ks.partitionIndex("topic-b", 1)
+	ks.setTopics([]string{"topic-a", "topic-b", "topic-0"}, map[string][]int32{
+		"topic-0": {0, 1},
+		"topic-a": {0},
+		"topic-b": {0, 1, 2},
+	})
+	assert.Equal(t, idx, ks.partitionIndex("topic-b", 1))
+}
+
+func TestNewPartitionIdxMap(t *testing.T) {
+	// the hashes of the partitions collide.
+	a, b := topicPartition{topic: "topic-82801"}, topicPartition{topic: "topic-699900"}
+	assert.Equal(t, hashPartitionIndex(a), hashPartitionIndex(b))
+	indexes, collided := newPartitionIdxMap(map[string][]int32{a.topic: {0}, b.topic: {0}})
+	// "topic-699900" is visited first, and keeps its hash.
+	assert.Equal(t, hashPartitionIndex(b), indexes[b])
+	assert.Equal(t, hashPartitionIndex(a)+1, indexes[a])
+	assert.Equal(t, []topicPartition{a}, collided)
+
+	// the replicas with the same topics get the same indexes.
+	indexes, collided = newPartitionIdxMap(map[string][]int32{b.topic: {0}, a.topic: {0}})
+	assert.Equal(t, hashPartitionIndex(a)+1, indexes[a])
+	assert.Equal(t, []topicPartition{a}, collided)
+}
+
+func TestMatchTopics(t *testing.T) {
+	topics := []string{"orders-us", "__consumer_offsets", "orders-eu", "payments"}
+	assert.Equal(t, []string{"orders-eu", "orders-us"}, matchTopics(topics, regexp.MustCompile("^orders-.*")))
+	assert.Equal(t, []string{"orders-eu", "orders-us", "payments"}, matchTopics(topics, regexp.MustCompile(".*")))
+	assert.Nil(t, matchTopics(topics, regexp.MustCompile("^invoices$")))
+}
+
+func TestToReadMessageMultiTopic(t *testing.T) {
+	ks := &kafkaSource{vertexName: "testVertex", multiTopic: true}
+	ks.setTopics([]string{"topic-a", "topic-b"}, map[string][]int32{"topic-a": {0, 1, 2}, "topic-b": {0}})
+	m := ks.toReadMessage(&sarama.ConsumerMessage{Topic: "topic-a", Partition: 2, Offset: 10, Value: []byte("value")})
+	offset := m.ReadOffset.(*kafkaOffset)
+	assert.Equal(t, "topic-a:10:2", offset.String())
+	assert.Equal(t, int32(2), offset.Partition())
+	assert.Equal(t, hashPartitionIndex(topicPartition{topic: "topic-a", partition: 2}), offset.PartitionIdx())
+	assert.Equal(t, offset.PartitionIdx(), m.ID.Index)
+}
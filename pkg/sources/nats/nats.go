@@ -28,8 +28,8 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/metrics"
+	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
-	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
 )
 
@@ -75,44 +75,11 @@ func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Optio
 			n.logger.Info("Nats reconnected")
 		}),
 	}
-	if source.TLS != nil {
-		if c, err := sharedutil.GetTLSConfig(source.TLS); err != nil {
-			return nil, err
-		} else {
-			opt = append(opt, natslib.Secure(c))
-		}
-	}
-
-	if source.Auth != nil {
-		switch {
-		case source.Auth.Basic != nil && source.Auth.Basic.User != nil && source.Auth.Basic.Password != nil:
-			username, err := sharedutil.GetSecretFromVolume(source.Auth.Basic.User)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get basic auth user, %w", err)
-			}
-			password, err := sharedutil.GetSecretFromVolume(source.Auth.Basic.Password)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get basic auth password, %w", err)
-			}
-			opt = append(opt, natslib.UserInfo(username, password))
-		case source.Auth.Token != nil:
-			token, err := sharedutil.GetSecretFromVolume(source.Auth.Token)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get auth token, %w", err)
-			}
-			opt = append(opt, natslib.Token(token))
-		case source.Auth.NKey != nil:
-			nkeyFile, err := sharedutil.GetSecretVolumePath(source.Auth.NKey)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get configured nkey file, %w", err)
-			}
-			o, err := natslib.NkeyOptionFromSeed(nkeyFile)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get NKey, %w", err)
-			}
-			opt = append(opt, o)
-		}
+	connOpts, err := natsclient.ConnectionOptions(source.TLS, source.Auth)
+	if err != nil {
+		return nil, err
 	}
+	opt = append(opt, connOpts...)
 
 	n.logger.Info("Connecting to nats service...")
 	if conn, err := natslib.Connect(source.URL, opt...); err != nil {
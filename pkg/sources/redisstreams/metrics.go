@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redisstreams
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// redisStreamsSourceReadCount is used to indicate the number of entries read by the redis streams source vertex
+var redisStreamsSourceReadCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "redis_streams_source",
+	Name:      "read_total",
+	Help:      "Total number of entries read",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// redisStreamsSourceReadErrors is used to indicate the number of errors while reading from the redis stream
+var redisStreamsSourceReadErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "redis_streams_source",
+	Name:      "read_error_total",
+	Help:      "Total number of read errors",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// redisStreamsSourceClaimCount is used to indicate the number of idle entries claimed from the other consumers
+var redisStreamsSourceClaimCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "redis_streams_source",
+	Name:      "claim_total",
+	Help:      "Total number of idle entries claimed from the other consumers",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// redisStreamsSourceAckCount is used to indicate the number of entries acknowledged by the redis streams source vertex
+var redisStreamsSourceAckCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "redis_streams_source",
+	Name:      "ack_total",
+	Help:      "Total number of entries acknowledged",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// redisStreamsSourceAckErrors is used to indicate the number of errors while acknowledging the entries
+var redisStreamsSourceAckErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "redis_streams_source",
+	Name:      "ack_error_total",
+	Help:      "Total number of acknowledgement errors",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// redisStreamsSourcePending is used to indicate the number of entries pending in the consumer group
+var redisStreamsSourcePending = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "redis_streams_source",
+	Name:      "pending_total",
+	Help:      "Number of entries not delivered or not acknowledged yet",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redisstreams
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// redisStreamsOffset implements isb.Offset, it's the ID of a stream entry.
+type redisStreamsOffset struct {
+	id           string
+	partitionIdx int32
+}
+
+func (r *redisStreamsOffset) String() string {
+	return r.id
+}
+
+// Sequence returns the millisecond timestamp of the entry ID.
+func (r *redisStreamsOffset) Sequence() (int64, error) {
+	ms, _, err := parseEntryID(r.id)
+	return ms, err
+}
+
+// AckIt acking is taken care by the source, which acknowledges the entries to the consumer group.
+func (r *redisStreamsOffset) AckIt() error {
+	// NOOP
+	return nil
+}
+
+func (r *redisStreamsOffset) NoAck() error {
+	return nil
+}
+
+func (r *redisStreamsOffset) PartitionIdx() int32 {
+	return r.partitionIdx
+}
+
+// parseEntryID parses a stream entry ID in the format of "<millisecondsTime>-<sequenceNumber>".
+func parseEntryID(id string) (int64, int64, error) {
+	msPart, seqPart, found := strings.Cut(id, "-")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid stream entry ID %q", id)
+	}
+	ms, err := strconv.ParseInt(msPart, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid stream entry ID %q, %w", id, err)
+	}
+	seq, err := strconv.ParseInt(seqPart, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid stream entry ID %q, %w", id, err)
+	}
+	return ms, seq, nil
+}
+
+// entryTime returns the time of a stream entry, which is the millisecond timestamp in the ID.
+func entryTime(id string) (time.Time, error) {
+	ms, _, err := parseEntryID(id)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(ms), nil
+}
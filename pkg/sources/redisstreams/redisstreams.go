@@ -0,0 +1,309 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redisstreams
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/sources/sourcer"
+)
+
+type redisStreamsSource struct {
+	*redisclient.RedisStreamsRead
+	vertexName    string
+	pipelineName  string
+	vertexReplica int32
+	readTimeout   time.Duration
+	// claimMinIdle is how long an entry stays unacknowledged before it's claimed from the other consumers, 0 disables it.
+	claimMinIdle time.Duration
+	// claimCursor is where the ongoing scan of the idle entries continues from, "0-0" means a new scan.
+	claimCursor string
+	// lastClaim is when the last scan of the idle entries completed.
+	lastClaim time.Time
+	logger    *zap.SugaredLogger
+}
+
+func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Option) (sourcer.SourceReader, error) {
+	source := vertexInstance.Vertex.Spec.Source.RedisStreams
+	rs := &redisStreamsSource{
+		vertexName:    vertexInstance.Vertex.Spec.Name,
+		pipelineName:  vertexInstance.Vertex.Spec.PipelineName,
+		vertexReplica: vertexInstance.Replica,
+		readTimeout:   1 * time.Second, // default timeout
+		claimMinIdle:  source.GetClaimMinIdleTime(),
+		claimCursor:   "0-0",
+		logger:        logging.FromContext(ctx),
+	}
+	for _, o := range opts {
+		if err := o(rs); err != nil {
+			return nil, err
+		}
+	}
+
+	redisOpts, err := clientOptions(source)
+	if err != nil {
+		return nil, err
+	}
+	client := redisclient.NewRedisClient(redisOpts)
+	group := source.GetConsumerGroup(rs.pipelineName, rs.vertexName)
+	if err := createConsumerGroup(ctx, client, source.Stream, group, source.ReadFromBeginning); err != nil {
+		_ = client.Client.Close()
+		return nil, err
+	}
+
+	labels := map[string]string{metrics.LabelVertex: rs.vertexName, metrics.LabelPipeline: rs.pipelineName}
+	rs.RedisStreamsRead = &redisclient.RedisStreamsRead{
+		Name:         rs.vertexName,
+		Stream:       source.Stream,
+		Group:        group,
+		Consumer:     fmt.Sprintf("%s-%d", rs.vertexName, rs.vertexReplica),
+		PartitionIdx: rs.vertexReplica,
+		RedisClient:  client,
+		Options: redisclient.Options{
+			ReadTimeOut: rs.readTimeout,
+			// the entries delivered to the consumer but not acknowledged before restarting are read first.
+			CheckBackLog: true,
+		},
+		Log: rs.logger,
+		Metrics: redisclient.Metrics{
+			ReadErrorsInc: func() { redisStreamsSourceReadErrors.With(labels).Inc() },
+			ReadsAdd:      func(n int) { redisStreamsSourceReadCount.With(labels).Add(float64(n)) },
+			AcksAdd:       func(n int) { redisStreamsSourceAckCount.With(labels).Add(float64(n)) },
+			AckErrorsAdd:  func(n int) { redisStreamsSourceAckErrors.With(labels).Add(float64(n)) },
+		},
+	}
+	rs.XStreamToMessages = rs.toMessages
+	return rs, nil
+}
+
+// Read reads the entries claimed from the other consumers first, and then the entries of its own.
+func (rs *redisStreamsSource) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	if rs.claimMinIdle > 0 && time.Since(rs.lastClaim) >= rs.claimMinIdle {
+		msgs, err := rs.claimIdle(ctx, count)
+		if err != nil || len(msgs) > 0 {
+			return msgs, err
+		}
+	}
+	return rs.RedisStreamsRead.Read(ctx, count)
+}
+
+// claimIdle claims the entries which are delivered to any consumer of the group but not acknowledged for longer than
+// the min idle time, e.g. the entries delivered to a replica which has been scaled down, with XAUTOCLAIM. The pending
+// entries of the group are scanned over the reads, a new scan starts after the min idle time since the last one.
+func (rs *redisStreamsSource) claimIdle(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	entries, next, err := rs.Client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   rs.Stream,
+		Group:    rs.Group,
+		Consumer: rs.Consumer,
+		MinIdle:  rs.claimMinIdle,
+		Start:    rs.claimCursor,
+		Count:    count,
+	}).Result()
+	if err != nil {
+		redisStreamsSourceReadErrors.With(map[string]string{metrics.LabelVertex: rs.vertexName, metrics.LabelPipeline: rs.pipelineName}).Inc()
+		return nil, fmt.Errorf("failed to claim the idle entries of consumer group %q, %w", rs.Group, err)
+	}
+	if next == "0-0" {
+		rs.lastClaim = time.Now()
+	}
+	rs.claimCursor = next
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	rs.logger.Infow("Claimed the idle entries", zap.Int("count", len(entries)))
+	redisStreamsSourceClaimCount.With(map[string]string{metrics.LabelVertex: rs.vertexName, metrics.LabelPipeline: rs.pipelineName}).Add(float64(len(entries)))
+	return rs.toMessages([]redis.XStream{{Stream: rs.Stream, Messages: entries}}, make([]*isb.ReadMessage, 0, len(entries)), nil)
+}
+
+type Option func(*redisStreamsSource) error
+
+// WithLogger is used to return logger information
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(o *redisStreamsSource) error {
+		o.logger = l
+		return nil
+	}
+}
+
+// WithReadTimeout sets the read timeout, which is how long a read blocks waiting for the new entries.
+func WithReadTimeout(t time.Duration) Option {
+	return func(o *redisStreamsSource) error {
+		// a zero block duration blocks forever.
+		if t > 0 {
+			o.readTimeout = t
+		}
+		return nil
+	}
+}
+
+// clientOptions returns the options of the Redis client of the source.
+func clientOptions(source *dfv1.RedisStreamsSource) (*redis.UniversalOptions, error) {
+	opts := &redis.UniversalOptions{
+		Username:     source.User,
+		MasterName:   source.MasterName,
+		MaxRedirects: 3,
+	}
+	if source.URL != "" {
+		opts.Addrs = strings.Split(source.URL, ",")
+		opts.MasterName = ""
+	} else if source.SentinelURL != "" {
+		opts.Addrs = strings.Split(source.SentinelURL, ",")
+	}
+	if source.Password != nil {
+		password, err := sharedutil.GetSecretFromVolume(source.Password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the redis password, %w", err)
+		}
+		opts.Password = password
+	}
+	if source.SentinelPassword != nil {
+		password, err := sharedutil.GetSecretFromVolume(source.SentinelPassword)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the redis sentinel password, %w", err)
+		}
+		opts.SentinelPassword = password
+	}
+	if source.TLS != nil {
+		c, err := sharedutil.GetTLSConfig(source.TLS)
+		if err != nil {
+			return nil, err
+		}
+		opts.TLSConfig = c
+	}
+	return opts, nil
+}
+
+// createConsumerGroup creates the consumer group and the stream if they don't exist.
+func createConsumerGroup(ctx context.Context, client *redisclient.RedisClient, stream, group string, fromBeginning bool) error {
+	start := redisclient.ReadFromLatest
+	if fromBeginning {
+		start = redisclient.ReadFromEarliest
+	}
+	if err := client.CreateStreamGroup(ctx, stream, group, start); err != nil && !redisclient.IsAlreadyExistError(err) {
+		return fmt.Errorf("failed to create consumer group %q of stream %q, %w", group, stream, err)
+	}
+	return nil
+}
+
+// toMessages converts the stream entries to the messages. The payload of an entry with a single field is the value,
+// and the field is the key of the message, otherwise the payload is the JSON object of all the fields.
+func (rs *redisStreamsSource) toMessages(xstreams []redis.XStream, messages []*isb.ReadMessage, _ map[string]string) ([]*isb.ReadMessage, error) {
+	var deleted []string
+	for _, xstream := range xstreams {
+		for _, entry := range xstream.Messages {
+			if len(entry.Values) == 0 {
+				// the entry was deleted from the stream after being delivered.
+				deleted = append(deleted, entry.ID)
+				continue
+			}
+			m, err := rs.newReadMessage(entry)
+			if err != nil {
+				return messages, err
+			}
+			messages = append(messages, m)
+		}
+	}
+	if len(deleted) > 0 {
+		rs.logger.Warnw("Acknowledging the pending entries deleted from the stream", zap.Strings("ids", deleted))
+		if err := rs.Client.XAck(redisclient.RedisContext, rs.Stream, rs.Group, deleted...).Err(); err != nil {
+			return messages, fmt.Errorf("failed to acknowledge the deleted entries, %w", err)
+		}
+	}
+	return messages, nil
+}
+
+func (rs *redisStreamsSource) newReadMessage(entry redis.XMessage) (*isb.ReadMessage, error) {
+	eventTime, err := entryTime(entry.ID)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	var payload []byte
+	if len(entry.Values) == 1 {
+		for k, v := range entry.Values {
+			keys = []string{k}
+			payload = []byte(fmt.Sprint(v))
+		}
+	} else if payload, err = json.Marshal(entry.Values); err != nil {
+		return nil, fmt.Errorf("failed to marshal the fields of entry %q, %w", entry.ID, err)
+	}
+	readOffset := &redisStreamsOffset{id: entry.ID, partitionIdx: rs.vertexReplica}
+	// the message ID only depends on the entry, an entry claimed by another replica keeps the same ID for the dedup.
+	return &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+				ID: isb.MessageID{
+					VertexName: rs.vertexName,
+					Offset:     readOffset.String(),
+				},
+				Keys: keys,
+			},
+			Body: isb.Body{
+				Payload: payload,
+			},
+		},
+		ReadOffset: readOffset,
+	}, nil
+}
+
+// Partitions returns the partitions associated with this source.
+func (rs *redisStreamsSource) Partitions(context.Context) []int32 {
+	return []int32{rs.vertexReplica}
+}
+
+// Pending returns the number of entries which are delivered to the consumer group but not acknowledged, plus the
+// number of entries not delivered yet. The latter is only available with Redis 7.0 or later.
+func (rs *redisStreamsSource) Pending(ctx context.Context) (int64, error) {
+	pending, err := rs.PendingMsgCount(ctx, rs.Stream, rs.Group)
+	if err != nil {
+		return isb.PendingNotAvailable, fmt.Errorf("failed to get the pending entries of consumer group %q, %w", rs.Group, err)
+	}
+	groups, err := rs.StreamGroupInfo(ctx, rs.Stream)
+	if err != nil {
+		return isb.PendingNotAvailable, fmt.Errorf("failed to get the consumer groups of stream %q, %w", rs.Stream, err)
+	}
+	for _, group := range groups {
+		if group.Name == rs.Group && group.Lag > 0 {
+			pending += group.Lag
+		}
+	}
+	redisStreamsSourcePending.With(map[string]string{metrics.LabelVertex: rs.vertexName, metrics.LabelPipeline: rs.pipelineName}).Set(float64(pending))
+	return pending, nil
+}
+
+func (rs *redisStreamsSource) Close() error {
+	rs.logger.Info("Shutting down redis streams source...")
+	if err := rs.Client.Close(); err != nil {
+		rs.logger.Errorw("Failed to close the redis client", zap.Error(err))
+	}
+	rs.logger.Info("Redis streams source shutdown")
+	return nil
+}
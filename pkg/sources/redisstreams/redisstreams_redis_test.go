@@ -0,0 +1,151 @@
+//go:build isb_redis
+
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redisstreams
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+func TestRedisStreamsSource_ReadAckPending(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream := "test-redis-streams-source"
+	client := redis.NewUniversalClient(&redis.UniversalOptions{Addrs: []string{":6379"}})
+	defer func() {
+		_ = client.Del(ctx, stream).Err()
+		_ = client.Close()
+	}()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, client.XAdd(ctx, &redis.XAddArgs{Stream: stream, Values: map[string]interface{}{"k": i}}).Err())
+	}
+
+	vi := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pl-in"},
+			Spec: dfv1.VertexSpec{
+				PipelineName: "test-pl",
+				AbstractVertex: dfv1.AbstractVertex{
+					Name: "in",
+					Source: &dfv1.Source{RedisStreams: &dfv1.RedisStreamsSource{
+						RedisConfig:       dfv1.RedisConfig{URL: ":6379"},
+						Stream:            stream,
+						ReadFromBeginning: true,
+					}},
+				},
+			},
+		},
+	}
+	rs, err := New(ctx, vi, WithReadTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+	defer func() { _ = rs.Close() }()
+
+	pending, err := rs.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), pending)
+
+	msgs, err := rs.Read(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	assert.Equal(t, "0", string(msgs[0].Payload))
+	// the entries are delivered but not acknowledged.
+	pending, err = rs.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), pending)
+
+	var offsets []isb.Offset
+	for _, m := range msgs[:2] {
+		offsets = append(offsets, m.ReadOffset)
+	}
+	for _, err := range rs.Ack(ctx, offsets) {
+		assert.NoError(t, err)
+	}
+	pending, err = rs.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), pending)
+}
+
+func TestRedisStreamsSource_ClaimIdle(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream := "test-redis-streams-source-claim"
+	client := redis.NewUniversalClient(&redis.UniversalOptions{Addrs: []string{":6379"}})
+	defer func() {
+		_ = client.Del(ctx, stream).Err()
+		_ = client.Close()
+	}()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, client.XAdd(ctx, &redis.XAddArgs{Stream: stream, Values: map[string]interface{}{"k": i}}).Err())
+	}
+
+	newVertexInstance := func(replica int32) *dfv1.VertexInstance {
+		return &dfv1.VertexInstance{
+			Vertex: &dfv1.Vertex{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pl-in"},
+				Spec: dfv1.VertexSpec{
+					PipelineName: "test-pl",
+					AbstractVertex: dfv1.AbstractVertex{
+						Name: "in",
+						Source: &dfv1.Source{RedisStreams: &dfv1.RedisStreamsSource{
+							RedisConfig:       dfv1.RedisConfig{URL: ":6379"},
+							Stream:            stream,
+							ReadFromBeginning: true,
+							ClaimMinIdleTime:  &metav1.Duration{Duration: 100 * time.Millisecond},
+						}},
+					},
+				},
+			},
+			Replica: replica,
+		}
+	}
+	// replica 1 reads the entries and is scaled down without acknowledging them.
+	scaledDown, err := New(ctx, newVertexInstance(1), WithReadTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+	msgs, err := scaledDown.Read(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	require.NoError(t, scaledDown.Close())
+
+	rs, err := New(ctx, newVertexInstance(0), WithReadTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+	defer func() { _ = rs.Close() }()
+	time.Sleep(200 * time.Millisecond)
+	msgs, err = rs.Read(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	var offsets []isb.Offset
+	for _, m := range msgs {
+		offsets = append(offsets, m.ReadOffset)
+	}
+	for _, err := range rs.Ack(ctx, offsets) {
+		assert.NoError(t, err)
+	}
+	pending, err := rs.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), pending)
+}
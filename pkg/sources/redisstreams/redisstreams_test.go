@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redisstreams
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestParseEntryID(t *testing.T) {
+	ms, seq, err := parseEntryID("1700000000123-5")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1700000000123), ms)
+	assert.Equal(t, int64(5), seq)
+	for _, id := range []string{"1700000000123", "a-1", "1-b"} {
+		_, _, err = parseEntryID(id)
+		assert.Error(t, err)
+	}
+	ts, err := entryTime("1700000000123-0")
+	assert.NoError(t, err)
+	assert.Equal(t, time.UnixMilli(1700000000123), ts)
+
+	o := &redisStreamsOffset{id: "1700000000123-5", partitionIdx: 1}
+	seqNum, err := o.Sequence()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1700000000123), seqNum)
+	assert.Equal(t, "1700000000123-5", o.String())
+	assert.Equal(t, int32(1), o.PartitionIdx())
+}
+
+func TestNewReadMessage(t *testing.T) {
+	rs := &redisStreamsSource{vertexName: "in", vertexReplica: 2, logger: zap.NewNop().Sugar()}
+	m, err := rs.newReadMessage(redis.XMessage{ID: "1700000000123-0", Values: map[string]interface{}{"order": "o1"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"order"}, m.Keys)
+	assert.Equal(t, "o1", string(m.Payload))
+	assert.Equal(t, time.UnixMilli(1700000000123), m.EventTime)
+	assert.Equal(t, "1700000000123-0", m.ID.Offset)
+	assert.Equal(t, int32(2), m.ReadOffset.PartitionIdx())
+
+	// the same entry claimed by another replica has the same message ID.
+	claimed, err := (&redisStreamsSource{vertexName: "in", vertexReplica: 0, logger: zap.NewNop().Sugar()}).newReadMessage(redis.XMessage{ID: "1700000000123-0", Values: map[string]interface{}{"order": "o1"}})
+	require.NoError(t, err)
+	assert.Equal(t, m.ID.String(), claimed.ID.String())
+	assert.Equal(t, int32(0), claimed.ReadOffset.PartitionIdx())
+
+	m, err = rs.newReadMessage(redis.XMessage{ID: "1700000000123-1", Values: map[string]interface{}{"a": "1", "b": "2"}})
+	require.NoError(t, err)
+	assert.Empty(t, m.Keys)
+	var fields map[string]string
+	assert.NoError(t, json.Unmarshal(m.Payload, &fields))
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, fields)
+
+	msgs, err := rs.toMessages([]redis.XStream{{Stream: "s", Messages: []redis.XMessage{
+		{ID: "1700000000123-2", Values: map[string]interface{}{"k": "v"}},
+	}}}, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+}
+
+func TestClientOptions(t *testing.T) {
+	opts, err := clientOptions(&dfv1.RedisStreamsSource{RedisConfig: dfv1.RedisConfig{URL: "r1:6379,r2:6379", User: "u", MasterName: "m"}, Stream: "s"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"r1:6379", "r2:6379"}, opts.Addrs)
+	assert.Equal(t, "u", opts.Username)
+	assert.Equal(t, "", opts.MasterName)
+
+	opts, err = clientOptions(&dfv1.RedisStreamsSource{RedisConfig: dfv1.RedisConfig{SentinelURL: "s1:26379", MasterName: "m"}, Stream: "s"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"s1:26379"}, opts.Addrs)
+	assert.Equal(t, "m", opts.MasterName)
+}
@@ -39,21 +39,28 @@ import (
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/ratelimit"
+	jsratelimit "github.com/numaproj/numaflow/pkg/shared/ratelimit/jetstream"
+	redisratelimit "github.com/numaproj/numaflow/pkg/shared/ratelimit/redis"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
+	"github.com/numaproj/numaflow/pkg/sources/file"
 	sourceforward "github.com/numaproj/numaflow/pkg/sources/forward"
 	"github.com/numaproj/numaflow/pkg/sources/generator"
 	"github.com/numaproj/numaflow/pkg/sources/http"
 	jetstreamsrc "github.com/numaproj/numaflow/pkg/sources/jetstream"
 	"github.com/numaproj/numaflow/pkg/sources/kafka"
 	"github.com/numaproj/numaflow/pkg/sources/nats"
+	"github.com/numaproj/numaflow/pkg/sources/redisstreams"
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
 	"github.com/numaproj/numaflow/pkg/sources/transformer"
 	"github.com/numaproj/numaflow/pkg/sources/udsource"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
+	rediswm "github.com/numaproj/numaflow/pkg/watermark/generic/redis"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
@@ -75,6 +82,8 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		sourceReader             sourcer.SourceReader
 		healthCheckers           []metrics.HealthChecker
 		idleManager              wmb.IdleManager
+		checkpointStore          kvs.KVStorer
+		rateLimiter              ratelimit.RateLimiter
 		pipelineName             = sp.VertexInstance.Vertex.Spec.PipelineName
 		vertexName               = sp.VertexInstance.Vertex.Spec.Name
 	)
@@ -93,6 +102,8 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 
 	switch sp.ISBSvcType {
 	case dfv1.ISBSvcTypeRedis:
+		// share one client, which maintains a connection pool, among the buffers and the stores.
+		redisClient := redisclient.NewInClusterRedisClient()
 		for _, e := range sp.VertexInstance.Vertex.Spec.ToEdges {
 			writeOpts := []redisclient.Option{
 				redisclient.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
@@ -108,12 +119,53 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 			// create a writer for each partition.
 			for partitionIdx, partition := range partitionedBuffers {
 				group := partition + "-group"
-				redisClient := redisclient.NewInClusterRedisClient()
 				writer := redisisb.NewBufferWrite(ctx, redisClient, partition, group, int32(partitionIdx), writeOpts...)
 				bufferWriters = append(bufferWriters, writer)
 			}
 			writersMap[e.To] = bufferWriters
 		}
+
+		// created watermark related components only if watermark is enabled
+		// otherwise no op will be used
+		if !sp.VertexInstance.Vertex.Spec.Watermark.Disabled {
+			var err error
+			// build watermark stores for from vertex
+			sourceWmStores, err = rediswm.BuildFromVertexWatermarkStores(ctx, sp.VertexInstance, redisClient)
+			if err != nil {
+				return fmt.Errorf("failed to build watermark stores: %w", err)
+			}
+
+			// create watermark fetcher using watermark stores of from vertex
+			fetchWatermark = fetch.NewSourceFetcher(ctx, sourceWmStores[sp.VertexInstance.Vertex.Name], fetch.WithIsSource(true))
+
+			// build watermark stores for to-vertex
+			toVertexWatermarkStores, err = rediswm.BuildToVertexWatermarkStores(ctx, sp.VertexInstance, redisClient)
+			if err != nil {
+				return err
+			}
+
+			// build watermark stores for sourceReader (we publish twice for sourceReader)
+			sourcePublisherStores, err = rediswm.BuildSourcePublisherStores(ctx, sp.VertexInstance, redisClient)
+			if err != nil {
+				return err
+			}
+			idleManager, _ = wmb.NewIdleManager(1, len(writersMap))
+		}
+
+		var err error
+		if rateLimiter, err = redisratelimit.BuildRateLimiter(ctx, sp.VertexInstance.Vertex, redisClient); err != nil {
+			return fmt.Errorf("failed to build rate limiter: %w", err)
+		}
+
+		// the file source checkpoints the offsets of the files in a KV store.
+		if sp.VertexInstance.Vertex.Spec.Source.File != nil {
+			var err error
+			checkpointStore, err = file.BuildRedisCheckpointStore(ctx, sp.VertexInstance.Vertex, redisClient)
+			if err != nil {
+				return fmt.Errorf("failed to build checkpoint store: %w", err)
+			}
+			defer checkpointStore.Close()
+		}
 	case dfv1.ISBSvcTypeJetStream:
 
 		// create a new NATS client pool
@@ -173,6 +225,19 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 			}
 			idleManager, _ = wmb.NewIdleManager(1, len(writersMap))
 		}
+
+		if rateLimiter, err = jsratelimit.BuildRateLimiter(ctx, sp.VertexInstance.Vertex, natsClientPool.NextAvailableClient()); err != nil {
+			return fmt.Errorf("failed to build rate limiter: %w", err)
+		}
+
+		// the file source checkpoints the offsets of the files in a KV store.
+		if sp.VertexInstance.Vertex.Spec.Source.File != nil {
+			checkpointStore, err = file.BuildJetStreamCheckpointStore(ctx, sp.VertexInstance.Vertex, natsClientPool.NextAvailableClient())
+			if err != nil {
+				return fmt.Errorf("failed to build checkpoint store: %w", err)
+			}
+			defer checkpointStore.Close()
+		}
 	default:
 		return fmt.Errorf("unrecognized isb svc type %q", sp.ISBSvcType)
 	}
@@ -231,6 +296,9 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 			forwardOpts = append(forwardOpts, sourceforward.WithReadBatchSize(int64(*x.ReadBatchSize)))
 		}
 	}
+	if rateLimiter != nil {
+		forwardOpts = append(forwardOpts, sourceforward.WithRateLimiter(rateLimiter))
+	}
 
 	if sp.VertexInstance.Vertex.HasUDTransformer() {
 		// Wait for server info to be ready
@@ -263,7 +331,7 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		forwardOpts = append(forwardOpts, sourceforward.WithTransformer(srcTransformerGRPCClient))
 	}
 
-	sourceReader, err := sp.createSourceReader(ctx, udsGRPCClient)
+	sourceReader, err := sp.createSourceReader(ctx, udsGRPCClient, checkpointStore)
 	if err != nil {
 		return fmt.Errorf("failed to create source, error: %w", err)
 	}
@@ -337,7 +405,7 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 }
 
 // createSourceReader is used to send the sourcer information
-func (sp *SourceProcessor) createSourceReader(ctx context.Context, udsGRPCClient *udsource.GRPCBasedUDSource) (sourcer.SourceReader, error) {
+func (sp *SourceProcessor) createSourceReader(ctx context.Context, udsGRPCClient *udsource.GRPCBasedUDSource, checkpointStore kvs.KVStorer) (sourcer.SourceReader, error) {
 	var readTimeout time.Duration
 	if l := sp.VertexInstance.Vertex.Spec.Limits; l != nil && l.ReadTimeout != nil {
 		readTimeout = l.ReadTimeout.Duration
@@ -356,6 +424,10 @@ func (sp *SourceProcessor) createSourceReader(ctx context.Context, udsGRPCClient
 		return nats.New(ctx, sp.VertexInstance, nats.WithReadTimeout(readTimeout))
 	} else if x := src.JetStream; x != nil {
 		return jetstreamsrc.New(ctx, sp.VertexInstance, jetstreamsrc.WithReadTimeout(readTimeout))
+	} else if x := src.File; x != nil && checkpointStore != nil {
+		return file.New(ctx, sp.VertexInstance, checkpointStore, file.WithReadTimeout(readTimeout))
+	} else if x := src.RedisStreams; x != nil {
+		return redisstreams.New(ctx, sp.VertexInstance, redisstreams.WithReadTimeout(readTimeout))
 	}
 	return nil, fmt.Errorf("invalid source spec")
 }
@@ -19,6 +19,7 @@ package sourcer
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/numaproj/numaflow/pkg/isb"
 )
@@ -45,3 +46,21 @@ type SourceReader interface {
 
 // SourceReader can be used as LagReader.
 var _ isb.LagReader = (SourceReader)(nil)
+
+// BoundedSourceReader is a SourceReader with a finite input. Once it's exhausted, the forwarder publishes the final
+// watermark to the downstream vertices, so that all the windows are closed and the pipeline can complete.
+type BoundedSourceReader interface {
+	SourceReader
+	// IsExhausted returns true once all the input has been read and acknowledged, Read returns no more messages after that.
+	IsExhausted() bool
+}
+
+// WatermarkedSourceReader is a SourceReader which knows the watermarks of its partitions, e.g. the oldest unread
+// position of each partition of an upstream system. The forwarder publishes these watermarks instead of inferring
+// them from the event times of the messages read from the partitions.
+type WatermarkedSourceReader interface {
+	SourceReader
+	// Watermarks returns the latest watermarks reported by the source, keyed by the partitions. The partitions which
+	// have never reported a watermark are not included.
+	Watermarks() map[int32]time.Time
+}
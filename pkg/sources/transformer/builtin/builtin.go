@@ -24,8 +24,12 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sources/transformer/builtin/convert"
 	eventtime "github.com/numaproj/numaflow/pkg/sources/transformer/builtin/event_time"
 	"github.com/numaproj/numaflow/pkg/sources/transformer/builtin/filter"
+	"github.com/numaproj/numaflow/pkg/sources/transformer/builtin/keyby"
+	"github.com/numaproj/numaflow/pkg/sources/transformer/builtin/projection"
+	"github.com/numaproj/numaflow/pkg/sources/transformer/builtin/redact"
 	timeextractionfilter "github.com/numaproj/numaflow/pkg/sources/transformer/builtin/time_extraction_filter"
 )
 
@@ -56,6 +60,14 @@ func (b *Builtin) executor() (sourcetransformer.SourceTransformFunc, error) {
 		return eventtime.New(b.KWArgs)
 	case "timeExtractionFilter":
 		return timeextractionfilter.New(b.KWArgs)
+	case "projection":
+		return projection.New(b.KWArgs)
+	case "keyBy":
+		return keyby.New(b.KWArgs)
+	case "convert":
+		return convert.New(b.KWArgs)
+	case "redact":
+		return redact.New(b.KWArgs)
 	default:
 		return nil, fmt.Errorf("unrecognized transformer %q", b.Name)
 	}
@@ -36,6 +36,22 @@ func TestGetExecutors(t *testing.T) {
 				Name:   "filter",
 				KWArgs: map[string]string{"expression": `json(payload).a=="b"`},
 			},
+			{
+				Name:   "projection",
+				KWArgs: map[string]string{"select": "a"},
+			},
+			{
+				Name:   "keyBy",
+				KWArgs: map[string]string{"expression": `json(payload).a`},
+			},
+			{
+				Name:   "redact",
+				KWArgs: map[string]string{"patterns": "email"},
+			},
+			{
+				Name:   "convert",
+				KWArgs: map[string]string{"format": "csv", "schema": "a,b"},
+			},
 		}
 		for _, b := range builtins {
 			e, err := b.executor()
@@ -0,0 +1,399 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// avroType is a parsed Avro schema.
+type avroType struct {
+	// kind is the name of a primitive type, or one of record, enum, array, map, fixed and union.
+	kind string
+	// fields of a record.
+	fields []avroField
+	// symbols of an enum.
+	symbols []string
+	// items of an array, or values of a map.
+	items *avroType
+	// branches of a union.
+	branches []*avroType
+	// size of a fixed.
+	size int
+}
+
+type avroField struct {
+	name string
+	typ  *avroType
+}
+
+const (
+	// maxAvroItems is the max number of the items of all the arrays and maps in a datum, which bounds the memory used
+	// by the items whose encoding has no bytes, e.g. the nulls.
+	maxAvroItems = 1 << 20
+	// confluentHeaderSize is the size of the Confluent wire format header, a magic byte 0 followed by the 4-byte
+	// schema ID.
+	confluentHeaderSize = 5
+	// framingConfluent is the framing of the payloads produced with the Confluent Schema Registry serializers.
+	framingConfluent = "confluent"
+)
+
+var avroPrimitives = map[string]bool{
+	"null": true, "boolean": true, "int": true, "long": true, "float": true, "double": true, "bytes": true, "string": true,
+}
+
+// avroDecoder decodes an Avro binary encoded datum, the values of unions are not wrapped with their types, and the
+// logical types are decoded as their underlying types.
+type avroDecoder struct {
+	schema *avroType
+	// confluent is true if the datum is prefixed with the Confluent wire format header, the schema ID in the header is
+	// not checked, the datum is always decoded with the schema.
+	confluent bool
+}
+
+// newAvroDecoder returns a decoder of the schema, the framing is either empty for the bare datums, or "confluent".
+func newAvroDecoder(schema []byte, framing string) (*avroDecoder, error) {
+	var confluent bool
+	switch framing {
+	case "":
+	case framingConfluent:
+		confluent = true
+	default:
+		return nil, fmt.Errorf("unsupported Avro framing %q, the supported framing is %q", framing, framingConfluent)
+	}
+	var v interface{}
+	if err := json.Unmarshal(schema, &v); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the Avro schema, %w", err)
+	}
+	t, err := parseAvroType(v, "", map[string]*avroType{})
+	if err != nil {
+		return nil, fmt.Errorf("invalid Avro schema, %w", err)
+	}
+	return &avroDecoder{schema: t, confluent: confluent}, nil
+}
+
+// parseAvroType parses a schema, the named types are registered in the names with their full names, so that they
+// can be referenced later, including recursively.
+func parseAvroType(v interface{}, namespace string, names map[string]*avroType) (*avroType, error) {
+	switch s := v.(type) {
+	case string:
+		if avroPrimitives[s] {
+			return &avroType{kind: s}, nil
+		}
+		if t, ok := names[fullAvroName(s, namespace)]; ok {
+			return t, nil
+		}
+		if t, ok := names[s]; ok {
+			return t, nil
+		}
+		return nil, fmt.Errorf("unknown type %q", s)
+	case []interface{}:
+		t := &avroType{kind: "union"}
+		for _, b := range s {
+			bt, err := parseAvroType(b, namespace, names)
+			if err != nil {
+				return nil, err
+			}
+			t.branches = append(t.branches, bt)
+		}
+		return t, nil
+	case map[string]interface{}:
+		kind, _ := s["type"].(string)
+		switch kind {
+		case "record", "error", "enum", "fixed":
+			name, _ := s["name"].(string)
+			if name == "" {
+				return nil, fmt.Errorf("missing the name of the %s", kind)
+			}
+			if ns, ok := s["namespace"].(string); ok && !strings.Contains(name, ".") {
+				namespace = ns
+			}
+			name = fullAvroName(name, namespace)
+			if i := strings.LastIndex(name, "."); i >= 0 {
+				namespace = name[:i]
+			}
+			t := &avroType{kind: kind}
+			if kind == "error" {
+				t.kind = "record"
+			}
+			names[name] = t
+			switch t.kind {
+			case "record":
+				fields, _ := s["fields"].([]interface{})
+				for _, f := range fields {
+					fm, _ := f.(map[string]interface{})
+					fname, _ := fm["name"].(string)
+					if fname == "" {
+						return nil, fmt.Errorf("missing the name of a field of record %q", name)
+					}
+					ft, err := parseAvroType(fm["type"], namespace, names)
+					if err != nil {
+						return nil, fmt.Errorf("invalid type of field %q of record %q, %w", fname, name, err)
+					}
+					t.fields = append(t.fields, avroField{name: fname, typ: ft})
+				}
+			case "enum":
+				symbols, _ := s["symbols"].([]interface{})
+				for _, sym := range symbols {
+					str, _ := sym.(string)
+					t.symbols = append(t.symbols, str)
+				}
+			case "fixed":
+				size, ok := s["size"].(float64)
+				if !ok || size < 0 {
+					return nil, fmt.Errorf("invalid size of fixed %q", name)
+				}
+				t.size = int(size)
+			}
+			return t, nil
+		case "array", "map":
+			key := "items"
+			if kind == "map" {
+				key = "values"
+			}
+			items, err := parseAvroType(s[key], namespace, names)
+			if err != nil {
+				return nil, err
+			}
+			return &avroType{kind: kind, items: items}, nil
+		default:
+			// a primitive type, possibly annotated with a logical type.
+			return parseAvroType(s["type"], namespace, names)
+		}
+	}
+	return nil, fmt.Errorf("invalid type %v", v)
+}
+
+func fullAvroName(name, namespace string) string {
+	if namespace == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func (d *avroDecoder) decode(data []byte) (interface{}, error) {
+	if d.confluent {
+		if len(data) < confluentHeaderSize || data[0] != 0 {
+			return nil, fmt.Errorf("failed to decode the Avro payload, missing the Confluent wire format header")
+		}
+		data = data[confluentHeaderSize:]
+	}
+	r := &avroReader{buf: data}
+	v, err := r.read(d.schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the Avro payload, %w", err)
+	}
+	if r.pos != len(r.buf) {
+		return nil, fmt.Errorf("failed to decode the Avro payload, %d bytes left after decoding", len(r.buf)-r.pos)
+	}
+	return v, nil
+}
+
+// avroReader reads the values of the Avro binary encoding.
+type avroReader struct {
+	buf []byte
+	pos int
+	// items is the number of the items of the arrays and maps read.
+	items int64
+}
+
+func (r *avroReader) read(t *avroType) (interface{}, error) {
+	switch t.kind {
+	case "null":
+		return nil, nil
+	case "boolean":
+		b, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		return b[0] != 0, nil
+	case "int", "long":
+		return r.readLong()
+	case "float":
+		b, err := r.next(4)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+	case "double":
+		b, err := r.next(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	case "bytes":
+		return r.readBytes()
+	case "string":
+		b, err := r.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case "fixed":
+		return r.next(t.size)
+	case "enum":
+		i, err := r.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || int(i) >= len(t.symbols) {
+			return nil, fmt.Errorf("enum index %d out of range", i)
+		}
+		return t.symbols[i], nil
+	case "union":
+		i, err := r.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || int(i) >= len(t.branches) {
+			return nil, fmt.Errorf("union index %d out of range", i)
+		}
+		return r.read(t.branches[i])
+	case "record":
+		result := make(map[string]interface{}, len(t.fields))
+		for _, f := range t.fields {
+			v, err := r.read(f.typ)
+			if err != nil {
+				return nil, fmt.Errorf("field %q, %w", f.name, err)
+			}
+			result[f.name] = v
+		}
+		return result, nil
+	case "array":
+		result := make([]interface{}, 0)
+		err := r.readBlocks(minAvroSize(t.items, map[*avroType]bool{}), func() error {
+			v, err := r.read(t.items)
+			if err != nil {
+				return err
+			}
+			result = append(result, v)
+			return nil
+		})
+		return result, err
+	case "map":
+		result := make(map[string]interface{})
+		// a map entry has at least the length of its key.
+		err := r.readBlocks(1+minAvroSize(t.items, map[*avroType]bool{}), func() error {
+			k, err := r.readBytes()
+			if err != nil {
+				return err
+			}
+			v, err := r.read(t.items)
+			if err != nil {
+				return err
+			}
+			result[string(k)] = v
+			return nil
+		})
+		return result, err
+	}
+	return nil, fmt.Errorf("unsupported type %q", t.kind)
+}
+
+// readBlocks reads the blocks of an array or a map, a block with a negative count is followed by its size in bytes.
+// The count of a block is checked against the remaining bytes with the min size of an item, and the items in total
+// are limited, before the items are read.
+func (r *avroReader) readBlocks(itemSize int, readItem func() error) error {
+	for {
+		count, err := r.readLong()
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			count = -count
+			if _, err := r.readLong(); err != nil {
+				return err
+			}
+		}
+		if itemSize > 0 && count > int64((len(r.buf)-r.pos)/itemSize) {
+			return fmt.Errorf("block count %d exceeds the remaining %d bytes at offset %d", count, len(r.buf)-r.pos, r.pos)
+		}
+		if r.items += count; r.items > maxAvroItems {
+			return fmt.Errorf("too many items, more than %d", maxAvroItems)
+		}
+		for i := int64(0); i < count; i++ {
+			if err := readItem(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// minAvroSize returns the min number of bytes of an encoded value of the type, the types being visited are counted
+// as 0 to stop at the recursive references.
+func minAvroSize(t *avroType, visiting map[*avroType]bool) int {
+	switch t.kind {
+	case "null":
+		return 0
+	case "float":
+		return 4
+	case "double":
+		return 8
+	case "fixed":
+		return t.size
+	case "record":
+		if visiting[t] {
+			return 0
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		size := 0
+		for _, f := range t.fields {
+			size += minAvroSize(f.typ, visiting)
+		}
+		return size
+	}
+	// a boolean, or a variable-length integer, e.g. a length, an index or the count of the blocks.
+	return 1
+}
+
+// readLong reads a zig-zag encoded variable-length integer.
+func (r *avroReader) readLong() (int64, error) {
+	u, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 {
+		return 0, fmt.Errorf("invalid variable-length integer at offset %d", r.pos)
+	}
+	r.pos += n
+	return int64(u>>1) ^ -int64(u&1), nil
+}
+
+func (r *avroReader) readBytes() ([]byte, error) {
+	n, err := r.readLong()
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("negative length %d at offset %d", n, r.pos)
+	}
+	return r.next(int(n))
+}
+
+func (r *avroReader) next(n int) ([]byte, error) {
+	if n > len(r.buf)-r.pos {
+		return nil, fmt.Errorf("unexpected end of data at offset %d", r.pos)
+	}
+	b := r.buf[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAvroSchema = `{
+  "type": "record",
+  "name": "User",
+  "namespace": "io.numaproj",
+  "fields": [
+    {"name": "id", "type": "long"},
+    {"name": "name", "type": "string"},
+    {"name": "email", "type": ["null", "string"]},
+    {"name": "score", "type": "double"},
+    {"name": "ratio", "type": "float"},
+    {"name": "active", "type": "boolean"},
+    {"name": "created", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+    {"name": "role", "type": {"type": "enum", "name": "Role", "symbols": ["ADMIN", "MEMBER"]}},
+    {"name": "tags", "type": {"type": "array", "items": "string"}},
+    {"name": "attrs", "type": {"type": "map", "values": "int"}},
+    {"name": "id2", "type": {"type": "fixed", "name": "ID2", "size": 2}},
+    {"name": "manager", "type": ["null", "User"]}
+  ]
+}`
+
+type avroEncoder struct {
+	buf []byte
+}
+
+func (e *avroEncoder) long(v int64) *avroEncoder {
+	e.buf = binary.AppendUvarint(e.buf, uint64((v<<1)^(v>>63)))
+	return e
+}
+
+func (e *avroEncoder) string(s string) *avroEncoder {
+	e.long(int64(len(s)))
+	e.buf = append(e.buf, s...)
+	return e
+}
+
+func (e *avroEncoder) raw(b ...byte) *avroEncoder {
+	e.buf = append(e.buf, b...)
+	return e
+}
+
+func (e *avroEncoder) user(id int64, manager bool) *avroEncoder {
+	e.long(id).string("numa")
+	e.long(1).string("numa@numaproj.io")
+	e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(1.5))
+	e.buf = binary.LittleEndian.AppendUint32(e.buf, math.Float32bits(0.25))
+	e.raw(1)
+	e.long(1636470000000)
+	e.long(1)
+	// tags in a block with its size.
+	e.long(-2).long(4).string("a").string("b").long(0)
+	e.long(1).string("x").long(-3).long(0)
+	e.raw(0xab, 0xcd)
+	if manager {
+		e.long(1)
+		return e.user(id+1, false)
+	}
+	return e.long(0)
+}
+
+func TestAvroDecoder(t *testing.T) {
+	t.Run("invalid schemas", func(t *testing.T) {
+		for _, s := range []string{`not json`, `"unknown"`, `{"type": "record", "fields": []}`, `{"type": "fixed", "name": "f"}`, `{"type": "array", "items": "Missing"}`} {
+			_, err := newAvroDecoder([]byte(s), "")
+			assert.Error(t, err, s)
+		}
+	})
+
+	t.Run("record", func(t *testing.T) {
+		d, err := newAvroDecoder([]byte(testAvroSchema), "")
+		require.NoError(t, err)
+		v, err := d.decode((&avroEncoder{}).user(1, true).buf)
+		require.NoError(t, err)
+		b, err := json.Marshal(v)
+		require.NoError(t, err)
+		user := `"name":"numa","email":"numa@numaproj.io","score":1.5,"ratio":0.25,"active":true,"created":1636470000000,"role":"MEMBER","tags":["a","b"],"attrs":{"x":-3},"id2":"q80="`
+		assert.JSONEq(t, `{"id":1,`+user+`,"manager":{"id":2,`+user+`,"manager":null}}`, string(b))
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		d, err := newAvroDecoder([]byte(testAvroSchema), "")
+		require.NoError(t, err)
+		data := (&avroEncoder{}).user(1, false).buf
+		_, err = d.decode(data[:len(data)-1])
+		assert.Error(t, err)
+		_, err = d.decode(append(data, 0))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "bytes left")
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		d, err := newAvroDecoder([]byte(`["null", "string"]`), "")
+		require.NoError(t, err)
+		_, err = d.decode((&avroEncoder{}).long(2).buf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "out of range")
+	})
+	t.Run("block count", func(t *testing.T) {
+		d, err := newAvroDecoder([]byte(`{"type": "array", "items": "long"}`), "")
+		require.NoError(t, err)
+		_, err = d.decode((&avroEncoder{}).long(1 << 40).long(1).long(0).buf)
+		assert.ErrorContains(t, err, "exceeds the remaining")
+		// the items without bytes are limited in total.
+		d, err = newAvroDecoder([]byte(`{"type": "array", "items": "null"}`), "")
+		require.NoError(t, err)
+		_, err = d.decode((&avroEncoder{}).long(1 << 60).long(0).buf)
+		assert.ErrorContains(t, err, "too many items")
+		v, err := d.decode((&avroEncoder{}).long(3).long(0).buf)
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{nil, nil, nil}, v)
+		d, err = newAvroDecoder([]byte(`{"type": "map", "values": {"type": "record", "name": "Empty", "fields": []}}`), "")
+		require.NoError(t, err)
+		_, err = d.decode((&avroEncoder{}).long(3).string("a").buf)
+		assert.ErrorContains(t, err, "exceeds the remaining")
+	})
+
+	t.Run("confluent framing", func(t *testing.T) {
+		_, err := newAvroDecoder([]byte(testAvroSchema), "unknown")
+		assert.Error(t, err)
+		d, err := newAvroDecoder([]byte(`"string"`), "confluent")
+		require.NoError(t, err)
+		v, err := d.decode((&avroEncoder{}).raw(0, 0, 0, 0, 42).string("numa").buf)
+		assert.NoError(t, err)
+		assert.Equal(t, "numa", v)
+		_, err = d.decode((&avroEncoder{}).string("numa").buf)
+		assert.ErrorContains(t, err, "Confluent wire format header")
+		_, err = d.decode([]byte{0, 0})
+		assert.Error(t, err)
+	})
+}
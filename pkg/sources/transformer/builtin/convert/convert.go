@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/numaproj/numaflow-go/pkg/sourcetransformer"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	formatCSV      = "csv"
+	formatAvro     = "avro"
+	formatProtobuf = "protobuf"
+	formatMsgpack  = "msgpack"
+)
+
+// decoder decodes a payload into a value which can be marshaled to JSON.
+type decoder interface {
+	decode(data []byte) (interface{}, error)
+}
+
+type convert struct {
+	decoder decoder
+	// failureTag is the tag of the messages failed to be converted, they are dropped if it's empty.
+	failureTag string
+}
+
+// New returns a source transformer which converts the payload from the "format" to JSON. The schema of the format is
+// either given inline with "schema", or read from a mounted file with "schemaFile".
+func New(args map[string]string) (sourcetransformer.SourceTransformFunc, error) {
+	format, existing := args["format"]
+	if !existing {
+		return nil, fmt.Errorf(`missing "format"`)
+	}
+	schema, err := readSchema(args)
+	if err != nil {
+		return nil, err
+	}
+	var d decoder
+	switch format {
+	case formatCSV:
+		d, err = newCSVDecoder(schema, args["delimiter"])
+	case formatAvro:
+		if len(schema) == 0 {
+			return nil, fmt.Errorf(`"schema" or "schemaFile" is required for format %q`, format)
+		}
+		d, err = newAvroDecoder(schema, args["framing"])
+	case formatProtobuf:
+		if len(schema) == 0 {
+			return nil, fmt.Errorf(`"schema" or "schemaFile" is required for format %q`, format)
+		}
+		if _, ok := args["schema"]; ok {
+			// the inline descriptor set is base64 encoded, as it is binary.
+			if schema, err = base64.StdEncoding.DecodeString(string(schema)); err != nil {
+				return nil, fmt.Errorf("failed to decode the base64 encoded descriptor set, %w", err)
+			}
+		}
+		d, err = newProtobufDecoder(schema, args["messageType"])
+	case formatMsgpack:
+		d = newMsgpackDecoder()
+	default:
+		return nil, fmt.Errorf("unsupported format %q, supported formats are %q, %q, %q and %q", format, formatCSV, formatAvro, formatProtobuf, formatMsgpack)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c := convert{
+		decoder:    d,
+		failureTag: args["failureTag"],
+	}
+
+	return func(ctx context.Context, keys []string, datum sourcetransformer.Datum) sourcetransformer.Messages {
+		log := logging.FromContext(ctx)
+		resultMsg, err := c.apply(keys, datum.EventTime(), datum.Value())
+		if err != nil {
+			log.Errorf("Convert transformer apply got an error: %v", err)
+		}
+		return sourcetransformer.MessagesBuilder().Append(resultMsg)
+	}, nil
+}
+
+// readSchema returns the inline schema or the content of the schema file, it returns nil if neither is set.
+func readSchema(args map[string]string) ([]byte, error) {
+	schema, inline := args["schema"]
+	file, fromFile := args["schemaFile"]
+	switch {
+	case inline && fromFile:
+		return nil, fmt.Errorf(`"schema" and "schemaFile" can not be used together`)
+	case inline:
+		return []byte(schema), nil
+	case fromFile:
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the schema file %q, %w", file, err)
+		}
+		return b, nil
+	}
+	return nil, nil
+}
+
+// apply converts the payload to JSON. A message failed to be converted is tagged with the failure tag if it's set,
+// otherwise it's dropped, in both cases it's also tagged with dfv1.MessageTagConversionFailed to be counted.
+func (c convert) apply(keys []string, et time.Time, msg []byte) (sourcetransformer.Message, error) {
+	result, err := c.convert(msg)
+	if err != nil {
+		if c.failureTag != "" {
+			return sourcetransformer.NewMessage(msg, et).WithKeys(keys).WithTags([]string{c.failureTag, dfv1.MessageTagConversionFailed}), err
+		}
+		return sourcetransformer.MessageToDrop(et).WithTags([]string{sourcetransformer.DROP, dfv1.MessageTagConversionFailed}), err
+	}
+	return sourcetransformer.NewMessage(result, et).WithKeys(keys), nil
+}
+
+func (c convert) convert(msg []byte) ([]byte, error) {
+	v, err := c.decoder.decode(msg)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the converted payload to JSON, %w", err)
+	}
+	return b, nil
+}
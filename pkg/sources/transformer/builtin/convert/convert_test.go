@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/numaproj/numaflow-go/pkg/sourcetransformer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ugorji/go/codec"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+type testDatum struct {
+	value     []byte
+	eventTime time.Time
+	watermark time.Time
+	headers   map[string]string
+}
+
+func (h *testDatum) Headers() map[string]string {
+	return h.headers
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return h.eventTime
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return h.watermark
+}
+
+func TestConvert(t *testing.T) {
+	et := time.Unix(1636470000, 0)
+	keys := []string{"k"}
+
+	t.Run("missing format", func(t *testing.T) {
+		_, err := New(map[string]string{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "missing")
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := New(map[string]string{"format": "xml"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported format")
+	})
+
+	t.Run("both schema and schema file", func(t *testing.T) {
+		_, err := New(map[string]string{"format": "avro", "schema": `"string"`, "schemaFile": "/tmp/schema"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not be used together")
+	})
+
+	t.Run("missing schema", func(t *testing.T) {
+		_, err := New(map[string]string{"format": "avro"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is required")
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		handle, err := New(map[string]string{"format": "csv", "schema": "id, name"})
+		require.NoError(t, err)
+		result := handle(context.Background(), keys, &testDatum{value: []byte("1,\"numa, flow\"\n"), eventTime: et})
+		require.Len(t, result.Items(), 1)
+		assert.Equal(t, `{"id":"1","name":"numa, flow"}`, string(result.Items()[0].Value()))
+		assert.Equal(t, keys, result.Items()[0].Keys())
+		assert.Equal(t, et, result.Items()[0].EventTime())
+		assert.Empty(t, result.Items()[0].Tags())
+	})
+
+	t.Run("csv without columns", func(t *testing.T) {
+		handle, err := New(map[string]string{"format": "csv", "delimiter": "|"})
+		require.NoError(t, err)
+		result := handle(context.Background(), keys, &testDatum{value: []byte("1|numa"), eventTime: et})
+		assert.Equal(t, `["1","numa"]`, string(result.Items()[0].Value()))
+	})
+
+	t.Run("invalid delimiter", func(t *testing.T) {
+		_, err := New(map[string]string{"format": "csv", "delimiter": "||"})
+		assert.Error(t, err)
+	})
+
+	t.Run("conversion failure is dropped", func(t *testing.T) {
+		handle, err := New(map[string]string{"format": "csv", "schema": "id,name"})
+		require.NoError(t, err)
+		result := handle(context.Background(), keys, &testDatum{value: []byte("1,numa,extra"), eventTime: et})
+		assert.Equal(t, []string{sourcetransformer.DROP, dfv1.MessageTagConversionFailed}, result.Items()[0].Tags())
+
+		result = handle(context.Background(), keys, &testDatum{value: []byte("1,numa\n2,flow"), eventTime: et})
+		assert.Equal(t, []string{sourcetransformer.DROP, dfv1.MessageTagConversionFailed}, result.Items()[0].Tags())
+	})
+
+	t.Run("conversion failure is tagged", func(t *testing.T) {
+		handle, err := New(map[string]string{"format": "msgpack", "failureTag": "invalid"})
+		require.NoError(t, err)
+		result := handle(context.Background(), keys, &testDatum{value: []byte{0xc1}, eventTime: et})
+		assert.Equal(t, []string{"invalid", dfv1.MessageTagConversionFailed}, result.Items()[0].Tags())
+		assert.Equal(t, []byte{0xc1}, result.Items()[0].Value())
+		assert.Equal(t, keys, result.Items()[0].Keys())
+	})
+
+	t.Run("msgpack", func(t *testing.T) {
+		var b []byte
+		err := codec.NewEncoderBytes(&b, &codec.MsgpackHandle{}).Encode(map[string]interface{}{
+			"id":   7,
+			"name": "numa",
+			"tags": []string{"a", "b"},
+			"meta": map[string]interface{}{"ok": true},
+		})
+		require.NoError(t, err)
+		handle, err := New(map[string]string{"format": "msgpack"})
+		require.NoError(t, err)
+		result := handle(context.Background(), keys, &testDatum{value: b, eventTime: et})
+		assert.JSONEq(t, `{"id":7,"name":"numa","tags":["a","b"],"meta":{"ok":true}}`, string(result.Items()[0].Value()))
+		assert.Empty(t, result.Items()[0].Tags())
+	})
+
+	t.Run("schema file", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "schema.avsc")
+		require.NoError(t, os.WriteFile(file, []byte(`{"type": "array", "items": "long"}`), 0644))
+		handle, err := New(map[string]string{"format": "avro", "schemaFile": file})
+		require.NoError(t, err)
+		// a block of 2 items, 1 and -2, followed by the end of the blocks.
+		result := handle(context.Background(), keys, &testDatum{value: []byte{0x04, 0x02, 0x03, 0x00}, eventTime: et})
+		assert.Equal(t, `[1,-2]`, string(result.Items()[0].Value()))
+
+		_, err = New(map[string]string{"format": "avro", "schemaFile": filepath.Join(t.TempDir(), "missing.avsc")})
+		assert.Error(t, err)
+	})
+
+	t.Run("avro with confluent framing", func(t *testing.T) {
+		handle, err := New(map[string]string{"format": "avro", "schema": `"long"`, "framing": "confluent"})
+		require.NoError(t, err)
+		// the magic byte and the schema ID 1, followed by the datum.
+		result := handle(context.Background(), keys, &testDatum{value: []byte{0x00, 0x00, 0x00, 0x00, 0x01, 0x02}, eventTime: et})
+		assert.Equal(t, `1`, string(result.Items()[0].Value()))
+
+		_, err = New(map[string]string{"format": "avro", "schema": `"long"`, "framing": "unknown"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// csvDecoder decodes a CSV record to a JSON object keyed by the column names, or to a JSON array if there are no
+// column names.
+type csvDecoder struct {
+	columns   []string
+	delimiter rune
+}
+
+// newCSVDecoder returns a CSV decoder, the schema is the comma separated column names.
+func newCSVDecoder(schema []byte, delimiter string) (*csvDecoder, error) {
+	d := &csvDecoder{delimiter: ','}
+	if delimiter != "" {
+		r, size := utf8.DecodeRuneInString(delimiter)
+		if size != len(delimiter) || r == utf8.RuneError {
+			return nil, fmt.Errorf("invalid delimiter %q, it should be a single character", delimiter)
+		}
+		d.delimiter = r
+	}
+	for _, c := range strings.Split(strings.TrimSpace(string(schema)), ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			d.columns = append(d.columns, c)
+		}
+	}
+	return d, nil
+}
+
+func (d *csvDecoder) decode(data []byte) (interface{}, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = d.delimiter
+	r.FieldsPerRecord = len(d.columns)
+	record, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the CSV record, %w", err)
+	}
+	if _, err := r.Read(); err != io.EOF {
+		return nil, fmt.Errorf("only one CSV record is expected in a message")
+	}
+	if len(d.columns) == 0 {
+		return record, nil
+	}
+	result := make(map[string]interface{}, len(d.columns))
+	for i, c := range d.columns {
+		result[c] = record[i]
+	}
+	return result, nil
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ugorji/go/codec"
+)
+
+// msgpackDecoder decodes a MessagePack payload, no schema is needed.
+type msgpackDecoder struct {
+	handle *codec.MsgpackHandle
+}
+
+func newMsgpackDecoder() *msgpackDecoder {
+	h := &codec.MsgpackHandle{}
+	h.MapType = reflect.TypeOf(map[string]interface{}(nil))
+	h.RawToString = true
+	return &msgpackDecoder{handle: h}
+}
+
+func (d *msgpackDecoder) decode(data []byte) (interface{}, error) {
+	var v interface{}
+	if err := codec.NewDecoderBytes(data, d.handle).Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to decode the MessagePack payload, %w", err)
+	}
+	return v, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// protobufDecoder decodes a protobuf payload of a message type defined in a descriptor set.
+type protobufDecoder struct {
+	descriptor protoreflect.MessageDescriptor
+}
+
+// newProtobufDecoder returns a protobuf decoder, the descriptor set is generated with
+// "protoc --include_imports --descriptor_set_out", and the message type is the full name of the message.
+func newProtobufDecoder(descriptorSet []byte, messageType string) (*protobufDecoder, error) {
+	if messageType == "" {
+		return nil, fmt.Errorf(`"messageType" is required for format %q`, formatProtobuf)
+	}
+	fds := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(descriptorSet, fds); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the descriptor set, %w", err)
+	}
+	files, err := protodesc.NewFiles(fds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the files from the descriptor set, %w", err)
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(messageType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find message type %q in the descriptor set, %w", messageType, err)
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a message type", messageType)
+	}
+	return &protobufDecoder{descriptor: md}, nil
+}
+
+func (d *protobufDecoder) decode(data []byte) (interface{}, error) {
+	msg := dynamicpb.NewMessage(d.descriptor)
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the protobuf payload, %w", err)
+	}
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the protobuf message to JSON, %w", err)
+	}
+	// protojson output is not stable, the raw message is compacted when it's marshaled.
+	return json.RawMessage(b), nil
+}
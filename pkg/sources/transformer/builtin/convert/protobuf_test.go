@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func testDescriptorSet() *descriptorpb.FileDescriptorSet {
+	return &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{{
+			Name:    proto.String("order.proto"),
+			Package: proto.String("test"),
+			Syntax:  proto.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("Order"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: proto.String("order_id"), JsonName: proto.String("orderId"), Number: proto.Int32(1), Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()},
+					{Name: proto.String("quantity"), JsonName: proto.String("quantity"), Number: proto.Int32(2), Type: descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()},
+					{Name: proto.String("items"), JsonName: proto.String("items"), Number: proto.Int32(3), Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(), Label: descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()},
+				},
+			}},
+		}},
+	}
+}
+
+func TestProtobufDecoder(t *testing.T) {
+	fds := testDescriptorSet()
+	descriptorSet, err := proto.Marshal(fds)
+	require.NoError(t, err)
+
+	files, err := protodesc.NewFiles(fds)
+	require.NoError(t, err)
+	d, err := files.FindDescriptorByName("test.Order")
+	require.NoError(t, err)
+	md := d.(protoreflect.MessageDescriptor)
+	msg := dynamicpb.NewMessage(md)
+	msg.Set(md.Fields().ByName("order_id"), protoreflect.ValueOfString("o-1"))
+	msg.Set(md.Fields().ByName("quantity"), protoreflect.ValueOfInt32(3))
+	items := msg.Mutable(md.Fields().ByName("items")).List()
+	items.Append(protoreflect.ValueOfString("a"))
+	items.Append(protoreflect.ValueOfString("b"))
+	payload, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := newProtobufDecoder(descriptorSet, "")
+		assert.Error(t, err)
+		_, err = newProtobufDecoder(descriptorSet, "test.Missing")
+		assert.Error(t, err)
+		_, err = newProtobufDecoder([]byte("invalid"), "test.Order")
+		assert.Error(t, err)
+	})
+
+	t.Run("inline descriptor set", func(t *testing.T) {
+		handle, err := New(map[string]string{
+			"format":      "protobuf",
+			"schema":      base64.StdEncoding.EncodeToString(descriptorSet),
+			"messageType": "test.Order",
+		})
+		require.NoError(t, err)
+		result := handle(context.Background(), nil, &testDatum{value: payload})
+		assert.Equal(t, `{"orderId":"o-1","quantity":3,"items":["a","b"]}`, string(result.Items()[0].Value()))
+
+		result = handle(context.Background(), nil, &testDatum{value: []byte{0xff}})
+		assert.Contains(t, result.Items()[0].Tags(), dfv1.MessageTagConversionFailed)
+	})
+
+	t.Run("descriptor set file", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "order.pb")
+		require.NoError(t, os.WriteFile(file, descriptorSet, 0644))
+		handle, err := New(map[string]string{"format": "protobuf", "schemaFile": file, "messageType": "test.Order"})
+		require.NoError(t, err)
+		result := handle(context.Background(), nil, &testDatum{value: payload})
+		assert.Equal(t, `{"orderId":"o-1","quantity":3,"items":["a","b"]}`, string(result.Items()[0].Value()))
+	})
+}
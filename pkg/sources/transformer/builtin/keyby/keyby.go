@@ -0,0 +1,45 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyby
+
+import (
+	"context"
+
+	"github.com/numaproj/numaflow-go/pkg/sourcetransformer"
+
+	sharedkeyby "github.com/numaproj/numaflow/pkg/shared/keyby"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// New returns a source transformer which assigns the keys computed with the expressions over the payload, the keys and
+// the headers of the message, the payload is not changed.
+func New(args map[string]string) (sourcetransformer.SourceTransformFunc, error) {
+	k, err := sharedkeyby.New(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, keys []string, datum sourcetransformer.Datum) sourcetransformer.Messages {
+		log := logging.FromContext(ctx)
+		newKeys, err := k.Keys(keys, datum.Value(), datum.Headers())
+		if err != nil {
+			log.Errorf("KeyBy transformer apply got an error: %v", err)
+			return sourcetransformer.MessagesBuilder().Append(sourcetransformer.MessageToDrop(datum.EventTime()))
+		}
+		return sourcetransformer.MessagesBuilder().Append(sourcetransformer.NewMessage(datum.Value(), datum.EventTime()).WithKeys(newKeys))
+	}, nil
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/numaproj/numaflow-go/pkg/sourcetransformer"
+
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// setPrefix is the prefix of the kwargs which add or override a field with the value of an expression.
+const setPrefix = "set."
+
+// field is a field set with the value of an expression.
+type field struct {
+	path       []string
+	expression string
+}
+
+// rename renames the field of the "from" path to the "to" path.
+type rename struct {
+	from []string
+	to   []string
+}
+
+type projection struct {
+	// expression builds the new payload, e.g. `{"user": json(payload).user.id}`, the payload is parsed as a JSON object
+	// if it's not specified.
+	expression string
+	// selects are the paths of the fields to keep, all the fields are kept if it's empty.
+	selects [][]string
+	// drops are the paths of the fields to remove.
+	drops [][]string
+	// renames are the fields to rename.
+	renames []rename
+	// sets are the fields to add or override.
+	sets []field
+}
+
+func New(args map[string]string) (sourcetransformer.SourceTransformFunc, error) {
+	p := projection{
+		expression: args["expression"],
+		selects:    parsePaths(args["select"]),
+		drops:      parsePaths(args["drop"]),
+	}
+	if x := strings.TrimSpace(args["rename"]); x != "" {
+		for _, r := range strings.Split(x, ",") {
+			from, to, ok := strings.Cut(r, ":")
+			if !ok || strings.TrimSpace(from) == "" || strings.TrimSpace(to) == "" {
+				return nil, fmt.Errorf(`invalid rename %q, it should be in the format of "from:to"`, r)
+			}
+			p.renames = append(p.renames, rename{from: parsePath(from), to: parsePath(to)})
+		}
+	}
+	for k, v := range args {
+		if name, ok := strings.CutPrefix(k, setPrefix); ok {
+			if name == "" {
+				return nil, fmt.Errorf("missing the field name of %q", k)
+			}
+			p.sets = append(p.sets, field{path: parsePath(name), expression: v})
+		}
+	}
+	// the fields are set in a deterministic order, a nested field is set after its parent.
+	sort.Slice(p.sets, func(i, j int) bool {
+		return strings.Join(p.sets[i].path, ".") < strings.Join(p.sets[j].path, ".")
+	})
+	if p.expression == "" && len(p.selects) == 0 && len(p.drops) == 0 && len(p.renames) == 0 && len(p.sets) == 0 {
+		return nil, fmt.Errorf(`at least one of "expression", "select", "drop", "rename" or "set.<field>" is required`)
+	}
+
+	return func(ctx context.Context, keys []string, datum sourcetransformer.Datum) sourcetransformer.Messages {
+		log := logging.FromContext(ctx)
+		resultMsg, err := p.apply(keys, datum.EventTime(), datum.Value())
+		if err != nil {
+			log.Errorf("Projection got an error: %v, dropping the message", err)
+		}
+		return sourcetransformer.MessagesBuilder().Append(resultMsg)
+	}, nil
+}
+
+// apply reshapes the payload in the order of expression, select, drop, rename and set. The message is dropped if the
+// payload can't be reshaped.
+func (p projection) apply(keys []string, et time.Time, payload []byte) (sourcetransformer.Message, error) {
+	var obj map[string]interface{}
+	if p.expression != "" {
+		result, err := expr.Eval(p.expression, payload)
+		if err != nil {
+			return sourcetransformer.MessageToDrop(et), err
+		}
+		var ok bool
+		if obj, ok = result.(map[string]interface{}); !ok {
+			return sourcetransformer.MessageToDrop(et), fmt.Errorf("expression %q returned %T instead of an object", p.expression, result)
+		}
+	} else if err := json.Unmarshal(payload, &obj); err != nil || obj == nil {
+		return sourcetransformer.MessageToDrop(et), fmt.Errorf("payload is not a JSON object, %v", err)
+	}
+	if len(p.selects) > 0 {
+		selected := make(map[string]interface{})
+		for _, path := range p.selects {
+			if v, ok := getPath(obj, path); ok {
+				setPath(selected, path, v)
+			}
+		}
+		obj = selected
+	}
+	for _, path := range p.drops {
+		deletePath(obj, path)
+	}
+	for _, r := range p.renames {
+		if v, ok := getPath(obj, r.from); ok {
+			deletePath(obj, r.from)
+			setPath(obj, r.to, v)
+		}
+	}
+	for _, f := range p.sets {
+		v, err := expr.Eval(f.expression, payload)
+		if err != nil {
+			return sourcetransformer.MessageToDrop(et), err
+		}
+		setPath(obj, f.path, v)
+	}
+	result, err := json.Marshal(obj)
+	if err != nil {
+		return sourcetransformer.MessageToDrop(et), fmt.Errorf("failed to marshal the projected payload, %w", err)
+	}
+	return sourcetransformer.NewMessage(result, et).WithKeys(keys), nil
+}
+
+// parsePaths parses a comma separated list of field paths, e.g. "id,user.name".
+func parsePaths(s string) [][]string {
+	var paths [][]string
+	for _, p := range strings.Split(s, ",") {
+		if strings.TrimSpace(p) != "" {
+			paths = append(paths, parsePath(p))
+		}
+	}
+	return paths
+}
+
+// parsePath parses a field path separated by dots, e.g. "user.name".
+func parsePath(s string) []string {
+	return strings.Split(strings.TrimSpace(s), ".")
+}
+
+// getPath returns the value of the field at the path.
+func getPath(obj map[string]interface{}, path []string) (interface{}, bool) {
+	for i, k := range path {
+		v, ok := obj[k]
+		if !ok {
+			return nil, false
+		}
+		if i == len(path)-1 {
+			return v, true
+		}
+		if obj, ok = v.(map[string]interface{}); !ok {
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// setPath sets the value of the field at the path, the missing parent objects are created, and the parents which are
+// not objects are replaced.
+func setPath(obj map[string]interface{}, path []string, v interface{}) {
+	for _, k := range path[:len(path)-1] {
+		child, ok := obj[k].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			obj[k] = child
+		}
+		obj = child
+	}
+	obj[path[len(path)-1]] = v
+}
+
+// deletePath removes the field at the path.
+func deletePath(obj map[string]interface{}, path []string) {
+	for _, k := range path[:len(path)-1] {
+		child, ok := obj[k].(map[string]interface{})
+		if !ok {
+			return
+		}
+		obj = child
+	}
+	delete(obj, path[len(path)-1])
+}
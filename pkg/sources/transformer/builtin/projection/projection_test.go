@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/numaproj/numaflow-go/pkg/sourcetransformer"
+	"github.com/stretchr/testify/assert"
+)
+
+var _keys = []string{"key"}
+var jsonMsg = `{"id": 1, "user": {"id": "u1", "name": "numa", "email": "numa@numaproj.io"}, "time": "2021-02-18T21:54:42.123Z"}`
+
+type testDatum struct {
+	value     []byte
+	eventTime time.Time
+	watermark time.Time
+	headers   map[string]string
+}
+
+func (h *testDatum) Headers() map[string]string {
+	return h.headers
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return h.eventTime
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return h.watermark
+}
+
+func TestProjection(t *testing.T) {
+	t.Run("missing arguments", func(t *testing.T) {
+		_, err := New(map[string]string{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one of")
+	})
+
+	t.Run("invalid rename", func(t *testing.T) {
+		_, err := New(map[string]string{"rename": "id"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid rename")
+	})
+
+	t.Run("expression", func(t *testing.T) {
+		handle, err := New(map[string]string{"expression": `{"user": json(payload).user.id, "ts": json(payload).time}`})
+		assert.NoError(t, err)
+		result := handle(context.Background(), _keys, &testDatum{value: []byte(jsonMsg), eventTime: time.Time{}})
+		assert.Len(t, result.Items(), 1)
+		assert.JSONEq(t, `{"user": "u1", "ts": "2021-02-18T21:54:42.123Z"}`, string(result.Items()[0].Value()))
+		assert.Equal(t, _keys, result.Items()[0].Keys())
+	})
+
+	t.Run("select, drop, rename and set", func(t *testing.T) {
+		handle, err := New(map[string]string{
+			"select":      "id,user",
+			"drop":        "user.email",
+			"rename":      "user.name:name, id:userId",
+			"set.source":  `"upstream"`,
+			"set.user.ts": `json(payload).time`,
+		})
+		assert.NoError(t, err)
+		result := handle(context.Background(), _keys, &testDatum{value: []byte(jsonMsg), eventTime: time.Time{}})
+		assert.Len(t, result.Items(), 1)
+		assert.JSONEq(t, `{"userId": 1, "name": "numa", "source": "upstream", "user": {"id": "u1", "ts": "2021-02-18T21:54:42.123Z"}}`, string(result.Items()[0].Value()))
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		handle, err := New(map[string]string{"select": "id"})
+		assert.NoError(t, err)
+		result := handle(context.Background(), _keys, &testDatum{value: []byte(`welcome to numaflow`), eventTime: time.Time{}})
+		assert.Len(t, result.Items(), 1)
+		assert.Equal(t, []string{sourcetransformer.DROP}, result.Items()[0].Tags())
+	})
+
+	t.Run("expression not returning an object", func(t *testing.T) {
+		handle, err := New(map[string]string{"expression": `json(payload).id`})
+		assert.NoError(t, err)
+		result := handle(context.Background(), _keys, &testDatum{value: []byte(jsonMsg), eventTime: time.Time{}})
+		assert.Len(t, result.Items(), 1)
+		assert.Equal(t, []string{sourcetransformer.DROP}, result.Items()[0].Tags())
+	})
+}
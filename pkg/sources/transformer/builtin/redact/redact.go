@@ -0,0 +1,46 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redact
+
+import (
+	"context"
+
+	"github.com/numaproj/numaflow-go/pkg/sourcetransformer"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedredact "github.com/numaproj/numaflow/pkg/shared/redact"
+)
+
+// New returns a source transformer which masks the sensitive data in the payload, the message is dropped if it can't
+// be redacted, so that the sensitive data never reaches the next vertices. The patterns are also applied to the keys,
+// the headers are not redacted.
+func New(args map[string]string) (sourcetransformer.SourceTransformFunc, error) {
+	r, err := sharedredact.New(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, keys []string, datum sourcetransformer.Datum) sourcetransformer.Messages {
+		log := logging.FromContext(ctx)
+		result, err := r.Redact(datum.Value())
+		if err != nil {
+			log.Errorf("Redact transformer apply got an error: %v", err)
+			return sourcetransformer.MessagesBuilder().Append(sourcetransformer.MessageToDrop(datum.EventTime()))
+		}
+		return sourcetransformer.MessagesBuilder().Append(sourcetransformer.NewMessage(result, datum.EventTime()).WithKeys(r.RedactKeys(keys)))
+	}, nil
+}
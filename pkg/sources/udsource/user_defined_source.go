@@ -18,6 +18,9 @@ package udsource
 
 import (
 	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -28,6 +31,19 @@ import (
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
 )
 
+// HeaderEndOfInput is the header of the end marker returned by a user-defined source, which signals the end of its
+// input. The end marker is acknowledged right away, and it's not forwarded.
+const HeaderEndOfInput = "x-numaflow-end-of-input"
+
+// HeaderWatermark is the header of a datum returned by a user-defined source, which reports the watermark of the
+// partition of the datum in epoch milliseconds. Once a partition reports its watermark, the watermark of the partition
+// is no longer inferred from the event times of its datums.
+const HeaderWatermark = "x-numaflow-watermark"
+
+// HeaderWatermarkOnly is the header of a datum which only reports a watermark with HeaderWatermark, e.g. for an idle
+// partition. The datum is acknowledged right away, and it's not forwarded.
+const HeaderWatermarkOnly = "x-numaflow-watermark-only"
+
 type Option func(*userDefinedSource) error
 
 // WithReadTimeout sets the read timeout
@@ -43,6 +59,9 @@ type userDefinedSource struct {
 	pipelineName  string             // name of the pipeline
 	sourceApplier *GRPCBasedUDSource // sourceApplier applies the user-defined source functions
 	readTimeout   time.Duration      // read timeout for the source
+	exhausted     atomic.Bool        // exhausted is set once the end marker is read
+	wmLock        sync.Mutex
+	watermarks    map[int32]time.Time // watermarks are the latest watermarks reported by the source
 	logger        *zap.SugaredLogger
 }
 
@@ -54,6 +73,7 @@ func NewUserDefinedSource(ctx context.Context, vertexInstance *dfv1.VertexInstan
 		vertexName:    vertexInstance.Vertex.Spec.Name,
 		pipelineName:  vertexInstance.Vertex.Spec.PipelineName,
 		sourceApplier: sourceApplier,
+		watermarks:    make(map[int32]time.Time),
 		logger:        logging.FromContext(ctx), // default logger
 	}
 	for _, opt := range opts {
@@ -84,9 +104,65 @@ func (u *userDefinedSource) Partitions(ctx context.Context) []int32 {
 	return partitions
 }
 
-// Read reads the messages from the user-defined source.
+// Read reads the messages from the user-defined source, the reported watermarks are recorded, and the end markers
+// and the watermark-only datums are acknowledged and dropped.
 func (u *userDefinedSource) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
-	return u.sourceApplier.ApplyReadFn(ctx, count, u.readTimeout)
+	msgs, err := u.sourceApplier.ApplyReadFn(ctx, count, u.readTimeout)
+	var markers []isb.Offset
+	endOfInput := false
+	result := msgs[:0]
+	for _, m := range msgs {
+		if v, ok := m.Headers[HeaderWatermark]; ok {
+			u.reportWatermark(m.ReadOffset.PartitionIdx(), v)
+		}
+		if _, ok := m.Headers[HeaderEndOfInput]; ok {
+			markers = append(markers, m.ReadOffset)
+			endOfInput = true
+			continue
+		}
+		if _, ok := m.Headers[HeaderWatermarkOnly]; ok {
+			markers = append(markers, m.ReadOffset)
+			continue
+		}
+		result = append(result, m)
+	}
+	if len(markers) > 0 {
+		if ackErr := u.sourceApplier.ApplyAckFn(ctx, markers); ackErr != nil {
+			u.logger.Errorw("Failed to acknowledge the markers", zap.Error(ackErr))
+		} else if endOfInput {
+			u.logger.Info("Read the end marker, the user-defined source is exhausted")
+			u.exhausted.Store(true)
+		}
+	}
+	return result, err
+}
+
+// reportWatermark records the watermark of the partition reported in a header.
+func (u *userDefinedSource) reportWatermark(partition int32, value string) {
+	millis, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		u.logger.Warnw("Invalid watermark reported by the user-defined source", zap.Int32("partition", partition), zap.String("watermark", value), zap.Error(err))
+		return
+	}
+	u.wmLock.Lock()
+	defer u.wmLock.Unlock()
+	u.watermarks[partition] = time.UnixMilli(millis)
+}
+
+// Watermarks returns the latest watermarks reported by the user-defined source.
+func (u *userDefinedSource) Watermarks() map[int32]time.Time {
+	u.wmLock.Lock()
+	defer u.wmLock.Unlock()
+	watermarks := make(map[int32]time.Time, len(u.watermarks))
+	for p, wm := range u.watermarks {
+		watermarks[p] = wm
+	}
+	return watermarks
+}
+
+// IsExhausted returns true once the end marker is read and acknowledged.
+func (u *userDefinedSource) IsExhausted() bool {
+	return u.exhausted.Load()
 }
 
 // Ack acknowledges the messages from the user-defined source
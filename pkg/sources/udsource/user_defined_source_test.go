@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package udsource
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	sourcepb "github.com/numaproj/numaflow-go/pkg/apis/proto/source/v1"
+	"github.com/numaproj/numaflow-go/pkg/apis/proto/source/v1/sourcemock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/sources/sourcer"
+)
+
+func TestUserDefinedSource_ReadEndMarker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := sourcemock.NewMockSourceClient(ctrl)
+	mockReadClient := sourcemock.NewMockSource_ReadFnClient(ctrl)
+
+	dataOffset := &sourcepb.Offset{Offset: []byte("offset-1"), PartitionId: 0}
+	endOffset := &sourcepb.Offset{Offset: []byte("offset-2"), PartitionId: 0}
+	mockReadClient.EXPECT().Recv().Return(&sourcepb.ReadResponse{
+		Result: &sourcepb.ReadResponse_Result{
+			Payload:   []byte("data"),
+			Offset:    dataOffset,
+			EventTime: timestamppb.New(time.Unix(1661169600, 0)),
+		},
+	}, nil).Times(1)
+	mockReadClient.EXPECT().Recv().Return(&sourcepb.ReadResponse{
+		Result: &sourcepb.ReadResponse_Result{
+			Offset:    endOffset,
+			EventTime: timestamppb.New(time.Unix(1661169600, 0)),
+			Headers:   map[string]string{HeaderEndOfInput: "true"},
+		},
+	}, nil).Times(1)
+	mockReadClient.EXPECT().Recv().Return(nil, io.EOF).Times(1)
+	mockClient.EXPECT().ReadFn(gomock.Any(), gomock.Any()).Return(mockReadClient, nil)
+	// the end marker is acknowledged by itself.
+	mockClient.EXPECT().AckFn(gomock.Any(), &rpcMsg{msg: &sourcepb.AckRequest{
+		Request: &sourcepb.AckRequest_Request{Offsets: []*sourcepb.Offset{endOffset}},
+	}}).Return(&sourcepb.AckResponse{Result: &sourcepb.AckResponse_Result{Success: &emptypb.Empty{}}}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pl-in"},
+			Spec:       dfv1.VertexSpec{PipelineName: "test-pl", AbstractVertex: dfv1.AbstractVertex{Name: "in"}},
+		},
+	}
+	u, err := NewUserDefinedSource(ctx, vertexInstance, NewMockUDSgRPCBasedUDSource(mockClient), WithReadTimeout(time.Second))
+	require.NoError(t, err)
+	bounded := u.(sourcer.BoundedSourceReader)
+	assert.False(t, bounded.IsExhausted())
+	msgs, err := u.Read(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, []byte("data"), msgs[0].Payload)
+	assert.True(t, bounded.IsExhausted())
+}
+
+func TestUserDefinedSource_ReadWatermarks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := sourcemock.NewMockSourceClient(ctrl)
+	mockReadClient := sourcemock.NewMockSource_ReadFnClient(ctrl)
+
+	dataOffset := &sourcepb.Offset{Offset: []byte("offset-1"), PartitionId: 0}
+	wmOnlyOffset := &sourcepb.Offset{Offset: []byte("offset-2"), PartitionId: 1}
+	mockReadClient.EXPECT().Recv().Return(&sourcepb.ReadResponse{
+		Result: &sourcepb.ReadResponse_Result{
+			Payload:   []byte("data"),
+			Offset:    dataOffset,
+			EventTime: timestamppb.New(time.Unix(1661169600, 0)),
+			Headers:   map[string]string{HeaderWatermark: "1661169500000"},
+		},
+	}, nil).Times(1)
+	mockReadClient.EXPECT().Recv().Return(&sourcepb.ReadResponse{
+		Result: &sourcepb.ReadResponse_Result{
+			Offset:    wmOnlyOffset,
+			EventTime: timestamppb.New(time.Unix(1661169600, 0)),
+			Headers:   map[string]string{HeaderWatermark: "1661169550000", HeaderWatermarkOnly: "true"},
+		},
+	}, nil).Times(1)
+	mockReadClient.EXPECT().Recv().Return(nil, io.EOF).Times(1)
+	mockClient.EXPECT().ReadFn(gomock.Any(), gomock.Any()).Return(mockReadClient, nil)
+	// the watermark-only datum is acknowledged by itself.
+	mockClient.EXPECT().AckFn(gomock.Any(), &rpcMsg{msg: &sourcepb.AckRequest{
+		Request: &sourcepb.AckRequest_Request{Offsets: []*sourcepb.Offset{wmOnlyOffset}},
+	}}).Return(&sourcepb.AckResponse{Result: &sourcepb.AckResponse_Result{Success: &emptypb.Empty{}}}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pl-in"},
+			Spec:       dfv1.VertexSpec{PipelineName: "test-pl", AbstractVertex: dfv1.AbstractVertex{Name: "in"}},
+		},
+	}
+	u, err := NewUserDefinedSource(ctx, vertexInstance, NewMockUDSgRPCBasedUDSource(mockClient), WithReadTimeout(time.Second))
+	require.NoError(t, err)
+	watermarked := u.(sourcer.WatermarkedSourceReader)
+	assert.Empty(t, watermarked.Watermarks())
+	msgs, err := u.Read(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, []byte("data"), msgs[0].Payload)
+	assert.Equal(t, map[int32]time.Time{
+		0: time.UnixMilli(1661169500000),
+		1: time.UnixMilli(1661169550000),
+	}, watermarked.Watermarks())
+	assert.False(t, u.(sourcer.BoundedSourceReader).IsExhausted())
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/udf/builtin/cat"
 	"github.com/numaproj/numaflow/pkg/udf/builtin/filter"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/keyby"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/redact"
 )
 
 type Builtin struct {
@@ -53,6 +55,10 @@ func (b *Builtin) executor() (mapsdk.MapperFunc, error) {
 		return cat.New(), nil
 	case "filter":
 		return filter.New(b.KWArgs)
+	case "keyBy":
+		return keyby.New(b.KWArgs)
+	case "redact":
+		return redact.New(b.KWArgs)
 	default:
 		return nil, fmt.Errorf("unrecognized function %q", b.Name)
 	}
@@ -32,6 +32,14 @@ func TestGetExecutors(t *testing.T) {
 				Name:   "filter",
 				KWArgs: map[string]string{"expression": `json(payload).a=="b"`},
 			},
+			{
+				Name:   "keyBy",
+				KWArgs: map[string]string{"expression": `json(payload).a`},
+			},
+			{
+				Name:   "redact",
+				KWArgs: map[string]string{"patterns": "email"},
+			},
 		}
 		for _, b := range builtins {
 			e, err := b.executor()
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyby
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+	"github.com/stretchr/testify/assert"
+)
+
+var jsonMsg = `{"user": {"id": 7, "region": "us"}, "amount": 10}`
+
+type testDatum struct {
+	value     []byte
+	eventTime time.Time
+	watermark time.Time
+	headers   map[string]string
+}
+
+func (h *testDatum) Headers() map[string]string {
+	return h.headers
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return h.eventTime
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return h.watermark
+}
+
+func TestKeyBy(t *testing.T) {
+	et := time.Unix(1636470000, 0)
+
+	t.Run("invalid args", func(t *testing.T) {
+		_, err := New(map[string]string{})
+		assert.Error(t, err)
+	})
+
+	t.Run("single key", func(t *testing.T) {
+		handle, err := New(map[string]string{"expression": "string(json(payload).user.id)"})
+		assert.NoError(t, err)
+		result := handle(context.Background(), []string{"old"}, &testDatum{value: []byte(jsonMsg), eventTime: et})
+		assert.Len(t, result.Items(), 1)
+		assert.Equal(t, []string{"7"}, result.Items()[0].Keys())
+		assert.Equal(t, jsonMsg, string(result.Items()[0].Value()))
+	})
+
+	t.Run("multiple keys from payload, keys and headers", func(t *testing.T) {
+		handle, err := New(map[string]string{
+			"expression.10": "keys[0]",
+			"expression.2":  `headers["x-tenant"]`,
+			"expression.0":  "json(payload).user.region",
+		})
+		assert.NoError(t, err)
+		result := handle(context.Background(), []string{"old"}, &testDatum{
+			value:     []byte(jsonMsg),
+			eventTime: et,
+			headers:   map[string]string{"x-tenant": "t1"},
+		})
+		assert.Equal(t, []string{"us", "t1", "old"}, result.Items()[0].Keys())
+	})
+
+	t.Run("failed expression", func(t *testing.T) {
+		handle, err := New(map[string]string{"expression": "json(payload).user.id"})
+		assert.NoError(t, err)
+		result := handle(context.Background(), nil, &testDatum{value: []byte("not json"), eventTime: et})
+		assert.Equal(t, []string{mapsdk.DROP}, result.Items()[0].Tags())
+	})
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redact
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testDatum struct {
+	value     []byte
+	eventTime time.Time
+	watermark time.Time
+	headers   map[string]string
+}
+
+func (h *testDatum) Headers() map[string]string {
+	return h.headers
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return h.eventTime
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return h.watermark
+}
+
+func TestRedact(t *testing.T) {
+	et := time.Unix(1636470000, 0)
+
+	t.Run("invalid args", func(t *testing.T) {
+		_, err := New(map[string]string{})
+		assert.Error(t, err)
+	})
+
+	t.Run("redacted", func(t *testing.T) {
+		handle, err := New(map[string]string{"fields": "email"})
+		require.NoError(t, err)
+		result := handle(context.Background(), []string{"k"}, &testDatum{value: []byte(`{"email":"numa@numaproj.io"}`), eventTime: et})
+		require.Len(t, result.Items(), 1)
+		assert.Equal(t, `{"email":"***"}`, string(result.Items()[0].Value()))
+		assert.Equal(t, []string{"k"}, result.Items()[0].Keys())
+	})
+
+	t.Run("redacted keys", func(t *testing.T) {
+		handle, err := New(map[string]string{"patterns": "email"})
+		require.NoError(t, err)
+		result := handle(context.Background(), []string{"numa@numaproj.io"}, &testDatum{value: []byte(`{"email":"numa@numaproj.io"}`), eventTime: et})
+		require.Len(t, result.Items(), 1)
+		assert.Equal(t, `{"email":"***"}`, string(result.Items()[0].Value()))
+		assert.Equal(t, []string{"***"}, result.Items()[0].Keys())
+	})
+
+	t.Run("dropped", func(t *testing.T) {
+		handle, err := New(map[string]string{"fields": "email"})
+		require.NoError(t, err)
+		result := handle(context.Background(), []string{"k"}, &testDatum{value: []byte("numa@numaproj.io"), eventTime: et})
+		assert.Equal(t, []string{mapsdk.DROP}, result.Items()[0].Tags())
+	})
+}
@@ -30,9 +30,8 @@ import (
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 )
 
-func buildRedisBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance) ([]isb.BufferReader, map[string][]isb.BufferWriter, error) {
+func buildRedisBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance, redisClient *redisclient.RedisClient) ([]isb.BufferReader, map[string][]isb.BufferWriter, error) {
 	var readers []isb.BufferReader
-	redisClient := redisclient.NewInClusterRedisClient()
 	var readerOpts []redisclient.Option
 	if x := vertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
 		readerOpts = append(readerOpts, redisclient.WithReadTimeOut(x.ReadTimeout.Duration))
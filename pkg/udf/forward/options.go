@@ -24,6 +24,7 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/ratelimit"
 )
 
 // options for forwarding the message
@@ -40,6 +41,8 @@ type options struct {
 	enableMapUdfStream bool
 	// cbPublisher is the callback publisher for the vertex.
 	cbPublisher *callback.Uploader
+	// rateLimiter limits the throughput of the vertex, it is nil if the vertex is not rate limited.
+	rateLimiter ratelimit.RateLimiter
 }
 
 type Option func(*options) error
@@ -101,3 +104,11 @@ func WithCallbackUploader(cp *callback.Uploader) Option {
 		return nil
 	}
 }
+
+// WithRateLimiter sets the rate limiter shared by the replicas of the vertex
+func WithRateLimiter(rl ratelimit.RateLimiter) Option {
+	return func(o *options) error {
+		o.rateLimiter = rl
+		return nil
+	}
+}
@@ -34,7 +34,11 @@ import (
 	sdkserverinfo "github.com/numaproj/numaflow/pkg/sdkclient/serverinfo"
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/shared/ratelimit"
+	jsratelimit "github.com/numaproj/numaflow/pkg/shared/ratelimit/jetstream"
+	redisratelimit "github.com/numaproj/numaflow/pkg/shared/ratelimit/redis"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
 	"github.com/numaproj/numaflow/pkg/udf/forward"
@@ -42,6 +46,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
+	rediswm "github.com/numaproj/numaflow/pkg/watermark/generic/redis"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
@@ -69,6 +74,7 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		mapHandler         *rpc.GRPCBasedMap
 		mapStreamHandler   *rpc.GRPCBasedMapStream
 		idleManager        wmb.IdleManager
+		rateLimiter        ratelimit.RateLimiter
 		vertexName         = u.VertexInstance.Vertex.Spec.Name
 		pipelineName       = u.VertexInstance.Vertex.Spec.PipelineName
 	)
@@ -82,10 +88,41 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 	// create readers and writers
 	switch u.ISBSvcType {
 	case dfv1.ISBSvcTypeRedis:
-		readers, writers, err = buildRedisBufferIO(ctx, u.VertexInstance)
+		// share one client, which maintains a connection pool, among the buffers and the stores.
+		redisClient := redisclient.NewInClusterRedisClient()
+		readers, writers, err = buildRedisBufferIO(ctx, u.VertexInstance, redisClient)
 		if err != nil {
 			return err
 		}
+
+		// created watermark related components only if watermark is enabled
+		// otherwise no op will used
+		if !u.VertexInstance.Vertex.Spec.Watermark.Disabled {
+			// create from vertex watermark stores
+			fromVertexWmStores, err = rediswm.BuildFromVertexWatermarkStores(ctx, u.VertexInstance, redisClient)
+			if err != nil {
+				return fmt.Errorf("failed to build watermark stores: %w", err)
+			}
+
+			// create watermark fetcher using watermark stores
+			fetchWatermark = fetch.NewEdgeFetcherSet(ctx, u.VertexInstance, fromVertexWmStores, fetch.WithVertexReplica(u.VertexInstance.Replica),
+				fetch.WithIsReduce(u.VertexInstance.Vertex.IsReduceUDF()), fetch.WithIsSource(u.VertexInstance.Vertex.IsASource()))
+
+			// create to vertex watermark stores
+			toVertexWmStores, err = rediswm.BuildToVertexWatermarkStores(ctx, u.VertexInstance, redisClient)
+			if err != nil {
+				return err
+			}
+
+			// create watermark publisher using watermark stores
+			publishWatermark = generic.BuildPublishersFromStores(ctx, u.VertexInstance, toVertexWmStores)
+
+			idleManager, _ = wmb.NewIdleManager(len(writers), len(writers))
+		}
+
+		if rateLimiter, err = redisratelimit.BuildRateLimiter(ctx, u.VertexInstance.Vertex, redisClient); err != nil {
+			return fmt.Errorf("failed to build rate limiter: %w", err)
+		}
 	case dfv1.ISBSvcTypeJetStream:
 
 		natsClientPool, err := jsclient.NewClientPool(ctx)
@@ -121,10 +158,14 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 			}
 
 			// create watermark publisher using watermark stores
-			publishWatermark = jetstream.BuildPublishersFromStores(ctx, u.VertexInstance, toVertexWmStores)
+			publishWatermark = generic.BuildPublishersFromStores(ctx, u.VertexInstance, toVertexWmStores)
 
 			idleManager, _ = wmb.NewIdleManager(len(writers), len(writers))
 		}
+
+		if rateLimiter, err = jsratelimit.BuildRateLimiter(ctx, u.VertexInstance.Vertex, natsClientPool.NextAvailableClient()); err != nil {
+			return fmt.Errorf("failed to build rate limiter: %w", err)
+		}
 	default:
 		return fmt.Errorf("unrecognized isbsvc type %q", u.ISBSvcType)
 	}
@@ -237,6 +278,9 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 				opts = append(opts, forward.WithUDFConcurrency(int(*x.ReadBatchSize)))
 			}
 		}
+		if rateLimiter != nil {
+			opts = append(opts, forward.WithRateLimiter(rateLimiter))
+		}
 
 		// if the callback is enabled, create a callback publisher
 		cbEnabled := sharedutil.LookupEnvBoolOr(dfv1.EnvCallbackEnabled, false)
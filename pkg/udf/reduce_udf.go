@@ -43,6 +43,7 @@ import (
 	sdkserverinfo "github.com/numaproj/numaflow/pkg/sdkclient/serverinfo"
 	"github.com/numaproj/numaflow/pkg/sdkclient/sessionreducer"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
@@ -50,6 +51,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
+	rediswm "github.com/numaproj/numaflow/pkg/watermark/generic/redis"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 	"github.com/numaproj/numaflow/pkg/window"
@@ -187,10 +189,37 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 	idleManager = wmb.NewNoOpIdleManager()
 	switch u.ISBSvcType {
 	case dfv1.ISBSvcTypeRedis:
-		readers, writers, err = buildRedisBufferIO(ctx, u.VertexInstance)
+		// share one client, which maintains a connection pool, among the buffers and the stores.
+		redisClient := redisclient.NewInClusterRedisClient()
+		readers, writers, err = buildRedisBufferIO(ctx, u.VertexInstance, redisClient)
 		if err != nil {
 			return err
 		}
+
+		// created watermark related components only if watermark is enabled
+		// otherwise noop will used
+		if !u.VertexInstance.Vertex.Spec.Watermark.Disabled {
+			// create from vertex watermark stores
+			fromVertexWmStores, err = rediswm.BuildFromVertexWatermarkStores(ctx, u.VertexInstance, redisClient)
+			if err != nil {
+				return fmt.Errorf("failed to build watermark stores: %w", err)
+			}
+
+			// create watermark fetcher using watermark stores
+			fetchWatermark = fetch.NewEdgeFetcherSet(ctx, u.VertexInstance, fromVertexWmStores, fetch.WithVertexReplica(u.VertexInstance.Replica),
+				fetch.WithIsReduce(u.VertexInstance.Vertex.IsReduceUDF()), fetch.WithIsSource(u.VertexInstance.Vertex.IsASource()))
+
+			// create to vertex watermark stores
+			toVertexWmStores, err = rediswm.BuildToVertexWatermarkStores(ctx, u.VertexInstance, redisClient)
+			if err != nil {
+				return err
+			}
+
+			// create watermark publisher using watermark stores
+			publishWatermark = generic.BuildPublishersFromStores(ctx, u.VertexInstance, toVertexWmStores)
+
+			idleManager, _ = wmb.NewIdleManager(1, len(writers))
+		}
 	case dfv1.ISBSvcTypeJetStream:
 
 		natsClientPool, err = jsclient.NewClientPool(ctx)
@@ -224,7 +253,7 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 			}
 
 			// create watermark publisher using watermark stores
-			publishWatermark = jetstream.BuildPublishersFromStores(ctx, u.VertexInstance, toVertexWmStores)
+			publishWatermark = generic.BuildPublishersFromStores(ctx, u.VertexInstance, toVertexWmStores)
 
 			idleManager, _ = wmb.NewIdleManager(1, len(writers))
 		}
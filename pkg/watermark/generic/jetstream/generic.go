@@ -24,8 +24,6 @@ import (
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
-	"github.com/numaproj/numaflow/pkg/watermark/entity"
-	"github.com/numaproj/numaflow/pkg/watermark/publish"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 )
 
@@ -87,29 +85,6 @@ func BuildToVertexWatermarkStores(ctx context.Context, vertexInstance *v1alpha1.
 	return wmStores, nil
 }
 
-// BuildPublishersFromStores creates a map of publishers for all the to buckets of the given vertex using the given watermark stores.
-func BuildPublishersFromStores(ctx context.Context, vertexInstance *v1alpha1.VertexInstance, wmStores map[string]store.WatermarkStore) map[string]publish.Publisher {
-	// Publisher map creation, we need a publisher per out buffer.
-	var (
-		publishWatermark = make(map[string]publish.Publisher)
-		processorName    = fmt.Sprintf("%s-%d", vertexInstance.Vertex.Name, vertexInstance.Replica)
-		vertex           = vertexInstance.Vertex
-	)
-
-	publishEntity := entity.NewProcessorEntity(processorName)
-
-	if vertex.IsASink() {
-		wmStore := wmStores[vertex.Spec.Name]
-		publishWatermark[vertex.Spec.Name] = publish.NewPublish(ctx, publishEntity, wmStore, 1, publish.IsSink())
-	} else {
-		for _, e := range vertex.Spec.ToEdges {
-			wmStore := wmStores[e.To]
-			publishWatermark[e.To] = publish.NewPublish(ctx, publishEntity, wmStore, int32(e.GetToVertexPartitionCount()))
-		}
-	}
-	return publishWatermark
-}
-
 // BuildSourcePublisherStores builds the watermark stores for source publisher.
 func BuildSourcePublisherStores(ctx context.Context, vertexInstance *v1alpha1.VertexInstance, client *jsclient.Client) (store.WatermarkStore, error) {
 	if !vertexInstance.Vertex.IsASource() {
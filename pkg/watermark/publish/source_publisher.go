@@ -37,6 +37,8 @@ type SourcePublisher interface {
 	PublishSourceWatermarks([]*isb.ReadMessage)
 	// PublishIdleWatermarks publishes idle watermarks for the given partitions.
 	PublishIdleWatermarks(wm time.Time, partitions []int32)
+	// PublishWatermarks publishes the watermarks of the partitions provided by the source itself.
+	PublishWatermarks(watermarks map[int32]time.Time)
 }
 
 type sourcePublish struct {
@@ -101,6 +103,15 @@ func (df *sourcePublish) PublishSourceWatermarks(readMessages []*isb.ReadMessage
 	}
 }
 
+// PublishWatermarks publishes the watermarks of the partitions provided by the source, instead of the ones inferred
+// from the event times of the messages.
+func (df *sourcePublish) PublishWatermarks(watermarks map[int32]time.Time) {
+	for p, wm := range watermarks {
+		publisher := df.loadSourceWatermarkPublisher(p)
+		publisher.PublishWatermark(wmb.Watermark(wm), nil, 0) // we don't care about the offset while publishing source watermark
+	}
+}
+
 // PublishIdleWatermarks publishes idle watermarks for all partitions.
 func (df *sourcePublish) PublishIdleWatermarks(wm time.Time, partitions []int32) {
 	for _, partitionId := range partitions {
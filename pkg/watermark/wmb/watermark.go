@@ -16,7 +16,10 @@ limitations under the License.
 
 package wmb
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 // Watermark is the monotonically increasing watermark. It is tightly coupled with ProcessorEntitier as
 // the processor is responsible for monotonically increasing Watermark for that processor.
@@ -25,6 +28,15 @@ type Watermark time.Time
 
 var InitialWatermark = Watermark(time.UnixMilli(-1))
 
+// FinalWatermark is published by a source once its input is exhausted, it's greater than any event time so that
+// all the windows are closed. math.MaxInt64 is not used because the fetchers use it to tell there's no watermark.
+var FinalWatermark = Watermark(time.UnixMilli(math.MaxInt64 - 1))
+
+// IsFinal returns true if the watermark is the FinalWatermark.
+func (w Watermark) IsFinal() bool {
+	return w.UnixMilli() == FinalWatermark.UnixMilli()
+}
+
 func (w Watermark) String() string {
 	var location, _ = time.LoadLocation("UTC")
 	var t = time.Time(w).In(location)
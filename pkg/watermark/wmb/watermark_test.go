@@ -0,0 +1,34 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wmb
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatermark_IsFinal(t *testing.T) {
+	assert.True(t, FinalWatermark.IsFinal())
+	assert.False(t, InitialWatermark.IsFinal())
+	assert.False(t, Watermark(time.Now()).IsFinal())
+	// math.MaxInt64 is used by the fetchers to tell there's no watermark.
+	assert.False(t, Watermark(time.UnixMilli(math.MaxInt64)).IsFinal())
+	assert.True(t, FinalWatermark.After(time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)))
+}